@@ -1,43 +1,322 @@
 package main
 
 import (
+	"context"
+	"errors"
+	"expvar"
+	"flag"
+	"fmt"
 	"log"
+	"log/slog"
 	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
 
 	"hivemind/graph/generated"
 	"hivemind/graph/resolver"
+	"hivemind/internal/admin"
+	"hivemind/internal/audit"
+	"hivemind/internal/cache"
 	"hivemind/internal/config"
+	"hivemind/internal/contentfilter"
+	"hivemind/internal/cors"
 	"hivemind/internal/db"
+	"hivemind/internal/health"
+	"hivemind/internal/httpcache"
+	"hivemind/internal/hub"
+	"hivemind/internal/logging"
 	"hivemind/internal/memory"
+	"hivemind/internal/metrics"
+	"hivemind/internal/pubsub"
+	"hivemind/internal/ratelimit"
+	"hivemind/internal/render"
+	"hivemind/internal/session"
+	"hivemind/internal/sse"
+	"hivemind/internal/storage"
+	"hivemind/internal/tracing"
 
 	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+	"github.com/99designs/gqlgen/graphql/handler/lru"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
 	"github.com/99designs/gqlgen/graphql/playground"
+	"github.com/gorilla/websocket"
+	"github.com/vektah/gqlparser/v2/ast"
 )
 
-const defaultPort = "8080"
-
 func main() {
-	cfg := config.Load()
-	var res *resolver.Resolver
+	cfg, err := config.Load(os.Args[1:])
+	if errors.Is(err, flag.ErrHelp) {
+		return
+	}
+	if err != nil {
+		log.Fatalf("invalid configuration: %v", err)
+	}
+	logger, err := logging.New(os.Stdout, cfg.LogLevel)
+	if err != nil {
+		log.Fatalf("invalid log level: %v", err)
+	}
+	slog.SetDefault(logger)
+
+	shutdownTracing, err := tracing.Setup(context.Background(), cfg.TracingExporter, cfg.TracingEndpoint)
+	if err != nil {
+		fatal("failed to set up tracing", "error", err)
+	}
+
+	var (
+		store  storage.Storage
+		dbConn *db.PostgresStorage
+	)
 
 	switch cfg.StorageType {
 	case "postgres":
-		dbConn, err := db.NewPostgresRepository(cfg.DatabaseURL)
+		dbConn, err = db.NewPostgresRepository(cfg.DatabaseURL,
+			db.WithPool(cfg.DBMaxOpenConns, cfg.DBMaxIdleConns, cfg.DBConnMaxLifetime, cfg.DBConnMaxIdleTime),
+			db.WithQueryTimeout(cfg.DBQueryTimeout),
+			db.WithConnectTimeout(cfg.DBConnectTimeout),
+			db.WithReadRetries(cfg.DBReadRetries),
+			db.WithReplicas(cfg.DBReplicaURLs...),
+			db.WithReplicaCheckInterval(cfg.DBReplicaCheckInterval),
+			db.WithStickyPrimary(cfg.DBStickyPrimaryWindow, session.ID),
+		)
 		if err != nil {
-			log.Fatalf("failed to connect to db: %v", err)
+			fatal("failed to connect to db", "error", err)
 		}
-		res = resolver.NewResolver(dbConn)
+		store = dbConn
 	case "memory":
-		res = resolver.NewResolver(memory.NewMemoryStorage())
-	default:
-		log.Fatalf("unknown storage type: %s", cfg.StorageType)
+		store = memory.NewMemoryStorage()
+	}
+	store = tracing.InstrumentStorage(store)
+	m := metrics.New()
+	if cfg.FeatureMetrics {
+		if dbConn != nil {
+			m.RegisterDB(dbConn.DB(), "primary")
+			for i, replica := range dbConn.ReplicaDBs() {
+				m.RegisterDB(replica, fmt.Sprintf("replica%d", i))
+			}
+		}
+		store = m.InstrumentStorage(store)
+	}
+
+	var broker pubsub.Broker
+	switch cfg.PubSubType {
+	case "postgres":
+		pg, err := pubsub.NewPostgres(cfg.DatabaseURL)
+		if err != nil {
+			fatal("failed to start postgres pubsub", "error", err)
+		}
+		broker = pg
+	case "memory":
+		broker = pubsub.NewInProcess()
+	}
+
+	// Кеш нужен только перед базой: память и так отдаёт данные мгновенно.
+	// Он стоит снаружи метрик и трассировки, чтобы они показывали обращения
+	// к базе, а не попадания в кеш.
+	var storeCache *cache.Storage
+	if dbConn != nil && cfg.FeatureStorageCache {
+		storeCache = cache.New(store, broker, cfg.CacheMaxBytes, cfg.CacheTTL, cache.WithPrimaryFillWindow(cfg.DBStickyPrimaryWindow))
+		store = storeCache
 	}
 
-	srv := handler.NewDefaultServer(generated.NewExecutableSchema(generated.Config{Resolvers: res}))
+	overflow, err := hub.ParsePolicy(cfg.SubscriptionOverflow)
+	if err != nil {
+		fatal("invalid subscription settings", "error", err)
+	}
 
-	http.Handle("/", playground.Handler("GraphQL playground", "/query"))
-	http.Handle("/query", srv)
+	opts := []resolver.Option{
+		resolver.WithBroker(broker),
+		resolver.WithSubscriptionQueue(cfg.SubscriptionQueueSize, overflow),
+		resolver.WithTypingTTL(cfg.TypingTTL),
+		resolver.WithModerators(cfg.Moderators...),
+		resolver.WithAdmins(cfg.Admins...),
+		resolver.WithRenderer(render.New(cfg.RenderCacheSize)),
+		resolver.WithMaxCommentLength(cfg.MaxCommentLength),
+		resolver.WithIdempotencyRetention(cfg.IdempotencyRetention),
+	}
+	if cfg.ContentFilterConfig != "" {
+		pipeline, err := contentfilter.Load(cfg.ContentFilterConfig)
+		if err != nil {
+			fatal("failed to load content filters", "error", err)
+		}
+		opts = append(opts, resolver.WithContentFilter(pipeline))
+	}
+	res := resolver.NewResolver(store, opts...)
+	if cfg.FeatureMetrics {
+		m.RegisterSubscriptions(res)
+	}
 
-	log.Printf("connect to http://localhost:%s/ for GraphQL playground", defaultPort)
-	log.Fatal(http.ListenAndServe(":"+defaultPort, nil))
+	rules, err := ratelimit.ParseRules(cfg.RateLimits)
+	if err != nil {
+		fatal("invalid rate limits", "error", err)
+	}
+	var limitStore ratelimit.Store
+	switch cfg.RateLimitStore {
+	case "postgres":
+		limitStore = dbConn.RateLimitStore()
+	case "memory":
+		limitStore = ratelimit.NewMemoryStore()
+	}
+
+	limits := resolver.NewSubscriptionLimits(cfg.MaxSubscriptionsPerConnection, cfg.MaxSubscriptionsPerUser, cfg.WebsocketIdleTimeout)
+	logs := logging.Extension{Logger: logger, User: resolver.Subscriber}
+
+	srv := handler.New(generated.NewExecutableSchema(generated.Config{Resolvers: res, Complexity: resolver.Complexity()}))
+	ws := transport.Websocket{
+		InitFunc:              logs.InitFunc(limits.InitConnection),
+		CloseFunc:             logs.CloseFunc,
+		InitTimeout:           cfg.WebsocketInitTimeout,
+		KeepAlivePingInterval: cfg.WebsocketKeepAlive,
+		PingPongInterval:      cfg.WebsocketPingPong,
+	}
+	if len(cfg.CORSAllowedOrigins) > 0 {
+		ws.Upgrader = websocket.Upgrader{CheckOrigin: cors.CheckOrigin(cfg.CORSAllowedOrigins)}
+	}
+	srv.AddTransport(ws)
+	// SSE проверяется раньше GET и POST: он отличается только заголовком Accept.
+	if cfg.FeatureSSE {
+		srv.AddTransport(sse.Transport{Heartbeat: cfg.SSEHeartbeat})
+	}
+	srv.AddTransport(transport.Options{})
+	srv.AddTransport(transport.GET{})
+	srv.AddTransport(transport.POST{})
+	srv.AddTransport(transport.MultipartForm{})
+	srv.SetQueryCache(lru.New[*ast.QueryDocument](1000))
+	if cfg.IntrospectionEnabled() {
+		srv.Use(extension.Introspection{})
+	}
+	srv.Use(tracing.Extension{Fields: cfg.TracingFields})
+	if cfg.FeatureMetrics {
+		srv.Use(m.Extension())
+	}
+	srv.Use(logs)
+	srv.Use(extension.AutomaticPersistedQuery{Cache: lru.New[string](cfg.PersistedQueryCacheSize)})
+	srv.Use(resolver.DepthLimit{Max: cfg.GraphQLMaxDepth})
+	if cfg.GraphQLMaxComplexity > 0 {
+		srv.Use(extension.FixedComplexityLimit(cfg.GraphQLMaxComplexity))
+	}
+	if cfg.FeatureHTTPCache {
+		srv.Use(httpcache.Extension{})
+	}
+	srv.Use(ratelimit.New(limitStore, rules))
+	srv.Use(limits)
+	srv.Use(resolver.SubscriptionNotices{})
+
+	mux := http.NewServeMux()
+	if cfg.FeaturePlayground {
+		mux.Handle("/", playground.Handler("GraphQL playground", "/query"))
+	}
+	var query http.Handler = srv
+	if cfg.FeatureHTTPCache {
+		query = httpcache.Middleware(query)
+	}
+	adminTokens, err := admin.ParseTokens(cfg.AdminTokens)
+	if err != nil {
+		fatal("invalid admin tokens", "error", err)
+	}
+	query = admin.Middleware(adminTokens, query)
+	mux.Handle("/query", cors.Middleware(cfg.CORSAllowedOrigins, tracing.Middleware(session.Middleware(ratelimit.ClientIPMiddleware(cfg.TrustProxy, query)))))
+	mux.Handle("/admin/audit.ndjson", admin.Middleware(adminTokens, audit.ExportHandler(store, cfg.Admins)))
+	if cfg.FeatureDebugVars {
+		expvar.Publish("subscriptions", expvar.Func(func() any { return res.DeliveryStats() }))
+		if storeCache != nil {
+			expvar.Publish("storage_cache", expvar.Func(func() any {
+				entries, bytes := storeCache.Stats()
+				return map[string]int{"entries": entries, "bytes": bytes}
+			}))
+		}
+		mux.Handle("/debug/vars", expvar.Handler())
+	}
+	if cfg.FeatureMetrics {
+		mux.Handle("/metrics", m.Handler())
+	}
+
+	checker := health.New(cfg.HealthCheckTimeout)
+	checker.Add("storage", func(ctx context.Context) (any, error) { return nil, store.Ping(ctx) })
+	if dbConn != nil {
+		checker.Add("migrations", migrationStatus(dbConn))
+		checker.Add("replicas", replicaStatus(dbConn))
+	}
+	checker.Add("subscriptions", res.SubscriptionHealth)
+	mux.Handle("/healthz", health.LiveHandler())
+	mux.Handle("/readyz", checker.ReadyHandler())
+
+	server := &http.Server{
+		Addr:              cfg.ListenAddr,
+		Handler:           logging.Middleware(mux),
+		ReadHeaderTimeout: cfg.ReadHeaderTimeout,
+		IdleTimeout:       cfg.IdleTimeout,
+	}
+	// Shutdown не закрывает перехваченные WebSocket-соединения, поэтому
+	// закрываем их сами, когда подписки на них уже завершены.
+	server.RegisterOnShutdown(limits.CloseConnections)
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	serveErr := make(chan error, 1)
+	go func() {
+		slog.Info("server started", "addr", cfg.ListenAddr)
+		serveErr <- server.ListenAndServe()
+	}()
+	select {
+	case err := <-serveErr:
+		fatal("server failed", "error", err)
+	case <-ctx.Done():
+	}
+	stop()
+
+	slog.Info("shutting down, draining connections", "timeout", cfg.ShutdownTimeout)
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), cfg.ShutdownTimeout)
+	defer cancel()
+	// Реплика перестаёт считаться готовой, подписчики получают complete,
+	// затем сервер перестаёт принимать соединения и дожидается текущих запросов.
+	checker.Drain()
+	res.Drain()
+	if err := server.Shutdown(shutdownCtx); err != nil {
+		slog.Error("failed to drain connections", "error", err)
+	}
+	if err := broker.Close(); err != nil {
+		slog.Error("failed to close pubsub", "error", err)
+	}
+	if dbConn != nil {
+		if err := dbConn.Close(); err != nil {
+			slog.Error("failed to close db", "error", err)
+		}
+	}
+	if err := shutdownTracing(shutdownCtx); err != nil {
+		slog.Error("failed to flush traces", "error", err)
+	}
+	slog.Info("server stopped")
+}
+
+// fatal записывает ошибку запуска и завершает процесс.
+func fatal(msg string, args ...any) {
+	slog.Error(msg, args...)
+	os.Exit(1)
+}
+
+// migrationStatus проверяет, что схема базы соответствует коду.
+func migrationStatus(dbConn *db.PostgresStorage) health.Check {
+	return func(ctx context.Context) (any, error) {
+		pending, err := dbConn.PendingMigrations(ctx)
+		if err != nil {
+			return nil, err
+		}
+		details := map[string]any{"pending": pending}
+		if len(pending) > 0 {
+			return details, errors.New("database schema is out of date, apply db/migrations/schema.sql")
+		}
+		return details, nil
+	}
+}
+
+// replicaStatus сообщает, сколько реплик принимают чтения. Недоступные реплики
+// не делают сервис неготовым: чтения переходят в основную базу.
+func replicaStatus(dbConn *db.PostgresStorage) health.Check {
+	return func(ctx context.Context) (any, error) {
+		healthy, total := dbConn.Replicas()
+		return map[string]any{"healthy": healthy, "total": total}, nil
+	}
 }
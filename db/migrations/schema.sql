@@ -20,4 +20,79 @@ CREATE TABLE IF NOT EXISTS comments (
 CREATE INDEX IF NOT EXISTS idx_comments_post_id ON comments(post_id);
 CREATE INDEX IF NOT EXISTS idx_comments_parent_id ON comments(parent_id);
 CREATE INDEX IF NOT EXISTS idx_posts_created_at ON posts(created_at);
-CREATE INDEX IF NOT EXISTS idx_comments_created_at ON comments(created_at);
\ No newline at end of file
+CREATE INDEX IF NOT EXISTS idx_comments_created_at ON comments(created_at);
+
+ALTER TABLE posts ADD COLUMN IF NOT EXISTS removed_at TIMESTAMPTZ;
+ALTER TABLE comments ADD COLUMN IF NOT EXISTS removed_at TIMESTAMPTZ;
+
+CREATE TABLE IF NOT EXISTS reports (
+    id TEXT PRIMARY KEY,
+    target_id TEXT NOT NULL,
+    target_type TEXT NOT NULL CHECK (target_type IN ('POST', 'COMMENT')),
+    target_author TEXT NOT NULL,
+    reason TEXT NOT NULL,
+    details TEXT CHECK (char_length(details) <= 1000),
+    reporter TEXT NOT NULL,
+    status TEXT NOT NULL DEFAULT 'OPEN',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE TABLE IF NOT EXISTS report_status_history (
+    id BIGSERIAL PRIMARY KEY,
+    report_id TEXT NOT NULL REFERENCES reports(id) ON DELETE CASCADE,
+    status TEXT NOT NULL,
+    actor TEXT NOT NULL,
+    note TEXT,
+    changed_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS idx_reports_status_created_at ON reports(status, created_at, id);
+CREATE INDEX IF NOT EXISTS idx_reports_target_id ON reports(target_id);
+CREATE INDEX IF NOT EXISTS idx_report_status_history_report_id ON report_status_history(report_id);
+
+CREATE TABLE IF NOT EXISTS audit_log (
+    id BIGSERIAL PRIMARY KEY,
+    actor TEXT NOT NULL,
+    action TEXT NOT NULL,
+    target_type TEXT NOT NULL,
+    target_id TEXT NOT NULL,
+    before JSONB,
+    after JSONB,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE OR REPLACE RULE audit_log_no_update AS ON UPDATE TO audit_log DO INSTEAD NOTHING;
+CREATE OR REPLACE RULE audit_log_no_delete AS ON DELETE TO audit_log DO INSTEAD NOTHING;
+
+CREATE INDEX IF NOT EXISTS idx_audit_log_actor ON audit_log(actor);
+CREATE INDEX IF NOT EXISTS idx_audit_log_target_id ON audit_log(target_id);
+CREATE INDEX IF NOT EXISTS idx_audit_log_created_at ON audit_log(created_at);
+
+CREATE UNLOGGED TABLE IF NOT EXISTS rate_limit_buckets (
+    key TEXT PRIMARY KEY,
+    tokens DOUBLE PRECISION NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL,
+    expires_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_rate_limit_buckets_expires_at ON rate_limit_buckets(expires_at);
+
+ALTER TABLE posts ADD COLUMN IF NOT EXISTS visibility TEXT NOT NULL DEFAULT 'public';
+ALTER TABLE comments ADD COLUMN IF NOT EXISTS visibility TEXT NOT NULL DEFAULT 'public';
+
+ALTER TABLE posts ADD COLUMN IF NOT EXISTS format TEXT NOT NULL DEFAULT 'PLAIN';
+ALTER TABLE comments ADD COLUMN IF NOT EXISTS format TEXT NOT NULL DEFAULT 'PLAIN';
+
+CREATE INDEX IF NOT EXISTS idx_comments_post_created_at ON comments(post_id, created_at, id);
+
+ALTER TABLE comments ADD COLUMN IF NOT EXISTS edited_at TIMESTAMPTZ;
+
+CREATE TABLE IF NOT EXISTS idempotency_keys (
+    key TEXT PRIMARY KEY,
+    fingerprint TEXT NOT NULL,
+    result_id TEXT NOT NULL,
+    expires_at TIMESTAMPTZ NOT NULL,
+    completed BOOLEAN NOT NULL DEFAULT false
+);
+
+CREATE INDEX IF NOT EXISTS idx_idempotency_keys_expires_at ON idempotency_keys(expires_at);
@@ -3,34 +3,475 @@
 package model
 
 import (
+	"bytes"
+	"fmt"
+	"io"
+	"strconv"
 	"time"
 )
 
+type AuditEntry struct {
+	ID         string          `json:"id"`
+	Actor      string          `json:"actor"`
+	Action     AuditAction     `json:"action"`
+	TargetType AuditTargetType `json:"targetType"`
+	TargetID   string          `json:"targetId"`
+	Before     *string         `json:"before,omitempty"`
+	After      *string         `json:"after,omitempty"`
+	CreatedAt  time.Time       `json:"createdAt"`
+}
+
+type AuditEntryConnection struct {
+	Edges    []*AuditEntryEdge `json:"edges"`
+	PageInfo *PageInfo         `json:"pageInfo"`
+}
+
+type AuditEntryEdge struct {
+	Cursor string      `json:"cursor"`
+	Node   *AuditEntry `json:"node"`
+}
+
+type AuditLogFilter struct {
+	Actor    *string      `json:"actor,omitempty"`
+	Action   *AuditAction `json:"action,omitempty"`
+	TargetID *string      `json:"targetId,omitempty"`
+	Since    *time.Time   `json:"since,omitempty"`
+	Until    *time.Time   `json:"until,omitempty"`
+}
+
 type Comment struct {
-	ID        string     `json:"id"`
-	PostID    string     `json:"postId"`
-	ParentID  *string    `json:"parentId,omitempty"`
-	Author    string     `json:"author"`
-	Content   string     `json:"content"`
-	CreatedAt time.Time  `json:"createdAt"`
-	Replies   []*Comment `json:"replies"`
+	ID        string        `json:"id"`
+	PostID    string        `json:"postId"`
+	ParentID  *string       `json:"parentId,omitempty"`
+	Author    string        `json:"author"`
+	Content   string        `json:"content"`
+	Format    ContentFormat `json:"format"`
+	CreatedAt time.Time     `json:"createdAt"`
+	EditedAt  *time.Time    `json:"editedAt,omitempty"`
+}
+
+type CommentDeletion struct {
+	ID       string  `json:"id"`
+	PostID   string  `json:"postId"`
+	ParentID *string `json:"parentId,omitempty"`
 }
 
 type Mutation struct {
 }
 
+type PageInfo struct {
+	HasNextPage bool    `json:"hasNextPage"`
+	EndCursor   *string `json:"endCursor,omitempty"`
+}
+
 type Post struct {
-	ID              string     `json:"id"`
-	Title           string     `json:"title"`
-	Content         string     `json:"content"`
-	Author          string     `json:"author"`
-	CommentsEnabled bool       `json:"commentsEnabled"`
-	CreatedAt       time.Time  `json:"createdAt"`
-	Comments        []*Comment `json:"comments"`
+	ID              string        `json:"id"`
+	Title           string        `json:"title"`
+	Content         string        `json:"content"`
+	Format          ContentFormat `json:"format"`
+	Author          string        `json:"author"`
+	CommentsEnabled bool          `json:"commentsEnabled"`
+	CreatedAt       time.Time     `json:"createdAt"`
+}
+
+type PostFilter struct {
+	Author *string `json:"author,omitempty"`
+}
+
+type Presence struct {
+	PostID  string   `json:"postId"`
+	Viewers int      `json:"viewers"`
+	Typing  []string `json:"typing"`
 }
 
 type Query struct {
 }
 
+type Report struct {
+	ID           string                `json:"id"`
+	TargetID     string                `json:"targetId"`
+	TargetType   ReportTargetType      `json:"targetType"`
+	TargetAuthor string                `json:"targetAuthor"`
+	Reason       ReportReason          `json:"reason"`
+	Details      *string               `json:"details,omitempty"`
+	Reporter     string                `json:"reporter"`
+	Status       ReportStatus          `json:"status"`
+	CreatedAt    time.Time             `json:"createdAt"`
+	History      []*ReportStatusChange `json:"history"`
+}
+
+type ReportConnection struct {
+	Edges    []*ReportEdge `json:"edges"`
+	PageInfo *PageInfo     `json:"pageInfo"`
+}
+
+type ReportEdge struct {
+	Cursor string  `json:"cursor"`
+	Node   *Report `json:"node"`
+}
+
+type ReportStatusChange struct {
+	Status    ReportStatus `json:"status"`
+	Actor     string       `json:"actor"`
+	Note      *string      `json:"note,omitempty"`
+	ChangedAt time.Time    `json:"changedAt"`
+}
+
 type Subscription struct {
 }
+
+type AuditAction string
+
+const (
+	AuditActionCreatePost     AuditAction = "CREATE_POST"
+	AuditActionCreateComment  AuditAction = "CREATE_COMMENT"
+	AuditActionToggleComments AuditAction = "TOGGLE_COMMENTS"
+	AuditActionReportContent  AuditAction = "REPORT_CONTENT"
+	AuditActionDismissReport  AuditAction = "DISMISS_REPORT"
+	AuditActionRemoveContent  AuditAction = "REMOVE_CONTENT"
+	AuditActionWarnAuthor     AuditAction = "WARN_AUTHOR"
+	AuditActionUpdateComment  AuditAction = "UPDATE_COMMENT"
+	AuditActionDeleteComment  AuditAction = "DELETE_COMMENT"
+)
+
+var AllAuditAction = []AuditAction{
+	AuditActionCreatePost,
+	AuditActionCreateComment,
+	AuditActionToggleComments,
+	AuditActionReportContent,
+	AuditActionDismissReport,
+	AuditActionRemoveContent,
+	AuditActionWarnAuthor,
+	AuditActionUpdateComment,
+	AuditActionDeleteComment,
+}
+
+func (e AuditAction) IsValid() bool {
+	switch e {
+	case AuditActionCreatePost, AuditActionCreateComment, AuditActionToggleComments, AuditActionReportContent, AuditActionDismissReport, AuditActionRemoveContent, AuditActionWarnAuthor, AuditActionUpdateComment, AuditActionDeleteComment:
+		return true
+	}
+	return false
+}
+
+func (e AuditAction) String() string {
+	return string(e)
+}
+
+func (e *AuditAction) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = AuditAction(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid AuditAction", str)
+	}
+	return nil
+}
+
+func (e AuditAction) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *AuditAction) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e AuditAction) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type AuditTargetType string
+
+const (
+	AuditTargetTypePost    AuditTargetType = "POST"
+	AuditTargetTypeComment AuditTargetType = "COMMENT"
+	AuditTargetTypeReport  AuditTargetType = "REPORT"
+)
+
+var AllAuditTargetType = []AuditTargetType{
+	AuditTargetTypePost,
+	AuditTargetTypeComment,
+	AuditTargetTypeReport,
+}
+
+func (e AuditTargetType) IsValid() bool {
+	switch e {
+	case AuditTargetTypePost, AuditTargetTypeComment, AuditTargetTypeReport:
+		return true
+	}
+	return false
+}
+
+func (e AuditTargetType) String() string {
+	return string(e)
+}
+
+func (e *AuditTargetType) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = AuditTargetType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid AuditTargetType", str)
+	}
+	return nil
+}
+
+func (e AuditTargetType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *AuditTargetType) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e AuditTargetType) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type ContentFormat string
+
+const (
+	ContentFormatPlain    ContentFormat = "PLAIN"
+	ContentFormatMarkdown ContentFormat = "MARKDOWN"
+)
+
+var AllContentFormat = []ContentFormat{
+	ContentFormatPlain,
+	ContentFormatMarkdown,
+}
+
+func (e ContentFormat) IsValid() bool {
+	switch e {
+	case ContentFormatPlain, ContentFormatMarkdown:
+		return true
+	}
+	return false
+}
+
+func (e ContentFormat) String() string {
+	return string(e)
+}
+
+func (e *ContentFormat) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ContentFormat(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ContentFormat", str)
+	}
+	return nil
+}
+
+func (e ContentFormat) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *ContentFormat) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e ContentFormat) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type ReportReason string
+
+const (
+	ReportReasonSpam       ReportReason = "SPAM"
+	ReportReasonAbuse      ReportReason = "ABUSE"
+	ReportReasonHarassment ReportReason = "HARASSMENT"
+	ReportReasonOffTopic   ReportReason = "OFF_TOPIC"
+	ReportReasonOther      ReportReason = "OTHER"
+)
+
+var AllReportReason = []ReportReason{
+	ReportReasonSpam,
+	ReportReasonAbuse,
+	ReportReasonHarassment,
+	ReportReasonOffTopic,
+	ReportReasonOther,
+}
+
+func (e ReportReason) IsValid() bool {
+	switch e {
+	case ReportReasonSpam, ReportReasonAbuse, ReportReasonHarassment, ReportReasonOffTopic, ReportReasonOther:
+		return true
+	}
+	return false
+}
+
+func (e ReportReason) String() string {
+	return string(e)
+}
+
+func (e *ReportReason) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ReportReason(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ReportReason", str)
+	}
+	return nil
+}
+
+func (e ReportReason) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *ReportReason) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e ReportReason) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type ReportStatus string
+
+const (
+	ReportStatusOpen      ReportStatus = "OPEN"
+	ReportStatusDismissed ReportStatus = "DISMISSED"
+	ReportStatusRemoved   ReportStatus = "REMOVED"
+	ReportStatusWarned    ReportStatus = "WARNED"
+)
+
+var AllReportStatus = []ReportStatus{
+	ReportStatusOpen,
+	ReportStatusDismissed,
+	ReportStatusRemoved,
+	ReportStatusWarned,
+}
+
+func (e ReportStatus) IsValid() bool {
+	switch e {
+	case ReportStatusOpen, ReportStatusDismissed, ReportStatusRemoved, ReportStatusWarned:
+		return true
+	}
+	return false
+}
+
+func (e ReportStatus) String() string {
+	return string(e)
+}
+
+func (e *ReportStatus) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ReportStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ReportStatus", str)
+	}
+	return nil
+}
+
+func (e ReportStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *ReportStatus) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e ReportStatus) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type ReportTargetType string
+
+const (
+	ReportTargetTypePost    ReportTargetType = "POST"
+	ReportTargetTypeComment ReportTargetType = "COMMENT"
+)
+
+var AllReportTargetType = []ReportTargetType{
+	ReportTargetTypePost,
+	ReportTargetTypeComment,
+}
+
+func (e ReportTargetType) IsValid() bool {
+	switch e {
+	case ReportTargetTypePost, ReportTargetTypeComment:
+		return true
+	}
+	return false
+}
+
+func (e ReportTargetType) String() string {
+	return string(e)
+}
+
+func (e *ReportTargetType) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ReportTargetType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ReportTargetType", str)
+	}
+	return nil
+}
+
+func (e ReportTargetType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *ReportTargetType) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e ReportTargetType) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,117 @@
+package resolver
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"hivemind/graph/model"
+	"hivemind/internal/admin"
+	"log/slog"
+	"time"
+)
+
+const (
+	defaultAuditPageSize = 50
+	maxAuditPageSize     = 500
+)
+
+func (r *Resolver) AuditLog(ctx context.Context, admin string, filter *model.AuditLogFilter, first *int, after *string) (*model.AuditEntryConnection, error) {
+	if !r.isAdmin(ctx, admin) {
+		return nil, errors.New("only admins can view the audit log")
+	}
+	limit := defaultAuditPageSize
+	if first != nil {
+		if *first < 0 {
+			return nil, errors.New("first must not be negative")
+		}
+		limit = min(*first, maxAuditPageSize)
+	}
+	afterID := ""
+	if after != nil {
+		id, err := decodeCursor(*after)
+		if err != nil {
+			return nil, err
+		}
+		afterID = id
+	}
+	var f model.AuditLogFilter
+	if filter != nil {
+		f = *filter
+	}
+
+	entries, err := r.Storage.GetAuditEntries(ctx, f, limit+1, afterID)
+	if err != nil {
+		return nil, err
+	}
+	conn := &model.AuditEntryConnection{
+		Edges:    []*model.AuditEntryEdge{},
+		PageInfo: &model.PageInfo{HasNextPage: len(entries) > limit},
+	}
+	if len(entries) > limit {
+		entries = entries[:limit]
+	}
+	for _, entry := range entries {
+		conn.Edges = append(conn.Edges, &model.AuditEntryEdge{Cursor: encodeCursor(entry.ID), Node: entry})
+	}
+	if len(conn.Edges) > 0 {
+		conn.PageInfo.EndCursor = &conn.Edges[len(conn.Edges)-1].Cursor
+	}
+	return conn, nil
+}
+
+// recordAudit пишет запись в журнал аудита. Ошибка записи не отменяет уже
+// выполненную мутацию, поэтому она только логируется.
+func (r *Resolver) recordAudit(ctx context.Context, actor string, action model.AuditAction, targetType model.AuditTargetType, targetID string, before, after *string) {
+	entry := &model.AuditEntry{
+		Actor:      actor,
+		Action:     action,
+		TargetType: targetType,
+		TargetID:   targetID,
+		Before:     before,
+		After:      after,
+		CreatedAt:  time.Now(),
+	}
+	if err := r.Storage.CreateAuditEntry(ctx, entry); err != nil {
+		slog.ErrorContext(ctx, "failed to record audit entry", "action", action, "target_id", targetID, "error", err)
+	}
+}
+
+// snapshot сериализует состояние объекта без вложенных коллекций, чтобы
+// запись аудита не зависела от последующих изменений и не разрасталась.
+func snapshot(v any) *string {
+	switch obj := v.(type) {
+	case nil:
+		return nil
+	case *model.Post:
+		if obj == nil {
+			return nil
+		}
+	case *model.Comment:
+		if obj == nil {
+			return nil
+		}
+	case *model.Report:
+		if obj == nil {
+			return nil
+		}
+		report := *obj
+		report.History = nil
+		v = report
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil
+	}
+	s := string(data)
+	return &s
+}
+
+// isAdmin требует, чтобы имя администратора было подтверждено токеном
+// запроса, а не только передано аргументом.
+func (r *Resolver) isAdmin(ctx context.Context, name string) bool {
+	if admin.Name(ctx) != name {
+		return false
+	}
+	_, ok := r.admins[name]
+	return ok
+}
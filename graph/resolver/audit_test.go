@@ -0,0 +1,79 @@
+package resolver_test
+
+import (
+	"context"
+	"hivemind/graph/model"
+	"hivemind/graph/resolver"
+	"hivemind/internal/admin"
+	"hivemind/internal/storage/mocks"
+	"strings"
+	"testing"
+)
+
+func TestAuditLog(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("not an admin", func(t *testing.T) {
+		mockStorage := mocks.NewStorageMock(t)
+		res := resolver.NewResolver(mockStorage, resolver.WithAdmins("root"))
+		_, err := res.AuditLog(ctx, "bob", nil, nil, nil)
+		if err == nil || err.Error() != "only admins can view the audit log" {
+			t.Errorf("expected admin error, got: %v", err)
+		}
+	})
+
+	t.Run("admin name without token", func(t *testing.T) {
+		mockStorage := mocks.NewStorageMock(t)
+		res := resolver.NewResolver(mockStorage, resolver.WithAdmins("root"))
+		_, err := res.AuditLog(ctx, "root", nil, nil, nil)
+		if err == nil || err.Error() != "only admins can view the audit log" {
+			t.Errorf("expected admin error, got: %v", err)
+		}
+	})
+
+	t.Run("filter is passed to storage", func(t *testing.T) {
+		mockStorage := mocks.NewStorageMock(t)
+		actor := "alice"
+		filter := &model.AuditLogFilter{Actor: &actor}
+		ctx := admin.WithName(ctx, "root")
+		mockStorage.GetAuditEntriesMock.Expect(ctx, *filter, 51, "").Return([]*model.AuditEntry{
+			{ID: "1", Actor: "alice", Action: model.AuditActionCreatePost},
+		}, nil)
+
+		res := resolver.NewResolver(mockStorage, resolver.WithAdmins("root"))
+		conn, err := res.AuditLog(ctx, "root", filter, nil, nil)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(conn.Edges) != 1 || conn.PageInfo.HasNextPage {
+			t.Errorf("unexpected connection: %+v", conn)
+		}
+	})
+}
+
+func TestToggleCommentsAudit(t *testing.T) {
+	ctx := context.Background()
+	mockStorage := mocks.NewStorageMock(t)
+	mockStorage.GetPostByIDMock.Return(&model.Post{ID: "post123", Author: "alice", CommentsEnabled: true}, nil)
+	mockStorage.ToggleCommentsMock.Return(&model.Post{ID: "post123", Author: "alice", CommentsEnabled: false}, nil)
+
+	var entry *model.AuditEntry
+	mockStorage.CreateAuditEntryMock.Set(func(ctx context.Context, e *model.AuditEntry) error {
+		entry = e
+		return nil
+	})
+
+	res := resolver.NewResolver(mockStorage)
+	if _, err := res.ToggleComments(ctx, "post123", false, "alice"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if entry == nil || entry.Actor != "alice" || entry.Action != model.AuditActionToggleComments {
+		t.Fatalf("unexpected audit entry: %+v", entry)
+	}
+	if entry.Before == nil || !strings.Contains(*entry.Before, `"commentsEnabled":true`) {
+		t.Errorf("expected before snapshot with comments enabled, got: %v", entry.Before)
+	}
+	if entry.After == nil || !strings.Contains(*entry.After, `"commentsEnabled":false`) {
+		t.Errorf("expected after snapshot with comments disabled, got: %v", entry.After)
+	}
+}
@@ -4,11 +4,31 @@ import (
 	"context"
 	"errors"
 	"hivemind/graph/model"
+	"hivemind/internal/contentfilter"
+	"hivemind/internal/storage"
 	"time"
 )
 
-func (r *Resolver) CreateComment(ctx context.Context, postID string, parentID *string, content, author string) (*model.Comment, error) {
-	post, err := r.Storage.GetPostByID(ctx, postID)
+const (
+	defaultMaxCommentLength = 2000
+
+	defaultCommentPageSize = 20
+	maxCommentPageSize     = 100
+)
+
+func (r *Resolver) CreateComment(ctx context.Context, postID string, parentID *string, content, author string, format model.ContentFormat, clientMutationID *string) (*model.Comment, error) {
+	return idempotent(ctx, r, "createComment", author, clientMutationID, []any{postID, parentID, content, format},
+		func(id string) (*model.Comment, error) {
+			return r.createComment(ctx, id, postID, parentID, content, author, format)
+		},
+		r.Storage.GetCommentByID,
+	)
+}
+
+func (r *Resolver) createComment(ctx context.Context, id, postID string, parentID *string, content, author string, format model.ContentFormat) (*model.Comment, error) {
+	// Пост читается из основной базы: по устаревшей копии можно принять
+	// комментарий к посту, где комментарии уже выключены.
+	post, err := r.visiblePost(storage.WithPrimary(ctx), postID)
 	if err != nil {
 		return nil, err
 	}
@@ -17,36 +37,167 @@ func (r *Resolver) CreateComment(ctx context.Context, postID string, parentID *s
 		return nil, errors.New("commenting is disabled for this post")
 	}
 
-	if len(content) > 2000 {
+	if len(content) > r.maxCommentLength {
 		return nil, errors.New("comment too long")
 	}
 
+	checked := contentfilter.Content{
+		Kind:   contentfilter.KindComment,
+		Author: author,
+		PostID: postID,
+		Text:   content,
+	}
+	verdict, err := r.checkContent(ctx, checked)
+	if err != nil {
+		return nil, err
+	}
+
 	comment := &model.Comment{
-		ID:        GenerateID(),
+		ID:        id,
 		PostID:    postID,
 		ParentID:  parentID,
 		Author:    author,
 		Content:   content,
+		Format:    format,
 		CreatedAt: time.Now(),
-		Replies:   []*model.Comment{},
 	}
 
-	if err := r.Storage.CreateComment(ctx, comment); err != nil {
+	if err := r.Storage.CreateComment(ctx, comment, visibilityFor(verdict)); err != nil {
 		return nil, err
 	}
+	r.filter.Record(ctx, checked)
+	r.recordAudit(ctx, author, model.AuditActionCreateComment, model.AuditTargetTypeComment, comment.ID, nil, snapshot(comment))
 
-	r.NotifySubscribers(postID, comment)
+	switch verdict.Action {
+	case contentfilter.Allow:
+		r.NotifySubscribers(postID, comment)
+	case contentfilter.Hold:
+		r.holdForModeration(ctx, model.ReportTargetTypeComment, comment.ID, author, verdict)
+	}
 
 	return comment, nil
 }
 
-func (r *Resolver) NotifySubscribers(postID string, comment *model.Comment) {
-	r.mu.Lock()
-	defer r.mu.Unlock()
-	for _, ch := range r.subscribers[postID] {
-		select {
-		case ch <- comment:
-		default:
+func (r *Resolver) UpdateComment(ctx context.Context, id, content, author string) (*model.Comment, error) {
+	if len(content) > r.maxCommentLength {
+		return nil, errors.New("comment too long")
+	}
+	comment, err := r.Storage.GetCommentByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if comment.Author != author {
+		return nil, errors.New("only the author of the comment can edit it")
+	}
+	verdict, err := r.checkContent(ctx, contentfilter.Content{
+		Kind:   contentfilter.KindComment,
+		Author: author,
+		PostID: comment.PostID,
+		Text:   content,
+	})
+	if err != nil {
+		return nil, err
+	}
+	// Комментарий уже опубликован, поэтому правку нельзя отложить до решения
+	// модератора: всё, что фильтр не пропустил, отклоняется.
+	if verdict.Action != contentfilter.Allow {
+		return nil, errors.New("content rejected: " + verdict.Reason)
+	}
+
+	before := snapshot(comment)
+	updated, visibility, err := r.Storage.UpdateComment(ctx, id, content, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	r.recordAudit(ctx, author, model.AuditActionUpdateComment, model.AuditTargetTypeComment, id, before, snapshot(updated))
+	if visibility == storage.VisibilityPublic {
+		r.publishCommentUpdated(updated)
+	}
+	return updated, nil
+}
+
+func (r *Resolver) DeleteComment(ctx context.Context, id, author string) (bool, error) {
+	comment, err := r.Storage.GetCommentByID(ctx, id)
+	if err != nil {
+		return false, err
+	}
+	if comment.Author != author {
+		return false, errors.New("only the author of the comment can delete it")
+	}
+	before := snapshot(comment)
+	if err := r.Storage.RemoveComment(ctx, id); err != nil {
+		return false, err
+	}
+	r.recordAudit(ctx, author, model.AuditActionDeleteComment, model.AuditTargetTypeComment, id, before, nil)
+	r.publishCommentDeleted(comment)
+	return true, nil
+}
+
+func (r *Resolver) CommentContentHTML(ctx context.Context, comment *model.Comment) (string, error) {
+	return r.renderer.Render(comment.Format, comment.Content)
+}
+
+func (r *Resolver) CommentCursor(comment *model.Comment) string {
+	return encodeCursor(comment.ID)
+}
+
+// PostComments возвращает страницу комментариев верхнего уровня поста.
+func (r *Resolver) PostComments(ctx context.Context, post *model.Post, limit, offset *int) ([]*model.Comment, error) {
+	n, skip, err := commentPage(limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	comments, err := r.Storage.GetCommentsByPostID(ctx, post.ID, n, skip)
+	if err != nil {
+		return nil, err
+	}
+	return nonNil(comments), nil
+}
+
+// CommentReplies возвращает страницу ответов на комментарий.
+func (r *Resolver) CommentReplies(ctx context.Context, comment *model.Comment, limit, offset *int) ([]*model.Comment, error) {
+	n, skip, err := commentPage(limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	replies, err := r.Storage.GetReplies(ctx, comment.ID, n, skip)
+	if err != nil {
+		return nil, err
+	}
+	return nonNil(replies), nil
+}
+
+func commentPage(limit, offset *int) (n, skip int, err error) {
+	n = defaultCommentPageSize
+	if limit != nil {
+		if *limit < 0 {
+			return 0, 0, errors.New("limit must not be negative")
+		}
+		n = min(*limit, maxCommentPageSize)
+	}
+	if offset != nil {
+		if *offset < 0 {
+			return 0, 0, errors.New("offset must not be negative")
 		}
+		skip = *offset
+	}
+	return n, skip, nil
+}
+
+// nonNil заменяет пустой результат хранилища пустым списком: поле списка в
+// схеме не может быть null.
+func nonNil(comments []*model.Comment) []*model.Comment {
+	if comments == nil {
+		return []*model.Comment{}
+	}
+	return comments
+}
+
+// NotifySubscribers доставляет новый комментарий подписчикам поста и, если это
+// ответ, подписчикам родительского комментария.
+func (r *Resolver) NotifySubscribers(postID string, comment *model.Comment) {
+	r.events.commentAdded.publish(r.events, postID, comment)
+	if comment.ParentID != nil {
+		r.events.replyAdded.publish(r.events, *comment.ParentID, comment)
 	}
 }
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"hivemind/graph/model"
 	"hivemind/graph/resolver"
+	"hivemind/internal/storage"
 	"hivemind/internal/storage/mocks"
 	"testing"
 )
@@ -15,15 +16,16 @@ func TestCreateComment(t *testing.T) {
 	t.Run("success", func(t *testing.T) {
 		mockStorage := mocks.NewStorageMock(t)
 
-		mockStorage.GetPostByIDMock.Return(&model.Post{
+		mockStorage.GetPostWithVisibilityMock.Return(&model.Post{
 			ID:              "post123",
 			CommentsEnabled: true,
-		}, nil)
+		}, storage.VisibilityPublic, nil)
 
 		mockStorage.CreateCommentMock.Return(nil)
+		mockStorage.CreateAuditEntryMock.Return(nil)
 
 		res := resolver.NewResolver(mockStorage)
-		comment, err := res.CreateComment(ctx, "post123", nil, "Test comment", "textik")
+		comment, err := res.CreateComment(ctx, "post123", nil, "Test comment", "textik", model.ContentFormatPlain, nil)
 
 		if err != nil {
 			t.Fatalf("unexpected error: %v", err)
@@ -37,26 +39,42 @@ func TestCreateComment(t *testing.T) {
 	t.Run("post not found", func(t *testing.T) {
 		mockStorage := mocks.NewStorageMock(t)
 
-		mockStorage.GetPostByIDMock.Return(nil, errors.New("not found"))
+		mockStorage.GetPostWithVisibilityMock.Return(nil, "", errors.New("not found"))
 
 		res := resolver.NewResolver(mockStorage)
-		_, err := res.CreateComment(ctx, "missing", nil, "Test comment", "bob")
+		_, err := res.CreateComment(ctx, "missing", nil, "Test comment", "bob", model.ContentFormatPlain, nil)
 
 		if err == nil || err.Error() != "not found" {
 			t.Errorf("expected 'not found' error, got: %v", err)
 		}
 	})
 
+	t.Run("post held for moderation", func(t *testing.T) {
+		mockStorage := mocks.NewStorageMock(t)
+
+		mockStorage.GetPostWithVisibilityMock.Return(&model.Post{
+			ID:              "post123",
+			CommentsEnabled: true,
+		}, storage.VisibilityHeld, nil)
+
+		res := resolver.NewResolver(mockStorage)
+		_, err := res.CreateComment(ctx, "post123", nil, "Test comment", "bob", model.ContentFormatPlain, nil)
+
+		if err == nil || err.Error() != "post not found" {
+			t.Errorf("expected 'post not found' error, got: %v", err)
+		}
+	})
+
 	t.Run("comments disabled", func(t *testing.T) {
 		mockStorage := mocks.NewStorageMock(t)
 
-		mockStorage.GetPostByIDMock.Return(&model.Post{
+		mockStorage.GetPostWithVisibilityMock.Return(&model.Post{
 			ID:              "post456",
 			CommentsEnabled: false,
-		}, nil)
+		}, storage.VisibilityPublic, nil)
 
 		res := resolver.NewResolver(mockStorage)
-		_, err := res.CreateComment(ctx, "post456", nil, "Test comment", "bob")
+		_, err := res.CreateComment(ctx, "post456", nil, "Test comment", "bob", model.ContentFormatPlain, nil)
 
 		if err == nil || err.Error() != "commenting is disabled for this post" {
 			t.Errorf("expected 'commenting is disabled' error, got: %v", err)
@@ -66,10 +84,10 @@ func TestCreateComment(t *testing.T) {
 	t.Run("comment too long", func(t *testing.T) {
 		mockStorage := mocks.NewStorageMock(t)
 
-		mockStorage.GetPostByIDMock.Return(&model.Post{
+		mockStorage.GetPostWithVisibilityMock.Return(&model.Post{
 			ID:              "post789",
 			CommentsEnabled: true,
-		}, nil)
+		}, storage.VisibilityPublic, nil)
 
 		longComment := make([]byte, 2001)
 		for i := range longComment {
@@ -77,7 +95,23 @@ func TestCreateComment(t *testing.T) {
 		}
 
 		res := resolver.NewResolver(mockStorage)
-		_, err := res.CreateComment(ctx, "post789", nil, string(longComment), "bob")
+		_, err := res.CreateComment(ctx, "post789", nil, string(longComment), "bob", model.ContentFormatPlain, nil)
+
+		if err == nil || err.Error() != "comment too long" {
+			t.Errorf("expected 'comment too long' error, got: %v", err)
+		}
+	})
+
+	t.Run("configured max comment length", func(t *testing.T) {
+		mockStorage := mocks.NewStorageMock(t)
+
+		mockStorage.GetPostWithVisibilityMock.Return(&model.Post{
+			ID:              "post789",
+			CommentsEnabled: true,
+		}, storage.VisibilityPublic, nil)
+
+		res := resolver.NewResolver(mockStorage, resolver.WithMaxCommentLength(10))
+		_, err := res.CreateComment(ctx, "post789", nil, "more than ten bytes", "bob", model.ContentFormatPlain, nil)
 
 		if err == nil || err.Error() != "comment too long" {
 			t.Errorf("expected 'comment too long' error, got: %v", err)
@@ -87,18 +121,61 @@ func TestCreateComment(t *testing.T) {
 	t.Run("CreateComment fails", func(t *testing.T) {
 		mockStorage := mocks.NewStorageMock(t)
 
-		mockStorage.GetPostByIDMock.Return(&model.Post{
+		mockStorage.GetPostWithVisibilityMock.Return(&model.Post{
 			ID:              "post321",
 			CommentsEnabled: true,
-		}, nil)
+		}, storage.VisibilityPublic, nil)
 
 		mockStorage.CreateCommentMock.Return(errors.New("db failure"))
 
 		res := resolver.NewResolver(mockStorage)
-		_, err := res.CreateComment(ctx, "post321", nil, "Test comment", "alice")
+		_, err := res.CreateComment(ctx, "post321", nil, "Test comment", "alice", model.ContentFormatPlain, nil)
 
 		if err == nil || err.Error() != "db failure" {
 			t.Errorf("expected 'db failure' error, got: %v", err)
 		}
 	})
 }
+
+func TestCommentPages(t *testing.T) {
+	ctx := context.Background()
+	post := &model.Post{ID: "post123"}
+	intp := func(n int) *int { return &n }
+
+	t.Run("default page", func(t *testing.T) {
+		mockStorage := mocks.NewStorageMock(t)
+		mockStorage.GetCommentsByPostIDMock.Expect(ctx, "post123", 20, 0).Return(nil, nil)
+
+		res := resolver.NewResolver(mockStorage)
+		comments, err := res.PostComments(ctx, post, nil, nil)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if comments == nil || len(comments) != 0 {
+			t.Errorf("expected empty list, got: %v", comments)
+		}
+	})
+
+	t.Run("limit is capped", func(t *testing.T) {
+		mockStorage := mocks.NewStorageMock(t)
+		mockStorage.GetRepliesMock.Expect(ctx, "comment1", 100, 5).Return([]*model.Comment{{ID: "reply1"}}, nil)
+
+		res := resolver.NewResolver(mockStorage)
+		replies, err := res.CommentReplies(ctx, &model.Comment{ID: "comment1"}, intp(1000000), intp(5))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(replies) != 1 || replies[0].ID != "reply1" {
+			t.Errorf("unexpected replies: %v", replies)
+		}
+	})
+
+	t.Run("negative offset", func(t *testing.T) {
+		res := resolver.NewResolver(mocks.NewStorageMock(t))
+		_, err := res.PostComments(ctx, post, nil, intp(-1))
+
+		if err == nil || err.Error() != "offset must not be negative" {
+			t.Errorf("expected 'offset must not be negative' error, got: %v", err)
+		}
+	})
+}
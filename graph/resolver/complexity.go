@@ -0,0 +1,177 @@
+package resolver
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"hivemind/graph/generated"
+	"hivemind/graph/model"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/errcode"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+const (
+	ErrDepthLimit = "DEPTH_LIMIT_EXCEEDED"
+
+	// listEstimate — ожидаемая длина ленты posts, размер которой клиент не
+	// задаёт.
+	listEstimate = 20
+	// maxComplexity ограничивает оценку, чтобы произведение огромных limit
+	// не переполнилось и не стало отрицательным.
+	maxComplexity = math.MaxInt32
+	// mutationCost учитывает, что запись дороже чтения поля.
+	mutationCost = 10
+)
+
+// Complexity возвращает оценки стоимости полей для расчёта сложности
+// запроса. Поле без оценки стоит 1 плюс стоимость вложенных полей; списки
+// умножают стоимость элемента на запрошенный размер страницы.
+func Complexity() generated.ComplexityRoot {
+	var c generated.ComplexityRoot
+
+	c.Query.Posts = func(childComplexity int) int {
+		return list(childComplexity, nil, listEstimate)
+	}
+	c.Query.ModerationQueue = func(childComplexity int, moderator string, status *model.ReportStatus, first *int, after *string) int {
+		return list(childComplexity, capped(first, maxQueuePageSize), defaultQueuePageSize)
+	}
+	c.Query.AuditLog = func(childComplexity int, admin string, filter *model.AuditLogFilter, first *int, after *string) int {
+		return list(childComplexity, capped(first, maxAuditPageSize), defaultAuditPageSize)
+	}
+	c.Post.Comments = func(childComplexity int, limit *int, offset *int) int {
+		return list(childComplexity, capped(limit, maxCommentPageSize), defaultCommentPageSize)
+	}
+	c.Comment.Replies = func(childComplexity int, limit *int, offset *int) int {
+		return list(childComplexity, capped(limit, maxCommentPageSize), defaultCommentPageSize)
+	}
+	c.Subscription.CommentAdded = func(childComplexity int, postID string, since *time.Time, afterCursor *string) int {
+		// Повтор пропущенных комментариев отдаёт их страницами.
+		if since != nil || afterCursor != nil {
+			return list(childComplexity, nil, replayPageSize)
+		}
+		return add(childComplexity, 1)
+	}
+
+	mutation := func(childComplexity int) int {
+		return add(childComplexity, mutationCost)
+	}
+	c.Mutation.CreatePost = func(childComplexity int, title string, content string, author string, format model.ContentFormat, clientMutationID *string) int {
+		return mutation(childComplexity)
+	}
+	c.Mutation.CreateComment = func(childComplexity int, postID string, parentID *string, content string, author string, format model.ContentFormat, clientMutationID *string) int {
+		return mutation(childComplexity)
+	}
+	c.Mutation.ToggleComments = func(childComplexity int, postID string, enabled bool, author string) int {
+		return mutation(childComplexity)
+	}
+	c.Mutation.UpdateComment = func(childComplexity int, id string, content string, author string) int {
+		return mutation(childComplexity)
+	}
+	c.Mutation.DeleteComment = func(childComplexity int, id string, author string) int {
+		return mutation(childComplexity)
+	}
+	c.Mutation.ReportContent = func(childComplexity int, targetID string, reason model.ReportReason, details *string, reporter string) int {
+		return mutation(childComplexity)
+	}
+	c.Mutation.DismissReport = func(childComplexity int, reportID string, moderator string, note *string) int {
+		return mutation(childComplexity)
+	}
+	c.Mutation.RemoveReportedContent = func(childComplexity int, reportID string, moderator string, note *string) int {
+		return mutation(childComplexity)
+	}
+	c.Mutation.WarnReportedAuthor = func(childComplexity int, reportID string, moderator string, note *string) int {
+		return mutation(childComplexity)
+	}
+	return c
+}
+
+// capped повторяет ограничение размера страницы, которое применяет резолвер.
+func capped(n *int, limit int) *int {
+	if n == nil || *n <= limit {
+		return n
+	}
+	return &limit
+}
+
+// list оценивает поле-список: стоимость элемента, умноженная на limit или
+// на estimate, если limit не передан.
+func list(childComplexity int, limit *int, estimate int) int {
+	n := estimate
+	if limit != nil {
+		n = max(*limit, 0)
+	}
+	if n > 0 && childComplexity > maxComplexity/n {
+		return maxComplexity
+	}
+	return add(n*childComplexity, 1)
+}
+
+func add(a, b int) int {
+	return min(a+b, maxComplexity)
+}
+
+// DepthLimit — расширение gqlgen, отклоняющее операции с вложенностью полей
+// больше Max. Рекурсивные поля вроде Comment.replies позволяют иначе строить
+// запросы произвольной глубины. Поля интроспекции не учитываются: их
+// стандартный запрос глубже любых полезных запросов к данным.
+type DepthLimit struct {
+	Max int
+}
+
+var _ interface {
+	graphql.HandlerExtension
+	graphql.OperationContextMutator
+} = DepthLimit{}
+
+func (DepthLimit) ExtensionName() string {
+	return "DepthLimit"
+}
+
+func (DepthLimit) Validate(graphql.ExecutableSchema) error {
+	return nil
+}
+
+func (l DepthLimit) MutateOperationContext(ctx context.Context, opCtx *graphql.OperationContext) *gqlerror.Error {
+	if l.Max <= 0 || opCtx.Operation == nil {
+		return nil
+	}
+	if d := depth(opCtx.Operation.SelectionSet, map[string]int{}); d > l.Max {
+		err := gqlerror.Errorf("operation has depth %d, which exceeds the limit of %d", d, l.Max)
+		errcode.Set(err, ErrDepthLimit)
+		return err
+	}
+	return nil
+}
+
+// depth возвращает наибольшую вложенность полей. Фрагменты не добавляют
+// уровня; циклы между ними отсекает валидация запроса. Глубина каждого
+// фрагмента считается один раз, иначе многократные ссылки на вложенные
+// фрагменты дают экспоненциальный обход.
+func depth(set ast.SelectionSet, fragments map[string]int) int {
+	deepest := 0
+	for _, sel := range set {
+		var d int
+		switch sel := sel.(type) {
+		case *ast.Field:
+			if sel.Name == "__schema" || sel.Name == "__type" {
+				continue
+			}
+			d = 1 + depth(sel.SelectionSet, fragments)
+		case *ast.InlineFragment:
+			d = depth(sel.SelectionSet, fragments)
+		case *ast.FragmentSpread:
+			known, ok := fragments[sel.Name]
+			if !ok && sel.Definition != nil {
+				known = depth(sel.Definition.SelectionSet, fragments)
+				fragments[sel.Name] = known
+			}
+			d = known
+		}
+		deepest = max(deepest, d)
+	}
+	return deepest
+}
@@ -0,0 +1,113 @@
+package resolver_test
+
+import (
+	"context"
+	"testing"
+
+	"hivemind/graph/generated"
+	"hivemind/graph/model"
+	"hivemind/graph/resolver"
+	"hivemind/internal/memory"
+
+	"github.com/99designs/gqlgen/client"
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newLimitedClient(maxDepth, maxComplexity int) *client.Client {
+	res := resolver.NewResolver(memory.NewMemoryStorage())
+	srv := handler.New(generated.NewExecutableSchema(generated.Config{Resolvers: res, Complexity: resolver.Complexity()}))
+	srv.AddTransport(transport.POST{})
+	srv.Use(extension.Introspection{})
+	srv.Use(resolver.DepthLimit{Max: maxDepth})
+	srv.Use(extension.FixedComplexityLimit(maxComplexity))
+	return client.New(srv)
+}
+
+func TestDepthLimit(t *testing.T) {
+	c := newLimitedClient(4, 1_000_000)
+	var resp map[string]any
+
+	t.Run("shallow query passes", func(t *testing.T) {
+		require.NoError(t, c.Post(`{ posts { comments { replies { id } } } }`, &resp))
+	})
+
+	t.Run("nested replies are rejected", func(t *testing.T) {
+		err := c.Post(`{ posts { comments { replies { replies { id } } } } }`, &resp)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "operation has depth 5, which exceeds the limit of 4")
+	})
+
+	t.Run("fragments are followed", func(t *testing.T) {
+		err := c.Post(`
+			query { posts { comments { ...Thread } } }
+			fragment Thread on Comment { replies { ...Leaf } }
+			fragment Leaf on Comment { replies { id } }
+		`, &resp)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "operation has depth 5")
+	})
+
+	t.Run("introspection is not counted", func(t *testing.T) {
+		require.NoError(t, c.Post(`{ __schema { types { fields { type { ofType { ofType { name } } } } } } }`, &resp))
+	})
+}
+
+func TestComplexity(t *testing.T) {
+	c := newLimitedClient(0, 500)
+	var resp map[string]any
+
+	t.Run("limit argument scales the cost", func(t *testing.T) {
+		// posts = 1 + 20 × (id + comments), comments = 1 + limit × (id + author)
+		require.NoError(t, c.Post(`{ posts { id comments(limit: 10) { id author } } }`, &resp))
+
+		err := c.Post(`{ posts { id comments(limit: 30) { id author } } }`, &resp)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "operation has complexity 1241, which exceeds the limit of 500")
+	})
+
+	t.Run("limit is capped like in the resolver", func(t *testing.T) {
+		// posts = 1 + 20 × comments, comments = 1 + min(limit, 100) × id
+		err := c.Post(`{ posts { comments(limit: 1000) { id } } }`, &resp)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "operation has complexity 2021, which exceeds the limit of 500")
+	})
+
+	t.Run("huge limits do not overflow", func(t *testing.T) {
+		err := c.Post(`{ posts { comments(limit: 2000000000) { replies(limit: 2000000000) { replies(limit: 2000000000) { id } } } } }`, &resp)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "exceeds the limit of 500")
+	})
+}
+
+func TestCommentPageMatchesComplexity(t *testing.T) {
+	ctx := context.Background()
+	store := memory.NewMemoryStorage()
+	res := resolver.NewResolver(store)
+	post, err := res.CreatePost(ctx, "title", "content", "alice", model.ContentFormatPlain, nil)
+	require.NoError(t, err)
+	for range 3 {
+		_, err := res.CreateComment(ctx, post.ID, nil, "comment", "bob", model.ContentFormatPlain, nil)
+		require.NoError(t, err)
+	}
+
+	srv := handler.New(generated.NewExecutableSchema(generated.Config{Resolvers: res, Complexity: resolver.Complexity()}))
+	srv.AddTransport(transport.POST{})
+	srv.Use(extension.FixedComplexityLimit(100))
+	c := client.New(srv)
+
+	var resp struct {
+		Post struct {
+			Comments []struct{ ID string }
+		}
+	}
+	// Пустая страница стоит дёшево, поэтому и комментариев не отдаёт.
+	require.NoError(t, c.Post(`query($id: ID!) { post(id: $id) { comments(limit: 0) { id } } }`, &resp, client.Var("id", post.ID)))
+	assert.Empty(t, resp.Post.Comments)
+
+	require.NoError(t, c.Post(`query($id: ID!) { post(id: $id) { comments(limit: 2, offset: 1) { id } } }`, &resp, client.Var("id", post.ID)))
+	assert.Len(t, resp.Post.Comments, 2)
+}
@@ -0,0 +1,91 @@
+package resolver
+
+import (
+	"context"
+	"errors"
+	"hivemind/graph/model"
+	"hivemind/internal/contentfilter"
+	"hivemind/internal/storage"
+	"log/slog"
+	"time"
+)
+
+// contentFilterReporter — автор жалоб, которые создаёт фильтр контента при
+// отправке записи на модерацию.
+const contentFilterReporter = "content-filter"
+
+func (r *Resolver) checkContent(ctx context.Context, c contentfilter.Content) (contentfilter.Verdict, error) {
+	verdict, err := r.filter.Check(ctx, c)
+	if err != nil {
+		return verdict, err
+	}
+	if verdict.Action == contentfilter.Reject {
+		return verdict, errors.New("content rejected: " + verdict.Reason)
+	}
+	return verdict, nil
+}
+
+func visibilityFor(v contentfilter.Verdict) storage.Visibility {
+	switch v.Action {
+	case contentfilter.Hold:
+		return storage.VisibilityHeld
+	case contentfilter.ShadowHide:
+		return storage.VisibilityShadowHidden
+	}
+	return storage.VisibilityPublic
+}
+
+// holdForModeration ставит задержанную фильтром запись в очередь модерации.
+// Запись уже сохранена, поэтому ошибка только логируется.
+func (r *Resolver) holdForModeration(ctx context.Context, targetType model.ReportTargetType, targetID, author string, v contentfilter.Verdict) {
+	now := time.Now()
+	details := v.Filter + ": " + v.Reason
+	report := &model.Report{
+		ID:           GenerateID(),
+		TargetID:     targetID,
+		TargetType:   targetType,
+		TargetAuthor: author,
+		Reason:       model.ReportReasonSpam,
+		Details:      &details,
+		Reporter:     contentFilterReporter,
+		Status:       model.ReportStatusOpen,
+		CreatedAt:    now,
+		History: []*model.ReportStatusChange{{
+			Status:    model.ReportStatusOpen,
+			Actor:     contentFilterReporter,
+			Note:      &details,
+			ChangedAt: now,
+		}},
+	}
+	if err := r.Storage.CreateReport(ctx, report); err != nil {
+		slog.ErrorContext(ctx, "failed to hold content for moderation", "target_type", targetType, "target_id", targetID, "error", err)
+	}
+}
+
+// publishHeldContent открывает запись, задержанную фильтром, после того как
+// модератор отклонил жалобу. Ошибка возвращается, только если запись не
+// открыта: после публикации жалобу уже нельзя вернуть в очередь.
+func (r *Resolver) publishHeldContent(ctx context.Context, report *model.Report) error {
+	if report.TargetType == model.ReportTargetTypePost {
+		if err := r.Storage.PublishPost(ctx, report.TargetID); err != nil {
+			return err
+		}
+		post, err := r.Storage.GetPostByID(storage.WithPrimary(ctx), report.TargetID)
+		if err != nil {
+			slog.ErrorContext(ctx, "failed to load published post for subscribers", "post_id", report.TargetID, "error", err)
+			return nil
+		}
+		r.publishPostCreated(post)
+		return nil
+	}
+	if err := r.Storage.PublishComment(ctx, report.TargetID); err != nil {
+		return err
+	}
+	comment, err := r.Storage.GetCommentByID(ctx, report.TargetID)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to load published comment for subscribers", "comment_id", report.TargetID, "error", err)
+		return nil
+	}
+	r.NotifySubscribers(comment.PostID, comment)
+	return nil
+}
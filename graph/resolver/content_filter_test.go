@@ -0,0 +1,150 @@
+package resolver_test
+
+import (
+	"context"
+	"errors"
+	"hivemind/graph/model"
+	"hivemind/graph/resolver"
+	"hivemind/internal/contentfilter"
+	"hivemind/internal/memory"
+	"hivemind/internal/storage"
+	"hivemind/internal/storage/mocks"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateCommentContentFilter(t *testing.T) {
+	ctx := context.Background()
+	pipeline := contentfilter.NewPipeline(
+		contentfilter.NewBannedWords([]string{"casino"}, contentfilter.Reject),
+		contentfilter.NewBannedWords([]string{"pills"}, contentfilter.ShadowHide),
+		contentfilter.NewLinkLimit(0, contentfilter.Hold),
+	)
+	post := &model.Post{ID: "post123", CommentsEnabled: true}
+
+	t.Run("rejected", func(t *testing.T) {
+		mockStorage := mocks.NewStorageMock(t)
+		mockStorage.GetPostWithVisibilityMock.Return(post, storage.VisibilityPublic, nil)
+
+		res := resolver.NewResolver(mockStorage, resolver.WithContentFilter(pipeline))
+		_, err := res.CreateComment(ctx, "post123", nil, "visit my casino", "bob", model.ContentFormatPlain, nil)
+		if err == nil || err.Error() != "content rejected: contains banned word" {
+			t.Errorf("expected 'content rejected' error, got: %v", err)
+		}
+	})
+
+	t.Run("held for moderation", func(t *testing.T) {
+		mockStorage := mocks.NewStorageMock(t)
+		mockStorage.GetPostWithVisibilityMock.Return(post, storage.VisibilityPublic, nil)
+		mockStorage.CreateCommentMock.Set(func(ctx context.Context, comment *model.Comment, visibility storage.Visibility) error {
+			assert.Equal(t, storage.VisibilityHeld, visibility)
+			return nil
+		})
+		mockStorage.CreateAuditEntryMock.Return(nil)
+		mockStorage.CreateReportMock.Set(func(ctx context.Context, report *model.Report) error {
+			assert.Equal(t, model.ReportTargetTypeComment, report.TargetType)
+			assert.Equal(t, model.ReportStatusOpen, report.Status)
+			return nil
+		})
+
+		res := resolver.NewResolver(mockStorage, resolver.WithContentFilter(pipeline))
+		commentChan := res.Subscribe("post123")
+		if _, err := res.CreateComment(ctx, "post123", nil, "see https://example.com", "bob", model.ContentFormatPlain, nil); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		select {
+		case <-commentChan:
+			t.Error("held comment must not be delivered to subscribers")
+		case <-time.After(100 * time.Millisecond):
+		}
+	})
+
+	t.Run("shadow hidden", func(t *testing.T) {
+		mockStorage := mocks.NewStorageMock(t)
+		mockStorage.GetPostWithVisibilityMock.Return(post, storage.VisibilityPublic, nil)
+		mockStorage.CreateCommentMock.Set(func(ctx context.Context, comment *model.Comment, visibility storage.Visibility) error {
+			assert.Equal(t, storage.VisibilityShadowHidden, visibility)
+			return nil
+		})
+		mockStorage.CreateAuditEntryMock.Return(nil)
+
+		res := resolver.NewResolver(mockStorage, resolver.WithContentFilter(pipeline))
+		comment, err := res.CreateComment(ctx, "post123", nil, "cheap pills", "bob", model.ContentFormatPlain, nil)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if comment.Content != "cheap pills" {
+			t.Errorf("author must get the comment back, got: %+v", comment)
+		}
+	})
+}
+
+func TestDismissHeldContent(t *testing.T) {
+	ctx := context.Background()
+	mockStorage := mocks.NewStorageMock(t)
+	report := openReport("r1", "comment123", model.ReportTargetTypeComment)
+	report.Reporter = "content-filter"
+	mockStorage.GetReportByIDMock.Return(report, nil)
+	mockStorage.PublishCommentMock.Expect(ctx, "comment123").Return(nil)
+	mockStorage.GetCommentByIDMock.Return(&model.Comment{ID: "comment123", PostID: "post123"}, nil)
+	mockStorage.UpdateReportStatusMock.Return(&model.Report{ID: "r1", Status: model.ReportStatusDismissed}, nil)
+	mockStorage.CreateAuditEntryMock.Return(nil)
+
+	res := resolver.NewResolver(mockStorage, resolver.WithModerators("mod"))
+	commentChan := res.Subscribe("post123")
+	if _, err := res.DismissReport(ctx, "r1", "mod", nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	select {
+	case c := <-commentChan:
+		assert.Equal(t, "comment123", c.ID)
+	case <-time.After(time.Second):
+		t.Error("published comment must be delivered to subscribers")
+	}
+}
+
+func TestDismissDoesNotPublishShadowHidden(t *testing.T) {
+	ctx := context.Background()
+	store := memory.NewMemoryStorage()
+	post := &model.Post{ID: "post123", Author: "alice"}
+	require.NoError(t, store.CreatePost(ctx, post, storage.VisibilityShadowHidden))
+	report := openReport("r1", post.ID, model.ReportTargetTypePost)
+	report.Reporter = "content-filter"
+	require.NoError(t, store.CreateReport(ctx, report))
+
+	res := resolver.NewResolver(store, resolver.WithModerators("mod"))
+	_, err := res.DismissReport(ctx, "r1", "mod", nil)
+	require.EqualError(t, err, "held post not found")
+
+	_, visibility, err := store.GetPostWithVisibility(ctx, post.ID)
+	require.NoError(t, err)
+	assert.Equal(t, storage.VisibilityShadowHidden, visibility)
+
+	reopened, err := store.GetReportByID(ctx, "r1")
+	require.NoError(t, err)
+	assert.Equal(t, model.ReportStatusOpen, reopened.Status)
+}
+
+func TestDuplicateAfterFailedCreate(t *testing.T) {
+	ctx := context.Background()
+	pipeline := contentfilter.NewPipeline(contentfilter.NewDuplicate(time.Minute, contentfilter.Reject))
+
+	mockStorage := mocks.NewStorageMock(t)
+	mockStorage.CreatePostMock.Return(errors.New("db failure"))
+	res := resolver.NewResolver(mockStorage, resolver.WithContentFilter(pipeline))
+	_, err := res.CreatePost(ctx, "Title", "Content", "alice", model.ContentFormatPlain, nil)
+	require.EqualError(t, err, "db failure")
+
+	mockStorage = mocks.NewStorageMock(t)
+	mockStorage.CreatePostMock.Return(nil)
+	mockStorage.CreateAuditEntryMock.Return(nil)
+	res = resolver.NewResolver(mockStorage, resolver.WithContentFilter(pipeline))
+	_, err = res.CreatePost(ctx, "Title", "Content", "alice", model.ContentFormatPlain, nil)
+	require.NoError(t, err, "a post that was not saved must not count as a duplicate")
+
+	_, err = res.CreatePost(ctx, "Title", "Content", "alice", model.ContentFormatPlain, nil)
+	require.EqualError(t, err, "content rejected: duplicate content")
+}
@@ -0,0 +1,170 @@
+package resolver
+
+import (
+	"context"
+	"errors"
+	"hivemind/internal/hub"
+	"sync"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/errcode"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+const (
+	ErrEventsMissed      = "EVENTS_MISSED"
+	ErrSubscriberTooSlow = "SUBSCRIBER_TOO_SLOW"
+	ErrShuttingDown      = "SHUTTING_DOWN"
+
+	defaultSubscriptionQueueSize = 64
+)
+
+var _ hub.Notifier = (*deliveryNotice)(nil)
+
+func (r *Resolver) DeliveryStats() hub.Stats {
+	return r.events.stats()
+}
+
+// TopicStats возвращает счётчики доставки по каждой теме подписок.
+func (r *Resolver) TopicStats() map[string]hub.Stats {
+	stats := make(map[string]hub.Stats, len(r.events.topics))
+	for name, t := range r.events.topics {
+		stats[name] = t.stats()
+	}
+	return stats
+}
+
+// PostSubscribers возвращает число активных подписок на события каждого поста.
+func (r *Resolver) PostSubscribers() map[string]int {
+	counts := make(map[string]int)
+	for _, name := range postTopics {
+		for postID, n := range r.events.topics[name].keys() {
+			counts[postID] += n
+		}
+	}
+	return counts
+}
+
+// Drain завершает все подписки реплики и отклоняет новые: клиенты получают
+// complete и переподключаются к другой реплике.
+func (r *Resolver) Drain() {
+	r.events.close()
+}
+
+// SubscriptionHealth сообщает состояние хабов подписок для проверки
+// готовности: после Drain реплика новых подписок не принимает.
+func (r *Resolver) SubscriptionHealth(ctx context.Context) (any, error) {
+	stats := r.events.stats()
+	if r.events.closed.Load() {
+		return stats, errors.New("subscriptions are drained")
+	}
+	return stats, nil
+}
+
+func shuttingDownError() *gqlerror.Error {
+	err := gqlerror.Errorf("server is shutting down, reconnect later")
+	errcode.Set(err, ErrShuttingDown)
+	return err
+}
+
+// deliveryNotice хранит сообщение для клиента, которое нельзя передать
+// событием подписки: число пропущенных событий или причину отключения.
+type deliveryNotice struct {
+	mu     sync.Mutex
+	missed int
+	err    *gqlerror.Error
+}
+
+type noticeKey struct{}
+
+func noticeFromContext(ctx context.Context) *deliveryNotice {
+	n, _ := ctx.Value(noticeKey{}).(*deliveryNotice)
+	return n
+}
+
+func (n *deliveryNotice) Missed(count int) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.missed += count
+}
+
+func (n *deliveryNotice) Disconnected() {
+	err := gqlerror.Errorf("subscriber is too slow, subscription closed")
+	errcode.Set(err, ErrSubscriberTooSlow)
+	n.fail(err)
+}
+
+func (n *deliveryNotice) fail(err *gqlerror.Error) {
+	if n == nil {
+		return
+	}
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.err = err
+}
+
+func (n *deliveryNotice) takeMissed() *graphql.Response {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if n.missed == 0 {
+		return nil
+	}
+	err := gqlerror.Errorf("missed %d events, refetch the data", n.missed)
+	errcode.Set(err, ErrEventsMissed)
+	err.Extensions["missed"] = n.missed
+	n.missed = 0
+	return &graphql.Response{Errors: gqlerror.List{err}}
+}
+
+func (n *deliveryNotice) takeErr() *graphql.Response {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if n.err == nil {
+		return nil
+	}
+	err := n.err
+	n.err = nil
+	return &graphql.Response{Errors: gqlerror.List{err}}
+}
+
+// SubscriptionNotices — расширение gqlgen, которое передаёт подписчику
+// уведомления о пропущенных событиях и ошибку при отключении.
+type SubscriptionNotices struct{}
+
+var _ interface {
+	graphql.HandlerExtension
+	graphql.OperationInterceptor
+	graphql.ResponseInterceptor
+} = SubscriptionNotices{}
+
+func (SubscriptionNotices) ExtensionName() string {
+	return "SubscriptionNotices"
+}
+
+func (SubscriptionNotices) Validate(schema graphql.ExecutableSchema) error {
+	return nil
+}
+
+func (SubscriptionNotices) InterceptOperation(ctx context.Context, next graphql.OperationHandler) graphql.ResponseHandler {
+	op := graphql.GetOperationContext(ctx).Operation
+	if op == nil || op.Operation != ast.Subscription {
+		return next(ctx)
+	}
+	return next(context.WithValue(ctx, noticeKey{}, &deliveryNotice{}))
+}
+
+func (SubscriptionNotices) InterceptResponse(ctx context.Context, next graphql.ResponseHandler) *graphql.Response {
+	n := noticeFromContext(ctx)
+	if n == nil {
+		return next(ctx)
+	}
+	if resp := n.takeMissed(); resp != nil {
+		return resp
+	}
+	if resp := next(ctx); resp != nil {
+		return resp
+	}
+	// Канал закрыт: если подписчика отключили, клиент должен узнать причину.
+	return n.takeErr()
+}
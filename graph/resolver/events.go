@@ -0,0 +1,223 @@
+package resolver
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hivemind/graph/model"
+	"hivemind/internal/hub"
+	"hivemind/internal/pubsub"
+	"hivemind/internal/storage"
+	"log/slog"
+	"sync/atomic"
+)
+
+const eventsTopic = "hivemind_events"
+
+// Имена тем шины совпадают с полями Subscription и передаются между репликами.
+const (
+	topicPostCreated    = "postCreated"
+	topicPostUpdated    = "postUpdated"
+	topicCommentAdded   = "commentAdded"
+	topicCommentUpdated = "commentUpdated"
+	topicCommentDeleted = "commentDeleted"
+	topicReplyAdded     = "replyAdded"
+	topicPresence       = "presenceChanged"
+)
+
+// postTopics — темы, ключом которых служит ID поста.
+var postTopics = []string{topicCommentAdded, topicCommentUpdated, topicCommentDeleted, topicPostUpdated, topicPresence}
+
+// busEvent — событие шины между репликами. Если запись не помещается в
+// сообщение брокера, передаётся только её ID, и получатель загружает её сам.
+type busEvent struct {
+	Origin string          `json:"origin"`
+	Topic  string          `json:"topic"`
+	Key    string          `json:"key"`
+	Data   json.RawMessage `json:"data,omitempty"`
+	ID     string          `json:"id,omitempty"`
+}
+
+// eventBus — типизированная шина событий. Мутации публикуют в неё, подписки
+// читают из хабов своей реплики, а брокер связывает реплики между собой.
+type eventBus struct {
+	origin    string
+	broker    pubsub.Broker
+	queueSize int
+	policy    hub.Policy
+	topics    map[string]remoteTopic
+	closed    atomic.Bool
+
+	postCreated    *topic[*model.Post]
+	postUpdated    *topic[*model.Post]
+	commentAdded   *topic[*model.Comment]
+	commentUpdated *topic[*model.Comment]
+	commentDeleted *topic[*model.CommentDeletion]
+	replyAdded     *topic[*model.Comment]
+	// presenceChanged не рассылается брокером: каждая реплика собирает
+	// присутствие сама и публикует только в свой хаб.
+	presenceChanged *topic[*model.Presence]
+}
+
+type remoteTopic interface {
+	deliverRemote(ev busEvent) error
+	stats() hub.Stats
+	keys() map[string]int
+	close()
+}
+
+type topic[T any] struct {
+	name string
+	hub  *hub.Hub[T]
+	id   func(T) string
+	// load загружает запись по ID, если она не поместилась в сообщение.
+	load func(ctx context.Context, id string) (T, error)
+}
+
+func newEventBus(origin string, broker pubsub.Broker, store storage.Storage, queueSize int, policy hub.Policy) *eventBus {
+	b := &eventBus{
+		origin:    origin,
+		broker:    broker,
+		queueSize: queueSize,
+		policy:    policy,
+		topics:    make(map[string]remoteTopic),
+	}
+	postID := func(p *model.Post) string { return p.ID }
+	commentID := func(c *model.Comment) string { return c.ID }
+	b.postCreated = newTopic(b, topicPostCreated, postID, store.GetPostByID)
+	b.postUpdated = newTopic(b, topicPostUpdated, postID, store.GetPostByID)
+	b.commentAdded = newTopic(b, topicCommentAdded, commentID, store.GetCommentByID)
+	b.commentUpdated = newTopic(b, topicCommentUpdated, commentID, store.GetCommentByID)
+	b.commentDeleted = newTopic(b, topicCommentDeleted, func(d *model.CommentDeletion) string { return d.ID }, nil)
+	b.replyAdded = newTopic(b, topicReplyAdded, commentID, store.GetCommentByID)
+	b.presenceChanged = newTopic(b, topicPresence, func(p *model.Presence) string { return p.PostID }, nil)
+
+	if err := broker.Subscribe(eventsTopic, b.handle); err != nil {
+		slog.Error("failed to subscribe to event bus", "topic", eventsTopic, "error", err)
+	}
+	return b
+}
+
+func newTopic[T any](b *eventBus, name string, id func(T) string, load func(context.Context, string) (T, error)) *topic[T] {
+	t := &topic[T]{name: name, hub: hub.New[T](b.queueSize, b.policy), id: id, load: load}
+	b.topics[name] = t
+	return t
+}
+
+// publish доставляет событие подписчикам этой реплики и рассылает его
+// остальным репликам.
+func (t *topic[T]) publish(b *eventBus, key string, v T) {
+	t.hub.Publish(key, v)
+
+	ctx := context.Background()
+	data, err := json.Marshal(v)
+	if err != nil {
+		slog.Error("failed to encode event", "topic", t.name, "error", err)
+		return
+	}
+	payload, err := json.Marshal(busEvent{Origin: b.origin, Topic: t.name, Key: key, Data: data})
+	if err != nil {
+		slog.Error("failed to encode event", "topic", t.name, "error", err)
+		return
+	}
+	err = b.broker.Publish(ctx, eventsTopic, payload)
+	if errors.Is(err, pubsub.ErrPayloadTooLarge) && t.load != nil {
+		payload, _ = json.Marshal(busEvent{Origin: b.origin, Topic: t.name, Key: key, ID: t.id(v)})
+		err = b.broker.Publish(ctx, eventsTopic, payload)
+	}
+	if err != nil {
+		slog.Error("failed to publish event", "topic", t.name, "id", t.id(v), "error", err)
+	}
+}
+
+func (t *topic[T]) deliverRemote(ev busEvent) error {
+	var v T
+	if ev.Data != nil {
+		if err := json.Unmarshal(ev.Data, &v); err != nil {
+			return err
+		}
+	} else {
+		if t.load == nil {
+			return errors.New("event has no data")
+		}
+		var err error
+		if v, err = t.load(context.Background(), ev.ID); err != nil {
+			return fmt.Errorf("load %s: %w", ev.ID, err)
+		}
+	}
+	t.hub.Publish(ev.Key, v)
+	return nil
+}
+
+func (t *topic[T]) stats() hub.Stats {
+	return t.hub.Stats()
+}
+
+func (t *topic[T]) keys() map[string]int {
+	return t.hub.Keys()
+}
+
+func (t *topic[T]) close() {
+	t.hub.Close()
+}
+
+// subscribe оформляет подписку на ключ темы, которая снимается при отмене ctx.
+// После остановки шины новые подписки отклоняются.
+func (t *topic[T]) subscribe(ctx context.Context, key string) (*hub.Subscription[T], error) {
+	if t.hub.Closed() {
+		return nil, shuttingDownError()
+	}
+	var notifier hub.Notifier
+	if notice := noticeFromContext(ctx); notice != nil {
+		notifier = notice
+	}
+	sub := t.hub.Subscribe(key, notifier)
+	go func() {
+		<-ctx.Done()
+		t.hub.Unsubscribe(sub)
+	}()
+	return sub, nil
+}
+
+// handle доставляет локальным подписчикам события других реплик. Свои события
+// уже доставлены при публикации.
+func (b *eventBus) handle(payload []byte) {
+	var ev busEvent
+	if err := json.Unmarshal(payload, &ev); err != nil {
+		slog.Error("failed to decode event", "error", err)
+		return
+	}
+	if ev.Origin == b.origin {
+		return
+	}
+	t, ok := b.topics[ev.Topic]
+	if !ok {
+		slog.Warn("unknown event topic", "topic", ev.Topic)
+		return
+	}
+	if err := t.deliverRemote(ev); err != nil {
+		slog.Error("failed to deliver event", "topic", ev.Topic, "error", err)
+	}
+}
+
+// close завершает все подписки реплики.
+func (b *eventBus) close() {
+	b.closed.Store(true)
+	for _, t := range b.topics {
+		t.close()
+	}
+}
+
+func (b *eventBus) stats() hub.Stats {
+	var total hub.Stats
+	for _, t := range b.topics {
+		s := t.stats()
+		total.Subscribers += s.Subscribers
+		total.Delivered += s.Delivered
+		total.Dropped += s.Dropped
+		total.Coalesced += s.Coalesced
+		total.Disconnected += s.Disconnected
+	}
+	return total
+}
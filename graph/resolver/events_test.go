@@ -0,0 +1,203 @@
+package resolver_test
+
+import (
+	"context"
+	"hivemind/graph/model"
+	"hivemind/graph/resolver"
+	"hivemind/internal/pubsub"
+	"hivemind/internal/storage"
+	"hivemind/internal/storage/mocks"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func next[T any](t *testing.T, ch <-chan T) T {
+	t.Helper()
+	select {
+	case v := <-ch:
+		return v
+	case <-time.After(time.Second):
+		t.Fatal("timeout waiting for event")
+	}
+	var zero T
+	return zero
+}
+
+func expectNone[T any](t *testing.T, ch <-chan T) {
+	t.Helper()
+	select {
+	case v := <-ch:
+		t.Errorf("unexpected event: %+v", v)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+func TestPostEvents(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	t.Run("post created with author filter", func(t *testing.T) {
+		mockStorage := mocks.NewStorageMock(t)
+		mockStorage.CreatePostMock.Return(nil)
+		mockStorage.CreateAuditEntryMock.Return(nil)
+
+		res := resolver.NewResolver(mockStorage)
+		all, _ := res.PostCreated(ctx, nil)
+		alice := "alice"
+		byAlice, _ := res.PostCreated(ctx, &model.PostFilter{Author: &alice})
+		bob := "bob"
+		byBob, _ := res.PostCreated(ctx, &model.PostFilter{Author: &bob})
+
+		post, err := res.CreatePost(ctx, "Title", "Content", "alice", model.ContentFormatPlain, nil)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		assert.Equal(t, post.ID, next(t, all).ID)
+		assert.Equal(t, post.ID, next(t, byAlice).ID)
+		expectNone(t, byBob)
+	})
+
+	t.Run("toggle comments updates post", func(t *testing.T) {
+		mockStorage := mocks.NewStorageMock(t)
+		mockStorage.GetPostByIDMock.Return(&model.Post{ID: "post123", Author: "alice", CommentsEnabled: true}, nil)
+		mockStorage.ToggleCommentsMock.Return(&model.Post{ID: "post123", Author: "alice", CommentsEnabled: false}, nil)
+		mockStorage.CreateAuditEntryMock.Return(nil)
+		publicPost(mockStorage, "post123")
+
+		res := resolver.NewResolver(mockStorage)
+		updates, _ := res.PostUpdated(ctx, "post123")
+		if _, err := res.ToggleComments(ctx, "post123", false, "alice"); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		assert.False(t, next(t, updates).CommentsEnabled)
+	})
+}
+
+func TestCommentEvents(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	parentID := "comment1"
+
+	t.Run("reply added", func(t *testing.T) {
+		mockStorage := mocks.NewStorageMock(t)
+		mockStorage.GetPostWithVisibilityMock.Return(&model.Post{ID: "post123", CommentsEnabled: true}, storage.VisibilityPublic, nil)
+		mockStorage.CreateCommentMock.Return(nil)
+		mockStorage.CreateAuditEntryMock.Return(nil)
+		mockStorage.GetCommentByIDMock.Return(&model.Comment{ID: parentID, PostID: "post123"}, nil)
+
+		res := resolver.NewResolver(mockStorage)
+		replies, _ := res.ReplyAdded(ctx, parentID)
+		other, _ := res.ReplyAdded(ctx, "comment2")
+		reply, err := res.CreateComment(ctx, "post123", &parentID, "reply", "bob", model.ContentFormatPlain, nil)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		assert.Equal(t, reply.ID, next(t, replies).ID)
+		expectNone(t, other)
+	})
+
+	t.Run("update comment", func(t *testing.T) {
+		mockStorage := mocks.NewStorageMock(t)
+		mockStorage.GetCommentByIDMock.Return(&model.Comment{ID: parentID, PostID: "post123", Author: "alice"}, nil)
+		mockStorage.UpdateCommentMock.Set(func(ctx context.Context, id, content string, editedAt time.Time) (*model.Comment, storage.Visibility, error) {
+			return &model.Comment{ID: id, PostID: "post123", Author: "alice", Content: content, EditedAt: &editedAt}, storage.VisibilityPublic, nil
+		})
+		mockStorage.CreateAuditEntryMock.Return(nil)
+		publicPost(mockStorage, "post123")
+
+		res := resolver.NewResolver(mockStorage)
+		updates, _ := res.CommentUpdated(ctx, "post123")
+		if _, err := res.UpdateComment(ctx, parentID, "edited", "bob"); err == nil || err.Error() != "only the author of the comment can edit it" {
+			t.Errorf("expected author error, got: %v", err)
+		}
+		comment, err := res.UpdateComment(ctx, parentID, "edited", "alice")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if comment.EditedAt == nil {
+			t.Error("expected editedAt to be set")
+		}
+		assert.Equal(t, "edited", next(t, updates).Content)
+	})
+
+	t.Run("held comment edit is not broadcast", func(t *testing.T) {
+		mockStorage := mocks.NewStorageMock(t)
+		mockStorage.GetCommentByIDMock.Return(&model.Comment{ID: parentID, PostID: "post123", Author: "alice"}, nil)
+		mockStorage.UpdateCommentMock.Return(&model.Comment{ID: parentID, PostID: "post123", Author: "alice"}, storage.VisibilityHeld, nil)
+		mockStorage.CreateAuditEntryMock.Return(nil)
+		publicPost(mockStorage, "post123")
+
+		res := resolver.NewResolver(mockStorage)
+		updates, _ := res.CommentUpdated(ctx, "post123")
+		if _, err := res.UpdateComment(ctx, parentID, "edited", "alice"); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		expectNone(t, updates)
+	})
+
+	t.Run("delete comment", func(t *testing.T) {
+		mockStorage := mocks.NewStorageMock(t)
+		mockStorage.GetCommentByIDMock.Return(&model.Comment{ID: parentID, PostID: "post123", Author: "alice"}, nil)
+		mockStorage.RemoveCommentMock.Expect(ctx, parentID).Return(nil)
+		mockStorage.CreateAuditEntryMock.Return(nil)
+		publicPost(mockStorage, "post123")
+
+		res := resolver.NewResolver(mockStorage)
+		deletions, _ := res.CommentDeleted(ctx, "post123")
+		ok, err := res.DeleteComment(ctx, parentID, "alice")
+		if err != nil || !ok {
+			t.Fatalf("unexpected result: %v, %v", ok, err)
+		}
+		assert.Equal(t, parentID, next(t, deletions).ID)
+	})
+}
+
+func TestCrossInstanceEvents(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	broker := pubsub.NewInProcess()
+
+	mockStorage := mocks.NewStorageMock(t)
+	mockStorage.GetPostByIDMock.Return(&model.Post{ID: "post123", Author: "alice", CommentsEnabled: true}, nil)
+	mockStorage.ToggleCommentsMock.Return(&model.Post{ID: "post123", Author: "alice", CommentsEnabled: false, Format: model.ContentFormatPlain}, nil)
+	mockStorage.CreateAuditEntryMock.Return(nil)
+	first := resolver.NewResolver(mockStorage, resolver.WithBroker(broker))
+	second := resolver.NewResolver(publicPost(mocks.NewStorageMock(t), "post123"), resolver.WithBroker(broker))
+
+	updates, _ := second.PostUpdated(ctx, "post123")
+	if _, err := first.ToggleComments(ctx, "post123", false, "alice"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assert.False(t, next(t, updates).CommentsEnabled)
+}
+
+func TestPresence(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	mockStorage := mocks.NewStorageMock(t)
+	mockStorage.GetPostByIDMock.Return(&model.Post{ID: "post123", CommentsEnabled: true}, nil)
+	publicPost(mockStorage, "post123")
+	res := resolver.NewResolver(mockStorage)
+
+	changes, _ := res.PresenceChanged(ctx, "post123")
+	assert.Equal(t, &model.Presence{PostID: "post123", Typing: []string{}}, next(t, changes))
+
+	viewerCtx, leave := context.WithCancel(ctx)
+	if _, err := res.CommentAdded(viewerCtx, "post123", nil, nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assert.Equal(t, 1, next(t, changes).Viewers)
+
+	if ok, err := res.SetTyping(ctx, "post123", "alice"); err != nil || !ok {
+		t.Fatalf("unexpected result: %v, %v", ok, err)
+	}
+	assert.Equal(t, []string{"alice"}, next(t, changes).Typing)
+
+	leave()
+	assert.Equal(t, 0, next(t, changes).Viewers)
+	current, _ := res.Presence(ctx, "post123")
+	assert.Equal(t, []string{"alice"}, current.Typing)
+}
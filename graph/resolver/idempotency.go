@@ -0,0 +1,128 @@
+package resolver
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"log/slog"
+	"strconv"
+	"time"
+
+	"hivemind/internal/storage"
+
+	"github.com/99designs/gqlgen/graphql/errcode"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+const (
+	ErrIdempotencyKeyReused      = "IDEMPOTENCY_KEY_REUSED"
+	ErrMutationInProgress        = "MUTATION_IN_PROGRESS"
+	ErrMutationResultUnavailable = "MUTATION_RESULT_UNAVAILABLE"
+
+	defaultIdempotencyRetention = 24 * time.Hour
+	// idempotencyPendingTTL — сколько ключ закреплён за незавершённой
+	// мутацией. Если процесс упал, не освободив ключ, повтор перехватит его
+	// по истечении этого срока, а не через весь срок хранения.
+	idempotencyPendingTTL = time.Minute
+)
+
+// WithIdempotencyRetention задаёт, сколько хранится clientMutationId
+// мутации. Повтор после этого срока выполняется как новая мутация.
+func WithIdempotencyRetention(d time.Duration) Option {
+	return func(r *Resolver) {
+		r.idempotencyRetention = d
+	}
+}
+
+// idempotent выполняет create не более одного раза для clientMutationID.
+// Идентификатор результата закрепляется за ключом до записи, поэтому
+// повтор, пришедший во время первого вызова, не создаёт дубликат. После
+// записи ключ отмечается завершённым и хранится весь срок; повтор с теми же
+// аргументами получает исходный результат через fetch. Если create
+// завершился ошибкой, ключ освобождается для следующей попытки.
+func idempotent[T any](
+	ctx context.Context,
+	r *Resolver,
+	mutation, author string,
+	clientMutationID *string,
+	args []any,
+	create func(id string) (T, error),
+	fetch func(ctx context.Context, id string) (T, error),
+) (T, error) {
+	id := GenerateID()
+	if clientMutationID == nil || *clientMutationID == "" {
+		return create(id)
+	}
+
+	var zero T
+	fingerprint, err := fingerprint(args)
+	if err != nil {
+		return zero, err
+	}
+	key := &storage.IdempotencyKey{
+		Key:         idempotencyKey(mutation, author, *clientMutationID),
+		Fingerprint: fingerprint,
+		ResultID:    id,
+		ExpiresAt:   time.Now().Add(idempotencyPendingTTL),
+	}
+	claimed, err := r.Storage.ClaimIdempotencyKey(ctx, key)
+	if err != nil {
+		return zero, err
+	}
+
+	if claimed.ResultID != id {
+		if claimed.Fingerprint != fingerprint {
+			err := gqlerror.Errorf("clientMutationId %q was already used with different arguments", *clientMutationID)
+			errcode.Set(err, ErrIdempotencyKeyReused)
+			return zero, err
+		}
+		if !claimed.Completed {
+			err := gqlerror.Errorf("mutation with clientMutationId %q is still in progress, retry later", *clientMutationID)
+			errcode.Set(err, ErrMutationInProgress)
+			return zero, err
+		}
+		// Реплика и кеш могут ещё не знать о только что созданном объекте.
+		result, err := fetch(storage.WithPrimary(ctx), claimed.ResultID)
+		if err != nil {
+			// Результат мог быть удалён после мутации.
+			slog.WarnContext(ctx, "failed to load result of repeated mutation", "key", key.Key, "result_id", claimed.ResultID, "error", err)
+			err := gqlerror.Errorf("result of mutation with clientMutationId %q is no longer available", *clientMutationID)
+			errcode.Set(err, ErrMutationResultUnavailable)
+			return zero, err
+		}
+		return result, nil
+	}
+
+	result, err := create(id)
+	if err != nil {
+		// Клиент мог отменить запрос, но ключ всё равно нужно освободить.
+		if releaseErr := r.Storage.ReleaseIdempotencyKey(context.WithoutCancel(ctx), key.Key, id); releaseErr != nil {
+			slog.ErrorContext(ctx, "failed to release idempotency key", "key", key.Key, "error", releaseErr)
+		}
+		return zero, err
+	}
+	// Объект уже создан, поэтому ошибка здесь не отменяет мутацию: ключ лишь
+	// истечёт раньше срока.
+	if err := r.Storage.CompleteIdempotencyKey(context.WithoutCancel(ctx), key.Key, id, time.Now().Add(r.idempotencyRetention)); err != nil {
+		slog.ErrorContext(ctx, "failed to complete idempotency key", "key", key.Key, "error", err)
+	}
+	return result, nil
+}
+
+// idempotencyKey хеширует автора и clientMutationId с длиной автора впереди:
+// при простой склейке через разделитель пары ("a:b", "c") и ("a", "b:c")
+// дали бы один ключ.
+func idempotencyKey(mutation, author, clientMutationID string) string {
+	sum := sha256.Sum256([]byte(strconv.Itoa(len(author)) + ":" + author + clientMutationID))
+	return mutation + ":" + hex.EncodeToString(sum[:])
+}
+
+func fingerprint(args []any) (string, error) {
+	data, err := json.Marshal(args)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
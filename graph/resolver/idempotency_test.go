@@ -0,0 +1,211 @@
+package resolver_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"hivemind/graph/model"
+	"hivemind/graph/resolver"
+	"hivemind/internal/memory"
+	"hivemind/internal/storage"
+	"hivemind/internal/storage/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+func errorCode(t *testing.T, err error) any {
+	t.Helper()
+	var gqlErr *gqlerror.Error
+	require.ErrorAs(t, err, &gqlErr)
+	return gqlErr.Extensions["code"]
+}
+
+func TestIdempotentCreate(t *testing.T) {
+	ctx := context.Background()
+	key := func(s string) *string { return &s }
+
+	t.Run("replay returns the original post", func(t *testing.T) {
+		store := memory.NewMemoryStorage()
+		res := resolver.NewResolver(store)
+
+		first, err := res.CreatePost(ctx, "Title", "Content", "alice", model.ContentFormatPlain, key("m1"))
+		require.NoError(t, err)
+		second, err := res.CreatePost(ctx, "Title", "Content", "alice", model.ContentFormatPlain, key("m1"))
+		require.NoError(t, err)
+		assert.Equal(t, first.ID, second.ID)
+
+		posts, err := store.GetPosts(ctx)
+		require.NoError(t, err)
+		assert.Len(t, posts, 1)
+	})
+
+	t.Run("replay returns the original comment", func(t *testing.T) {
+		store := memory.NewMemoryStorage()
+		res := resolver.NewResolver(store)
+		post, err := res.CreatePost(ctx, "Title", "Content", "alice", model.ContentFormatPlain, nil)
+		require.NoError(t, err)
+
+		first, err := res.CreateComment(ctx, post.ID, nil, "hi", "bob", model.ContentFormatPlain, key("m1"))
+		require.NoError(t, err)
+		second, err := res.CreateComment(ctx, post.ID, nil, "hi", "bob", model.ContentFormatPlain, key("m1"))
+		require.NoError(t, err)
+		assert.Equal(t, first.ID, second.ID)
+
+		comments, err := store.GetCommentsByPostID(ctx, post.ID, 10, 0)
+		require.NoError(t, err)
+		assert.Len(t, comments, 1)
+	})
+
+	t.Run("keys are scoped by author and mutation", func(t *testing.T) {
+		res := resolver.NewResolver(memory.NewMemoryStorage())
+
+		first, err := res.CreatePost(ctx, "Title", "Content", "alice", model.ContentFormatPlain, key("m1"))
+		require.NoError(t, err)
+		second, err := res.CreatePost(ctx, "Title", "Content", "bob", model.ContentFormatPlain, key("m1"))
+		require.NoError(t, err)
+		assert.NotEqual(t, first.ID, second.ID)
+
+		_, err = res.CreateComment(ctx, first.ID, nil, "hi", "alice", model.ContentFormatPlain, key("m1"))
+		require.NoError(t, err)
+	})
+
+	t.Run("separator in author or key does not collide", func(t *testing.T) {
+		res := resolver.NewResolver(memory.NewMemoryStorage())
+
+		first, err := res.CreatePost(ctx, "Title", "Content", "alice:x", model.ContentFormatPlain, key("m1"))
+		require.NoError(t, err)
+		second, err := res.CreatePost(ctx, "Title", "Content", "alice", model.ContentFormatPlain, key("x:m1"))
+		require.NoError(t, err)
+		assert.NotEqual(t, first.ID, second.ID)
+	})
+
+	t.Run("reuse with different arguments is rejected", func(t *testing.T) {
+		res := resolver.NewResolver(memory.NewMemoryStorage())
+
+		_, err := res.CreatePost(ctx, "Title", "Content", "alice", model.ContentFormatPlain, key("m1"))
+		require.NoError(t, err)
+		_, err = res.CreatePost(ctx, "Other", "Content", "alice", model.ContentFormatPlain, key("m1"))
+		require.Error(t, err)
+		assert.Equal(t, resolver.ErrIdempotencyKeyReused, errorCode(t, err))
+	})
+
+	t.Run("expired key allows a new mutation", func(t *testing.T) {
+		res := resolver.NewResolver(memory.NewMemoryStorage(), resolver.WithIdempotencyRetention(-1))
+
+		first, err := res.CreatePost(ctx, "Title", "Content", "alice", model.ContentFormatPlain, key("m1"))
+		require.NoError(t, err)
+		second, err := res.CreatePost(ctx, "Title", "Content", "alice", model.ContentFormatPlain, key("m1"))
+		require.NoError(t, err)
+		assert.NotEqual(t, first.ID, second.ID)
+	})
+
+	t.Run("key is held briefly until the mutation completes", func(t *testing.T) {
+		mockStorage := mocks.NewStorageMock(t)
+		mockStorage.ClaimIdempotencyKeyMock.Set(func(ctx context.Context, key *storage.IdempotencyKey) (*storage.IdempotencyKey, error) {
+			assert.False(t, key.Completed)
+			assert.WithinDuration(t, time.Now().Add(time.Minute), key.ExpiresAt, 5*time.Second)
+			return key, nil
+		})
+		mockStorage.CreatePostMock.Return(nil)
+		mockStorage.CreateAuditEntryMock.Return(nil)
+		mockStorage.CompleteIdempotencyKeyMock.Set(func(ctx context.Context, key, resultID string, expiresAt time.Time) error {
+			assert.NotEmpty(t, resultID)
+			assert.WithinDuration(t, time.Now().Add(time.Hour), expiresAt, 5*time.Second)
+			return nil
+		})
+
+		res := resolver.NewResolver(mockStorage, resolver.WithIdempotencyRetention(time.Hour))
+		_, err := res.CreatePost(ctx, "Title", "Content", "alice", model.ContentFormatPlain, key("m1"))
+		require.NoError(t, err)
+		assert.Equal(t, uint64(1), mockStorage.CompleteIdempotencyKeyAfterCounter())
+	})
+
+	t.Run("abandoned claim is taken over after it expires", func(t *testing.T) {
+		store := memory.NewMemoryStorage()
+		res := resolver.NewResolver(store)
+
+		// Процесс закрепил ключ и упал, не создав пост.
+		abandoned := &storage.IdempotencyKey{ResultID: "lost", ExpiresAt: time.Now().Add(-time.Second)}
+		mockStorage := mocks.NewStorageMock(t)
+		mockStorage.ClaimIdempotencyKeyMock.Set(func(ctx context.Context, key *storage.IdempotencyKey) (*storage.IdempotencyKey, error) {
+			abandoned.Key, abandoned.Fingerprint = key.Key, key.Fingerprint
+			return store.ClaimIdempotencyKey(ctx, abandoned)
+		})
+		_, err := resolver.NewResolver(mockStorage).CreatePost(ctx, "Title", "Content", "alice", model.ContentFormatPlain, key("m1"))
+		require.Error(t, err)
+		assert.Equal(t, resolver.ErrMutationInProgress, errorCode(t, err))
+
+		post, err := res.CreatePost(ctx, "Title", "Content", "alice", model.ContentFormatPlain, key("m1"))
+		require.NoError(t, err)
+		assert.NotEqual(t, "lost", post.ID)
+	})
+
+	t.Run("replay reads the result from the primary", func(t *testing.T) {
+		mockStorage := mocks.NewStorageMock(t)
+		mockStorage.ClaimIdempotencyKeyMock.Set(func(ctx context.Context, key *storage.IdempotencyKey) (*storage.IdempotencyKey, error) {
+			claimed := *key
+			claimed.ResultID = "post1"
+			claimed.Completed = true
+			return &claimed, nil
+		})
+		mockStorage.GetPostByIDMock.Set(func(ctx context.Context, id string) (*model.Post, error) {
+			assert.True(t, storage.PrimaryRequested(ctx))
+			return &model.Post{ID: id}, nil
+		})
+
+		res := resolver.NewResolver(mockStorage)
+		post, err := res.CreatePost(ctx, "Title", "Content", "alice", model.ContentFormatPlain, key("m1"))
+		require.NoError(t, err)
+		assert.Equal(t, "post1", post.ID)
+	})
+
+	t.Run("replay after the result was removed", func(t *testing.T) {
+		store := memory.NewMemoryStorage()
+		res := resolver.NewResolver(store)
+
+		post, err := res.CreatePost(ctx, "Title", "Content", "alice", model.ContentFormatPlain, key("m1"))
+		require.NoError(t, err)
+		require.NoError(t, store.RemovePost(ctx, post.ID))
+
+		_, err = res.CreatePost(ctx, "Title", "Content", "alice", model.ContentFormatPlain, key("m1"))
+		require.Error(t, err)
+		assert.Equal(t, resolver.ErrMutationResultUnavailable, errorCode(t, err))
+	})
+
+	t.Run("failure releases the key", func(t *testing.T) {
+		mockStorage := mocks.NewStorageMock(t)
+		mockStorage.ClaimIdempotencyKeyMock.Set(func(ctx context.Context, key *storage.IdempotencyKey) (*storage.IdempotencyKey, error) {
+			return key, nil
+		})
+		mockStorage.CreatePostMock.Return(errors.New("db failure"))
+		mockStorage.ReleaseIdempotencyKeyMock.Set(func(ctx context.Context, key, resultID string) error {
+			assert.True(t, strings.HasPrefix(key, "createPost:"), key)
+			assert.NotEmpty(t, resultID)
+			return nil
+		})
+
+		res := resolver.NewResolver(mockStorage)
+		_, err := res.CreatePost(ctx, "Title", "Content", "alice", model.ContentFormatPlain, key("m1"))
+		require.EqualError(t, err, "db failure")
+		assert.Equal(t, uint64(1), mockStorage.ReleaseIdempotencyKeyAfterCounter())
+	})
+
+	t.Run("replay before the first call finishes", func(t *testing.T) {
+		mockStorage := mocks.NewStorageMock(t)
+		mockStorage.ClaimIdempotencyKeyMock.Set(func(ctx context.Context, key *storage.IdempotencyKey) (*storage.IdempotencyKey, error) {
+			claimed := *key
+			claimed.ResultID = "pending"
+			return &claimed, nil
+		})
+
+		res := resolver.NewResolver(mockStorage)
+		_, err := res.CreatePost(ctx, "Title", "Content", "alice", model.ContentFormatPlain, key("m1"))
+		require.Error(t, err)
+		assert.Equal(t, resolver.ErrMutationInProgress, errorCode(t, err))
+	})
+}
@@ -0,0 +1,217 @@
+package resolver
+
+import (
+	"context"
+	"hivemind/internal/ratelimit"
+	"sync"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/errcode"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+const ErrSubscriptionLimit = "SUBSCRIPTION_LIMIT"
+
+// connection — состояние WebSocket-соединения: кто подключился и сколько
+// подписок на нём открыто.
+type connection struct {
+	user   string
+	cancel context.CancelFunc
+	idle   time.Duration
+
+	mu     sync.Mutex
+	active int
+	timer  *time.Timer
+}
+
+type connectionKey struct{}
+
+func connectionFromContext(ctx context.Context) *connection {
+	c, _ := ctx.Value(connectionKey{}).(*connection)
+	return c
+}
+
+// Subscriber возвращает пользователя, указанного в connection_init
+// WebSocket-соединения, или пустую строку.
+func Subscriber(ctx context.Context) string {
+	if c := connectionFromContext(ctx); c != nil {
+		return c.user
+	}
+	return ""
+}
+
+// startIdle закрывает соединение, если за время idle на нём не появится подписок.
+// Вызывается под c.mu.
+func (c *connection) startIdle() {
+	if c.idle <= 0 {
+		return
+	}
+	c.timer = time.AfterFunc(c.idle, c.cancel)
+}
+
+func (c *connection) stopIdle() {
+	if c.timer != nil {
+		c.timer.Stop()
+		c.timer = nil
+	}
+}
+
+func (c *connection) release() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.active--
+	if c.active == 0 {
+		c.startIdle()
+	}
+}
+
+// SubscriptionLimits — расширение gqlgen, ограничивающее число одновременных
+// подписок на одном соединении и у одного пользователя. Пользователь
+// определяется по полю user в connection_init, а без него — по IP клиента.
+// Счётчики пользователей ведутся в пределах реплики.
+type SubscriptionLimits struct {
+	perConnection int
+	perUser       int
+	idleTimeout   time.Duration
+
+	mu    sync.Mutex
+	users map[string]int
+	conns map[*connection]struct{}
+}
+
+var _ interface {
+	graphql.HandlerExtension
+	graphql.OperationInterceptor
+} = &SubscriptionLimits{}
+
+// NewSubscriptionLimits создаёт ограничения; нулевое значение отключает
+// соответствующий лимит. Соединение без подписок закрывается через idleTimeout.
+func NewSubscriptionLimits(perConnection, perUser int, idleTimeout time.Duration) *SubscriptionLimits {
+	return &SubscriptionLimits{
+		perConnection: perConnection,
+		perUser:       perUser,
+		idleTimeout:   idleTimeout,
+		users:         make(map[string]int),
+		conns:         make(map[*connection]struct{}),
+	}
+}
+
+func (l *SubscriptionLimits) ExtensionName() string {
+	return "SubscriptionLimits"
+}
+
+func (l *SubscriptionLimits) Validate(schema graphql.ExecutableSchema) error {
+	return nil
+}
+
+// InitConnection используется как InitFunc транспорта WebSocket.
+func (l *SubscriptionLimits) InitConnection(ctx context.Context, payload transport.InitPayload) (context.Context, *transport.InitPayload, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	c := &connection{user: payload.GetString("user"), cancel: cancel, idle: l.idleTimeout}
+	c.mu.Lock()
+	c.startIdle()
+	c.mu.Unlock()
+
+	l.mu.Lock()
+	l.conns[c] = struct{}{}
+	l.mu.Unlock()
+	go func() {
+		<-ctx.Done()
+		l.mu.Lock()
+		delete(l.conns, c)
+		l.mu.Unlock()
+	}()
+	return context.WithValue(ctx, connectionKey{}, c), nil, nil
+}
+
+// CloseConnections закрывает все открытые WebSocket-соединения. Предназначен
+// для http.Server.RegisterOnShutdown: Shutdown не ждёт перехваченные соединения.
+func (l *SubscriptionLimits) CloseConnections() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for c := range l.conns {
+		c.cancel()
+	}
+}
+
+func (l *SubscriptionLimits) InterceptOperation(ctx context.Context, next graphql.OperationHandler) graphql.ResponseHandler {
+	op := graphql.GetOperationContext(ctx).Operation
+	if op == nil || op.Operation != ast.Subscription {
+		return next(ctx)
+	}
+	release, err := l.acquire(ctx)
+	if err != nil {
+		return graphql.OneShot(&graphql.Response{Errors: gqlerror.List{err}})
+	}
+	var once sync.Once
+	go func() {
+		<-ctx.Done()
+		once.Do(release)
+	}()
+	responses := next(ctx)
+	return func(ctx context.Context) *graphql.Response {
+		resp := responses(ctx)
+		if resp == nil {
+			once.Do(release)
+		}
+		return resp
+	}
+}
+
+func (l *SubscriptionLimits) acquire(ctx context.Context) (func(), *gqlerror.Error) {
+	conn := connectionFromContext(ctx)
+	user := Subscriber(ctx)
+	if user == "" {
+		if ip := ratelimit.ClientIP(ctx); ip != "" {
+			user = "ip:" + ip
+		}
+	} else {
+		user = "user:" + user
+	}
+
+	if conn != nil {
+		conn.mu.Lock()
+		if l.perConnection > 0 && conn.active >= l.perConnection {
+			conn.mu.Unlock()
+			return nil, limitError("too many subscriptions on this connection", l.perConnection)
+		}
+		conn.active++
+		conn.stopIdle()
+		conn.mu.Unlock()
+	}
+	if user != "" {
+		l.mu.Lock()
+		if l.perUser > 0 && l.users[user] >= l.perUser {
+			l.mu.Unlock()
+			if conn != nil {
+				conn.release()
+			}
+			return nil, limitError("too many subscriptions for this user", l.perUser)
+		}
+		l.users[user]++
+		l.mu.Unlock()
+	}
+
+	return func() {
+		if conn != nil {
+			conn.release()
+		}
+		if user != "" {
+			l.mu.Lock()
+			if l.users[user]--; l.users[user] <= 0 {
+				delete(l.users, user)
+			}
+			l.mu.Unlock()
+		}
+	}, nil
+}
+
+func limitError(message string, limit int) *gqlerror.Error {
+	err := gqlerror.Errorf("%s", message)
+	errcode.Set(err, ErrSubscriptionLimit)
+	err.Extensions["limit"] = limit
+	return err
+}
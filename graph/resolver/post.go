@@ -4,6 +4,8 @@ import (
 	"context"
 	"errors"
 	"hivemind/graph/model"
+	"hivemind/internal/contentfilter"
+	"hivemind/internal/storage"
 	"time"
 )
 
@@ -11,23 +13,65 @@ func (r *Resolver) Posts(ctx context.Context) ([]*model.Post, error) {
 	return r.Storage.GetPosts(ctx)
 }
 
-func (r *Resolver) Post(ctx context.Context, id string) (*model.Post, error) {
-	return r.Storage.GetPostByID(ctx, id)
+func (r *Resolver) PostByID(ctx context.Context, id string) (*model.Post, error) {
+	return r.visiblePost(ctx, id)
 }
 
-func (r *Resolver) CreatePost(ctx context.Context, title, content, author string) (*model.Post, error) {
+// visiblePost возвращает пост, если он не ждёт решения модератора. Пост на
+// модерации не отличается от несуществующего; скрытый без уведомления пост
+// по-прежнему доступен по ID, чтобы автор не заметил скрытия.
+func (r *Resolver) visiblePost(ctx context.Context, id string) (*model.Post, error) {
+	post, visibility, err := r.Storage.GetPostWithVisibility(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if visibility == storage.VisibilityHeld {
+		return nil, errors.New("post not found")
+	}
+	return post, nil
+}
+
+func (r *Resolver) CreatePost(ctx context.Context, title, content, author string, format model.ContentFormat, clientMutationID *string) (*model.Post, error) {
+	return idempotent(ctx, r, "createPost", author, clientMutationID, []any{title, content, format},
+		func(id string) (*model.Post, error) {
+			return r.createPost(ctx, id, title, content, author, format)
+		},
+		r.Storage.GetPostByID,
+	)
+}
+
+func (r *Resolver) createPost(ctx context.Context, id, title, content, author string, format model.ContentFormat) (*model.Post, error) {
+	checked := contentfilter.Content{
+		Kind:   contentfilter.KindPost,
+		Author: author,
+		Title:  title,
+		Text:   content,
+	}
+	verdict, err := r.checkContent(ctx, checked)
+	if err != nil {
+		return nil, err
+	}
+
 	post := &model.Post{
-		ID:              GenerateID(),
+		ID:              id,
 		Title:           title,
 		Content:         content,
+		Format:          format,
 		Author:          author,
 		CommentsEnabled: true,
 		CreatedAt:       time.Now(),
-		Comments:        []*model.Comment{},
 	}
-	if err := r.Storage.CreatePost(ctx, post); err != nil {
+	if err := r.Storage.CreatePost(ctx, post, visibilityFor(verdict)); err != nil {
 		return nil, err
 	}
+	r.filter.Record(ctx, checked)
+	r.recordAudit(ctx, author, model.AuditActionCreatePost, model.AuditTargetTypePost, post.ID, nil, snapshot(post))
+	switch verdict.Action {
+	case contentfilter.Allow:
+		r.publishPostCreated(post)
+	case contentfilter.Hold:
+		r.holdForModeration(ctx, model.ReportTargetTypePost, post.ID, author, verdict)
+	}
 	return post, nil
 }
 
@@ -41,5 +85,16 @@ func (r *Resolver) ToggleComments(ctx context.Context, postID string, enabled bo
 		return nil, errors.New("only the author of the post can toggle comments")
 	}
 
-	return r.Storage.ToggleComments(ctx, postID, enabled, author)
+	before := snapshot(post)
+	updated, err := r.Storage.ToggleComments(ctx, postID, enabled, author)
+	if err != nil {
+		return nil, err
+	}
+	r.recordAudit(ctx, author, model.AuditActionToggleComments, model.AuditTargetTypePost, postID, before, snapshot(updated))
+	r.publishPostUpdated(updated)
+	return updated, nil
+}
+
+func (r *Resolver) PostContentHTML(ctx context.Context, post *model.Post) (string, error) {
+	return r.renderer.Render(post.Format, post.Content)
 }
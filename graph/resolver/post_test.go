@@ -5,6 +5,7 @@ import (
 	"errors"
 	"hivemind/graph/model"
 	"hivemind/graph/resolver"
+	"hivemind/internal/storage"
 	"hivemind/internal/storage/mocks"
 	"testing"
 	"time"
@@ -17,9 +18,10 @@ func TestCreatePost(t *testing.T) {
 		mockStorage := mocks.NewStorageMock(t)
 
 		mockStorage.CreatePostMock.Return(nil)
+		mockStorage.CreateAuditEntryMock.Return(nil)
 
 		res := resolver.NewResolver(mockStorage)
-		post, err := res.CreatePost(ctx, "Test Title", "Test Content", "alice")
+		post, err := res.CreatePost(ctx, "Test Title", "Test Content", "alice", model.ContentFormatPlain, nil)
 
 		if err != nil {
 			t.Fatalf("unexpected error: %v", err)
@@ -36,7 +38,7 @@ func TestCreatePost(t *testing.T) {
 		mockStorage.CreatePostMock.Return(errors.New("db failure"))
 
 		res := resolver.NewResolver(mockStorage)
-		_, err := res.CreatePost(ctx, "Test Title", "Test Content", "alice")
+		_, err := res.CreatePost(ctx, "Test Title", "Test Content", "alice", model.ContentFormatPlain, nil)
 
 		if err == nil || err.Error() != "db failure" {
 			t.Errorf("expected 'db failure' error, got: %v", err)
@@ -60,6 +62,7 @@ func TestToggleComments(t *testing.T) {
 			Author:          "alice",
 			CommentsEnabled: false,
 		}, nil)
+		mockStorage.CreateAuditEntryMock.Return(nil)
 
 		res := resolver.NewResolver(mockStorage)
 		post, err := res.ToggleComments(ctx, "post123", false, "alice")
@@ -153,17 +156,17 @@ func TestPost(t *testing.T) {
 	t.Run("success", func(t *testing.T) {
 		mockStorage := mocks.NewStorageMock(t)
 
-		mockStorage.GetPostByIDMock.Return(&model.Post{
+		mockStorage.GetPostWithVisibilityMock.Return(&model.Post{
 			ID:              "post123",
 			Title:           "Test Title",
 			Content:         "Test Content",
 			Author:          "alice",
 			CommentsEnabled: true,
 			CreatedAt:       time.Now(),
-		}, nil)
+		}, storage.VisibilityPublic, nil)
 
 		res := resolver.NewResolver(mockStorage)
-		post, err := res.Post(ctx, "post123")
+		post, err := res.PostByID(ctx, "post123")
 
 		if err != nil {
 			t.Fatalf("unexpected error: %v", err)
@@ -177,13 +180,26 @@ func TestPost(t *testing.T) {
 	t.Run("post not found", func(t *testing.T) {
 		mockStorage := mocks.NewStorageMock(t)
 
-		mockStorage.GetPostByIDMock.Return(nil, errors.New("not found"))
+		mockStorage.GetPostWithVisibilityMock.Return(nil, "", errors.New("not found"))
 
 		res := resolver.NewResolver(mockStorage)
-		_, err := res.Post(ctx, "missing")
+		_, err := res.PostByID(ctx, "missing")
 
 		if err == nil || err.Error() != "not found" {
 			t.Errorf("expected 'not found' error, got: %v", err)
 		}
 	})
+
+	t.Run("held post is hidden", func(t *testing.T) {
+		mockStorage := mocks.NewStorageMock(t)
+
+		mockStorage.GetPostWithVisibilityMock.Return(&model.Post{ID: "post123", Author: "alice"}, storage.VisibilityHeld, nil)
+
+		res := resolver.NewResolver(mockStorage)
+		_, err := res.PostByID(ctx, "post123")
+
+		if err == nil || err.Error() != "post not found" {
+			t.Errorf("expected 'post not found' error, got: %v", err)
+		}
+	})
 }
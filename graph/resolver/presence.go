@@ -0,0 +1,70 @@
+package resolver
+
+import (
+	"context"
+	"errors"
+	"hivemind/graph/model"
+	"hivemind/internal/presence"
+	"time"
+)
+
+const defaultTypingTTL = 5 * time.Second
+
+func (r *Resolver) SetTyping(ctx context.Context, postID, author string) (bool, error) {
+	post, err := r.Storage.GetPostByID(ctx, postID)
+	if err != nil {
+		return false, err
+	}
+	if !post.CommentsEnabled {
+		return false, errors.New("commenting is disabled for this post")
+	}
+	r.presence.Typing(postID, author)
+	return true, nil
+}
+
+func (r *Resolver) Presence(ctx context.Context, postID string) (*model.Presence, error) {
+	return presenceModel(r.presence.Snapshot(postID)), nil
+}
+
+// PresenceChanged сначала отдаёт текущее присутствие на посте, затем каждое
+// его изменение.
+func (r *Resolver) PresenceChanged(ctx context.Context, postID string) (<-chan *model.Presence, error) {
+	if err := r.authorizePost(ctx, postID); err != nil {
+		return nil, err
+	}
+	sub, err := r.events.presenceChanged.subscribe(ctx, postID)
+	if err != nil {
+		return nil, err
+	}
+	current := presenceModel(r.presence.Snapshot(postID))
+	out := make(chan *model.Presence, 1)
+	out <- current
+	go func() {
+		defer close(out)
+		for p := range sub.C() {
+			select {
+			case out <- p:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return out, nil
+}
+
+// watch засчитывает подписчика commentAdded зрителем поста до отмены ctx.
+func (r *Resolver) watch(ctx context.Context, postID string) {
+	r.presence.Join(postID)
+	go func() {
+		<-ctx.Done()
+		r.presence.Leave(postID)
+	}()
+}
+
+func (r *Resolver) publishPresence(s presence.Snapshot) {
+	r.events.presenceChanged.hub.Publish(s.PostID, presenceModel(s))
+}
+
+func presenceModel(s presence.Snapshot) *model.Presence {
+	return &model.Presence{PostID: s.PostID, Viewers: s.Viewers, Typing: s.Typing}
+}
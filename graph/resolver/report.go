@@ -0,0 +1,221 @@
+package resolver
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"hivemind/graph/model"
+	"hivemind/internal/storage"
+	"log/slog"
+	"time"
+)
+
+const (
+	maxReportDetailsLength = 1000
+	defaultQueuePageSize   = 20
+	maxQueuePageSize       = 100
+)
+
+func (r *Resolver) ReportContent(ctx context.Context, targetID string, reason model.ReportReason, details *string, reporter string) (*model.Report, error) {
+	if details != nil && len(*details) > maxReportDetailsLength {
+		return nil, errors.New("report details too long")
+	}
+	// Отклонение жалобы фильтра публикует задержанную запись, поэтому от его
+	// имени жаловаться нельзя.
+	if reporter == contentFilterReporter {
+		return nil, errors.New("reporter name is reserved")
+	}
+	targetType, targetAuthor, err := r.resolveReportTarget(ctx, targetID)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	report := &model.Report{
+		ID:           GenerateID(),
+		TargetID:     targetID,
+		TargetType:   targetType,
+		TargetAuthor: targetAuthor,
+		Reason:       reason,
+		Details:      details,
+		Reporter:     reporter,
+		Status:       model.ReportStatusOpen,
+		CreatedAt:    now,
+		History: []*model.ReportStatusChange{{
+			Status:    model.ReportStatusOpen,
+			Actor:     reporter,
+			ChangedAt: now,
+		}},
+	}
+	if err := r.Storage.CreateReport(ctx, report); err != nil {
+		return nil, err
+	}
+	r.recordAudit(ctx, reporter, model.AuditActionReportContent, model.AuditTargetTypeReport, report.ID, nil, snapshot(report))
+	return report, nil
+}
+
+func (r *Resolver) ModerationQueue(ctx context.Context, moderator string, status *model.ReportStatus, first *int, after *string) (*model.ReportConnection, error) {
+	if !r.isModerator(moderator) {
+		return nil, errors.New("only moderators can view the moderation queue")
+	}
+	queueStatus := model.ReportStatusOpen
+	if status != nil {
+		queueStatus = *status
+	}
+	limit := defaultQueuePageSize
+	if first != nil {
+		if *first < 0 {
+			return nil, errors.New("first must not be negative")
+		}
+		limit = min(*first, maxQueuePageSize)
+	}
+	afterID := ""
+	if after != nil {
+		id, err := decodeCursor(*after)
+		if err != nil {
+			return nil, err
+		}
+		afterID = id
+	}
+
+	reports, err := r.Storage.GetReports(ctx, queueStatus, limit+1, afterID)
+	if err != nil {
+		return nil, err
+	}
+	conn := &model.ReportConnection{
+		Edges:    []*model.ReportEdge{},
+		PageInfo: &model.PageInfo{HasNextPage: len(reports) > limit},
+	}
+	if len(reports) > limit {
+		reports = reports[:limit]
+	}
+	for _, report := range reports {
+		conn.Edges = append(conn.Edges, &model.ReportEdge{Cursor: encodeCursor(report.ID), Node: report})
+	}
+	if len(conn.Edges) > 0 {
+		conn.PageInfo.EndCursor = &conn.Edges[len(conn.Edges)-1].Cursor
+	}
+	return conn, nil
+}
+
+func (r *Resolver) DismissReport(ctx context.Context, reportID, moderator string, note *string) (*model.Report, error) {
+	return r.resolveReport(ctx, reportID, moderator, note, model.ReportStatusDismissed)
+}
+
+func (r *Resolver) RemoveReportedContent(ctx context.Context, reportID, moderator string, note *string) (*model.Report, error) {
+	return r.resolveReport(ctx, reportID, moderator, note, model.ReportStatusRemoved)
+}
+
+func (r *Resolver) WarnReportedAuthor(ctx context.Context, reportID, moderator string, note *string) (*model.Report, error) {
+	return r.resolveReport(ctx, reportID, moderator, note, model.ReportStatusWarned)
+}
+
+func (r *Resolver) resolveReport(ctx context.Context, reportID, moderator string, note *string, status model.ReportStatus) (*model.Report, error) {
+	if !r.isModerator(moderator) {
+		return nil, errors.New("only moderators can resolve reports")
+	}
+	report, err := r.Storage.GetReportByID(ctx, reportID)
+	if err != nil {
+		return nil, err
+	}
+	if report.Status != model.ReportStatusOpen {
+		return nil, storage.ErrReportResolved
+	}
+	before := snapshot(report)
+	// Статус меняется условно и до побочных эффектов: из двух модераторов,
+	// закрывающих жалобу одновременно, действие выполнит только один.
+	updated, err := r.Storage.UpdateReportStatus(ctx, reportID, &model.ReportStatusChange{
+		Status:    status,
+		Actor:     moderator,
+		Note:      note,
+		ChangedAt: time.Now(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	var actionErr error
+	switch {
+	case status == model.ReportStatusRemoved:
+		actionErr = r.removeContent(ctx, moderator, report.TargetType, report.TargetID)
+	case status == model.ReportStatusDismissed && report.Reporter == contentFilterReporter:
+		actionErr = r.publishHeldContent(ctx, report)
+	}
+	if actionErr != nil {
+		// Жалоба снова открывается, чтобы действие можно было повторить.
+		r.reopenReport(ctx, reportID, moderator, status, actionErr)
+		return nil, actionErr
+	}
+	switch status {
+	case model.ReportStatusDismissed:
+		r.recordAudit(ctx, moderator, model.AuditActionDismissReport, model.AuditTargetTypeReport, reportID, before, snapshot(updated))
+	case model.ReportStatusWarned:
+		r.recordAudit(ctx, moderator, model.AuditActionWarnAuthor, model.AuditTargetTypeReport, reportID, before, snapshot(updated))
+	}
+	return updated, nil
+}
+
+func (r *Resolver) reopenReport(ctx context.Context, reportID, moderator string, from model.ReportStatus, cause error) {
+	note := "action failed: " + cause.Error()
+	err := r.Storage.ReopenReport(context.WithoutCancel(ctx), reportID, from, &model.ReportStatusChange{
+		Status:    model.ReportStatusOpen,
+		Actor:     moderator,
+		Note:      &note,
+		ChangedAt: time.Now(),
+	})
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to reopen report after failed action", "report_id", reportID, "error", err)
+	}
+}
+
+func (r *Resolver) resolveReportTarget(ctx context.Context, targetID string) (model.ReportTargetType, string, error) {
+	if comment, err := r.Storage.GetCommentByID(ctx, targetID); err == nil {
+		return model.ReportTargetTypeComment, comment.Author, nil
+	}
+	post, err := r.Storage.GetPostByID(ctx, targetID)
+	if err != nil {
+		return "", "", errors.New("report target not found")
+	}
+	return model.ReportTargetTypePost, post.Author, nil
+}
+
+func (r *Resolver) removeContent(ctx context.Context, moderator string, targetType model.ReportTargetType, targetID string) error {
+	if targetType == model.ReportTargetTypeComment {
+		comment, err := r.Storage.GetCommentByID(ctx, targetID)
+		if err != nil {
+			return err
+		}
+		before := snapshot(comment)
+		if err := r.Storage.RemoveComment(ctx, targetID); err != nil {
+			return err
+		}
+		r.recordAudit(ctx, moderator, model.AuditActionRemoveContent, model.AuditTargetTypeComment, targetID, before, nil)
+		r.publishCommentDeleted(comment)
+		return nil
+	}
+	post, err := r.Storage.GetPostByID(ctx, targetID)
+	if err != nil {
+		return err
+	}
+	before := snapshot(post)
+	if err := r.Storage.RemovePost(ctx, targetID); err != nil {
+		return err
+	}
+	r.recordAudit(ctx, moderator, model.AuditActionRemoveContent, model.AuditTargetTypePost, targetID, before, nil)
+	return nil
+}
+
+func (r *Resolver) isModerator(name string) bool {
+	_, ok := r.moderators[name]
+	return ok
+}
+
+func encodeCursor(id string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(id))
+}
+
+func decodeCursor(cursor string) (string, error) {
+	id, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return "", errors.New("invalid cursor")
+	}
+	return string(id), nil
+}
@@ -0,0 +1,279 @@
+package resolver_test
+
+import (
+	"context"
+	"errors"
+	"hivemind/graph/model"
+	"hivemind/graph/resolver"
+	"hivemind/internal/memory"
+	"hivemind/internal/storage"
+	"hivemind/internal/storage/mocks"
+	"testing"
+	"time"
+)
+
+func openReport(id, targetID string, targetType model.ReportTargetType) *model.Report {
+	return &model.Report{
+		ID:         id,
+		TargetID:   targetID,
+		TargetType: targetType,
+		Reason:     model.ReportReasonSpam,
+		Reporter:   "bob",
+		Status:     model.ReportStatusOpen,
+		CreatedAt:  time.Now(),
+	}
+}
+
+func TestReportContent(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("report comment", func(t *testing.T) {
+		mockStorage := mocks.NewStorageMock(t)
+		mockStorage.GetCommentByIDMock.Return(&model.Comment{ID: "comment123", Author: "alice"}, nil)
+		mockStorage.CreateReportMock.Return(nil)
+		mockStorage.CreateAuditEntryMock.Return(nil)
+
+		res := resolver.NewResolver(mockStorage)
+		report, err := res.ReportContent(ctx, "comment123", model.ReportReasonAbuse, nil, "bob")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if report.TargetType != model.ReportTargetTypeComment || report.TargetAuthor != "alice" || report.Status != model.ReportStatusOpen {
+			t.Errorf("unexpected report values: %+v", report)
+		}
+		if len(report.History) != 1 || report.History[0].Actor != "bob" {
+			t.Errorf("expected initial history entry by reporter, got: %+v", report.History)
+		}
+	})
+
+	t.Run("report post", func(t *testing.T) {
+		mockStorage := mocks.NewStorageMock(t)
+		mockStorage.GetCommentByIDMock.Return(nil, errors.New("comment not found"))
+		mockStorage.GetPostByIDMock.Return(&model.Post{ID: "post123", Author: "alice"}, nil)
+		mockStorage.CreateReportMock.Return(nil)
+		mockStorage.CreateAuditEntryMock.Return(nil)
+
+		res := resolver.NewResolver(mockStorage)
+		report, err := res.ReportContent(ctx, "post123", model.ReportReasonSpam, nil, "bob")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if report.TargetType != model.ReportTargetTypePost {
+			t.Errorf("expected POST target, got: %v", report.TargetType)
+		}
+	})
+
+	t.Run("target not found", func(t *testing.T) {
+		mockStorage := mocks.NewStorageMock(t)
+		mockStorage.GetCommentByIDMock.Return(nil, errors.New("comment not found"))
+		mockStorage.GetPostByIDMock.Return(nil, errors.New("post not found"))
+
+		res := resolver.NewResolver(mockStorage)
+		_, err := res.ReportContent(ctx, "missing", model.ReportReasonSpam, nil, "bob")
+		if err == nil || err.Error() != "report target not found" {
+			t.Errorf("expected 'report target not found' error, got: %v", err)
+		}
+	})
+
+	t.Run("details too long", func(t *testing.T) {
+		mockStorage := mocks.NewStorageMock(t)
+		details := string(make([]byte, 1001))
+
+		res := resolver.NewResolver(mockStorage)
+		_, err := res.ReportContent(ctx, "post123", model.ReportReasonOther, &details, "bob")
+		if err == nil || err.Error() != "report details too long" {
+			t.Errorf("expected 'report details too long' error, got: %v", err)
+		}
+	})
+
+	t.Run("reserved reporter", func(t *testing.T) {
+		mockStorage := mocks.NewStorageMock(t)
+
+		res := resolver.NewResolver(mockStorage)
+		_, err := res.ReportContent(ctx, "post123", model.ReportReasonSpam, nil, "content-filter")
+		if err == nil || err.Error() != "reporter name is reserved" {
+			t.Errorf("expected 'reporter name is reserved' error, got: %v", err)
+		}
+	})
+}
+
+func TestModerationQueue(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("not a moderator", func(t *testing.T) {
+		mockStorage := mocks.NewStorageMock(t)
+		res := resolver.NewResolver(mockStorage, resolver.WithModerators("mod"))
+		_, err := res.ModerationQueue(ctx, "bob", nil, nil, nil)
+		if err == nil || err.Error() != "only moderators can view the moderation queue" {
+			t.Errorf("expected moderator error, got: %v", err)
+		}
+	})
+
+	t.Run("pagination", func(t *testing.T) {
+		mockStorage := mocks.NewStorageMock(t)
+		mockStorage.GetReportsMock.Expect(ctx, model.ReportStatusOpen, 3, "").Return([]*model.Report{
+			openReport("r1", "c1", model.ReportTargetTypeComment),
+			openReport("r2", "c2", model.ReportTargetTypeComment),
+			openReport("r3", "c3", model.ReportTargetTypeComment),
+		}, nil)
+
+		res := resolver.NewResolver(mockStorage, resolver.WithModerators("mod"))
+		first := 2
+		conn, err := res.ModerationQueue(ctx, "mod", nil, &first, nil)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(conn.Edges) != 2 || !conn.PageInfo.HasNextPage {
+			t.Fatalf("unexpected connection: %+v", conn)
+		}
+
+		mockStorage.GetReportsMock.Expect(ctx, model.ReportStatusOpen, 3, "r2").Return([]*model.Report{
+			openReport("r3", "c3", model.ReportTargetTypeComment),
+		}, nil)
+		conn, err = res.ModerationQueue(ctx, "mod", nil, &first, conn.PageInfo.EndCursor)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(conn.Edges) != 1 || conn.PageInfo.HasNextPage || conn.Edges[0].Node.ID != "r3" {
+			t.Errorf("unexpected second page: %+v", conn)
+		}
+	})
+
+	t.Run("invalid cursor", func(t *testing.T) {
+		mockStorage := mocks.NewStorageMock(t)
+		res := resolver.NewResolver(mockStorage, resolver.WithModerators("mod"))
+		after := "%%%"
+		_, err := res.ModerationQueue(ctx, "mod", nil, nil, &after)
+		if err == nil || err.Error() != "invalid cursor" {
+			t.Errorf("expected 'invalid cursor' error, got: %v", err)
+		}
+	})
+}
+
+func TestResolveReport(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("remove comment", func(t *testing.T) {
+		mockStorage := mocks.NewStorageMock(t)
+		mockStorage.GetReportByIDMock.Return(openReport("r1", "comment123", model.ReportTargetTypeComment), nil)
+		mockStorage.GetCommentByIDMock.Return(&model.Comment{ID: "comment123", Author: "alice"}, nil)
+		mockStorage.RemoveCommentMock.Expect(ctx, "comment123").Return(nil)
+		mockStorage.CreateAuditEntryMock.Return(nil)
+		mockStorage.UpdateReportStatusMock.Set(func(ctx context.Context, id string, change *model.ReportStatusChange) (*model.Report, error) {
+			report := openReport(id, "comment123", model.ReportTargetTypeComment)
+			report.Status = change.Status
+			return report, nil
+		})
+
+		res := resolver.NewResolver(mockStorage, resolver.WithModerators("mod"))
+		report, err := res.RemoveReportedContent(ctx, "r1", "mod", nil)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if report.Status != model.ReportStatusRemoved {
+			t.Errorf("expected REMOVED status, got: %v", report.Status)
+		}
+	})
+
+	t.Run("dismiss does not remove content", func(t *testing.T) {
+		mockStorage := mocks.NewStorageMock(t)
+		mockStorage.GetReportByIDMock.Return(openReport("r1", "post123", model.ReportTargetTypePost), nil)
+		mockStorage.UpdateReportStatusMock.Return(&model.Report{ID: "r1", Status: model.ReportStatusDismissed}, nil)
+		mockStorage.CreateAuditEntryMock.Return(nil)
+
+		res := resolver.NewResolver(mockStorage, resolver.WithModerators("mod"))
+		if _, err := res.DismissReport(ctx, "r1", "mod", nil); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("already resolved", func(t *testing.T) {
+		mockStorage := mocks.NewStorageMock(t)
+		report := openReport("r1", "post123", model.ReportTargetTypePost)
+		report.Status = model.ReportStatusWarned
+		mockStorage.GetReportByIDMock.Return(report, nil)
+
+		res := resolver.NewResolver(mockStorage, resolver.WithModerators("mod"))
+		_, err := res.WarnReportedAuthor(ctx, "r1", "mod", nil)
+		if err == nil || err.Error() != "report is already resolved" {
+			t.Errorf("expected 'report is already resolved' error, got: %v", err)
+		}
+	})
+
+	t.Run("resolved concurrently", func(t *testing.T) {
+		mockStorage := mocks.NewStorageMock(t)
+		mockStorage.GetReportByIDMock.Return(openReport("r1", "comment123", model.ReportTargetTypeComment), nil)
+		mockStorage.UpdateReportStatusMock.Return(nil, storage.ErrReportResolved)
+
+		res := resolver.NewResolver(mockStorage, resolver.WithModerators("mod"))
+		_, err := res.RemoveReportedContent(ctx, "r1", "mod", nil)
+		if !errors.Is(err, storage.ErrReportResolved) {
+			t.Errorf("expected ErrReportResolved, got: %v", err)
+		}
+	})
+
+	t.Run("failed action reopens the report", func(t *testing.T) {
+		store := memory.NewMemoryStorage()
+		if err := store.CreateReport(ctx, openReport("r1", "missing", model.ReportTargetTypeComment)); err != nil {
+			t.Fatal(err)
+		}
+
+		res := resolver.NewResolver(store, resolver.WithModerators("mod"))
+		_, err := res.RemoveReportedContent(ctx, "r1", "mod", nil)
+		if err == nil || err.Error() != "comment not found" {
+			t.Fatalf("expected 'comment not found' error, got: %v", err)
+		}
+		report, err := store.GetReportByID(ctx, "r1")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if report.Status != model.ReportStatusOpen {
+			t.Errorf("expected report to be reopened, got: %v", report.Status)
+		}
+		last := report.History[len(report.History)-1]
+		if last.Status != model.ReportStatusOpen || last.Note == nil || *last.Note != "action failed: comment not found" {
+			t.Errorf("unexpected last history entry: %+v", last)
+		}
+	})
+
+	t.Run("removed comment keeps its replies", func(t *testing.T) {
+		store := memory.NewMemoryStorage()
+		res := resolver.NewResolver(store, resolver.WithModerators("mod"))
+		post, err := res.CreatePost(ctx, "Title", "Content", "alice", model.ContentFormatPlain, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		parent, err := res.CreateComment(ctx, post.ID, nil, "parent", "bob", model.ContentFormatPlain, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		reply, err := res.CreateComment(ctx, post.ID, &parent.ID, "reply", "carol", model.ContentFormatPlain, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		report, err := res.ReportContent(ctx, parent.ID, model.ReportReasonAbuse, nil, "dave")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if _, err := res.RemoveReportedContent(ctx, report.ID, "mod", nil); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if _, err := store.GetCommentByID(ctx, parent.ID); err == nil {
+			t.Error("removed comment must not be readable")
+		}
+		replies, err := store.GetReplies(ctx, parent.ID, 10, 0)
+		if err != nil || len(replies) != 1 || replies[0].ID != reply.ID {
+			t.Errorf("expected the reply to stay readable, got: %v, %v", replies, err)
+		}
+	})
+
+	t.Run("not a moderator", func(t *testing.T) {
+		mockStorage := mocks.NewStorageMock(t)
+		res := resolver.NewResolver(mockStorage)
+		_, err := res.DismissReport(ctx, "r1", "bob", nil)
+		if err == nil || err.Error() != "only moderators can resolve reports" {
+			t.Errorf("expected moderator error, got: %v", err)
+		}
+	})
+}
@@ -1,20 +1,120 @@
 package resolver
 
 import (
-	"hivemind/graph/model"
+	"hivemind/internal/contentfilter"
+	"hivemind/internal/hub"
+	"hivemind/internal/presence"
+	"hivemind/internal/pubsub"
+	"hivemind/internal/render"
 	"hivemind/internal/storage"
-	"sync"
+	"time"
 )
 
 type Resolver struct {
-	Storage     storage.Storage
-	subscribers map[string][]chan *model.Comment
-	mu          sync.Mutex
+	Storage    storage.Storage
+	moderators map[string]struct{}
+	admins     map[string]struct{}
+	filter     *contentfilter.Pipeline
+	renderer   *render.Renderer
+	broker     pubsub.Broker
+	instanceID string
+	events     *eventBus
+	queueSize  int
+	overflow   hub.Policy
+	typingTTL  time.Duration
+	presence   *presence.Tracker
+
+	maxCommentLength     int
+	idempotencyRetention time.Duration
+}
+
+const defaultRenderCacheSize = 10000
+
+type Option func(*Resolver)
+
+// WithModerators задаёт список пользователей, которым доступна очередь модерации.
+func WithModerators(names ...string) Option {
+	return func(r *Resolver) {
+		for _, name := range names {
+			r.moderators[name] = struct{}{}
+		}
+	}
+}
+
+// WithAdmins задаёт список пользователей, которым доступен журнал аудита.
+func WithAdmins(names ...string) Option {
+	return func(r *Resolver) {
+		for _, name := range names {
+			r.admins[name] = struct{}{}
+		}
+	}
+}
+
+// WithContentFilter включает проверку новых постов и комментариев фильтрами.
+func WithContentFilter(p *contentfilter.Pipeline) Option {
+	return func(r *Resolver) {
+		r.filter = p
+	}
+}
+
+// WithRenderer задаёт рендерер содержимого в HTML.
+func WithRenderer(renderer *render.Renderer) Option {
+	return func(r *Resolver) {
+		r.renderer = renderer
+	}
 }
 
-func NewResolver(storage storage.Storage) *Resolver {
-	return &Resolver{
-		Storage:     storage,
-		subscribers: make(map[string][]chan *model.Comment),
+// WithBroker задаёт брокер, через который события доходят до подписчиков
+// на других репликах. По умолчанию используется брокер внутри процесса.
+func WithBroker(b pubsub.Broker) Option {
+	return func(r *Resolver) {
+		r.broker = b
+	}
+}
+
+// WithSubscriptionQueue задаёт размер очереди каждого подписчика и политику
+// на случай её переполнения.
+func WithSubscriptionQueue(size int, policy hub.Policy) Option {
+	return func(r *Resolver) {
+		r.queueSize = size
+		r.overflow = policy
+	}
+}
+
+// WithTypingTTL задаёт, сколько держится отметка «пишет комментарий» после
+// последнего вызова setTyping.
+func WithTypingTTL(ttl time.Duration) Option {
+	return func(r *Resolver) {
+		r.typingTTL = ttl
+	}
+}
+
+// WithMaxCommentLength задаёт наибольшую длину комментария в байтах.
+func WithMaxCommentLength(n int) Option {
+	return func(r *Resolver) {
+		r.maxCommentLength = n
+	}
+}
+
+func NewResolver(storage storage.Storage, opts ...Option) *Resolver {
+	r := &Resolver{
+		Storage:    storage,
+		moderators: make(map[string]struct{}),
+		admins:     make(map[string]struct{}),
+		renderer:   render.New(defaultRenderCacheSize),
+		broker:     pubsub.NewInProcess(),
+		instanceID: GenerateID(),
+		queueSize:  defaultSubscriptionQueueSize,
+		overflow:   hub.Coalesce,
+		typingTTL:  defaultTypingTTL,
+
+		maxCommentLength:     defaultMaxCommentLength,
+		idempotencyRetention: defaultIdempotencyRetention,
+	}
+	for _, opt := range opts {
+		opt(r)
 	}
+	r.events = newEventBus(r.instanceID, r.broker, storage, r.queueSize, r.overflow)
+	r.presence = presence.New(r.instanceID, r.broker, r.typingTTL, r.publishPresence)
+	return r
 }
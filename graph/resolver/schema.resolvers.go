@@ -8,16 +8,32 @@ import (
 	"context"
 	"hivemind/graph/generated"
 	"hivemind/graph/model"
+	"time"
 )
 
+// ContentHTML is the resolver for the contentHtml field.
+func (r *commentResolver) ContentHTML(ctx context.Context, obj *model.Comment) (string, error) {
+	return r.Resolver.CommentContentHTML(ctx, obj)
+}
+
+// Cursor is the resolver for the cursor field.
+func (r *commentResolver) Cursor(ctx context.Context, obj *model.Comment) (string, error) {
+	return r.Resolver.CommentCursor(obj), nil
+}
+
+// Replies is the resolver for the replies field.
+func (r *commentResolver) Replies(ctx context.Context, obj *model.Comment, limit *int, offset *int) ([]*model.Comment, error) {
+	return r.Resolver.CommentReplies(ctx, obj, limit, offset)
+}
+
 // CreatePost is the resolver for the createPost field.
-func (r *mutationResolver) CreatePost(ctx context.Context, title string, content string, author string) (*model.Post, error) {
-	return r.Resolver.CreatePost(ctx, title, content, author)
+func (r *mutationResolver) CreatePost(ctx context.Context, title string, content string, author string, format model.ContentFormat, clientMutationID *string) (*model.Post, error) {
+	return r.Resolver.CreatePost(ctx, title, content, author, format, clientMutationID)
 }
 
 // CreateComment is the resolver for the createComment field.
-func (r *mutationResolver) CreateComment(ctx context.Context, postID string, parentID *string, content string, author string) (*model.Comment, error) {
-	return r.Resolver.CreateComment(ctx, postID, parentID, content, author)
+func (r *mutationResolver) CreateComment(ctx context.Context, postID string, parentID *string, content string, author string, format model.ContentFormat, clientMutationID *string) (*model.Comment, error) {
+	return r.Resolver.CreateComment(ctx, postID, parentID, content, author, format, clientMutationID)
 }
 
 // ToggleComments is the resolver for the toggleComments field.
@@ -25,6 +41,51 @@ func (r *mutationResolver) ToggleComments(ctx context.Context, postID string, en
 	return r.Resolver.ToggleComments(ctx, postID, enabled, author)
 }
 
+// UpdateComment is the resolver for the updateComment field.
+func (r *mutationResolver) UpdateComment(ctx context.Context, id string, content string, author string) (*model.Comment, error) {
+	return r.Resolver.UpdateComment(ctx, id, content, author)
+}
+
+// DeleteComment is the resolver for the deleteComment field.
+func (r *mutationResolver) DeleteComment(ctx context.Context, id string, author string) (bool, error) {
+	return r.Resolver.DeleteComment(ctx, id, author)
+}
+
+// SetTyping is the resolver for the setTyping field.
+func (r *mutationResolver) SetTyping(ctx context.Context, postID string, author string) (bool, error) {
+	return r.Resolver.SetTyping(ctx, postID, author)
+}
+
+// ReportContent is the resolver for the reportContent field.
+func (r *mutationResolver) ReportContent(ctx context.Context, targetID string, reason model.ReportReason, details *string, reporter string) (*model.Report, error) {
+	return r.Resolver.ReportContent(ctx, targetID, reason, details, reporter)
+}
+
+// DismissReport is the resolver for the dismissReport field.
+func (r *mutationResolver) DismissReport(ctx context.Context, reportID string, moderator string, note *string) (*model.Report, error) {
+	return r.Resolver.DismissReport(ctx, reportID, moderator, note)
+}
+
+// RemoveReportedContent is the resolver for the removeReportedContent field.
+func (r *mutationResolver) RemoveReportedContent(ctx context.Context, reportID string, moderator string, note *string) (*model.Report, error) {
+	return r.Resolver.RemoveReportedContent(ctx, reportID, moderator, note)
+}
+
+// WarnReportedAuthor is the resolver for the warnReportedAuthor field.
+func (r *mutationResolver) WarnReportedAuthor(ctx context.Context, reportID string, moderator string, note *string) (*model.Report, error) {
+	return r.Resolver.WarnReportedAuthor(ctx, reportID, moderator, note)
+}
+
+// ContentHTML is the resolver for the contentHtml field.
+func (r *postResolver) ContentHTML(ctx context.Context, obj *model.Post) (string, error) {
+	return r.Resolver.PostContentHTML(ctx, obj)
+}
+
+// Comments is the resolver for the comments field.
+func (r *postResolver) Comments(ctx context.Context, obj *model.Post, limit *int, offset *int) ([]*model.Comment, error) {
+	return r.Resolver.PostComments(ctx, obj, limit, offset)
+}
+
 // Posts is the resolver for the posts field.
 func (r *queryResolver) Posts(ctx context.Context) ([]*model.Post, error) {
 	return r.Resolver.Posts(ctx)
@@ -32,23 +93,76 @@ func (r *queryResolver) Posts(ctx context.Context) ([]*model.Post, error) {
 
 // Post is the resolver for the post field.
 func (r *queryResolver) Post(ctx context.Context, id string) (*model.Post, error) {
-	return r.Resolver.Post(ctx, id)
+	return r.Resolver.PostByID(ctx, id)
+}
+
+// Presence is the resolver for the presence field.
+func (r *queryResolver) Presence(ctx context.Context, postID string) (*model.Presence, error) {
+	return r.Resolver.Presence(ctx, postID)
+}
+
+// ModerationQueue is the resolver for the moderationQueue field.
+func (r *queryResolver) ModerationQueue(ctx context.Context, moderator string, status *model.ReportStatus, first *int, after *string) (*model.ReportConnection, error) {
+	return r.Resolver.ModerationQueue(ctx, moderator, status, first, after)
+}
+
+// AuditLog is the resolver for the auditLog field.
+func (r *queryResolver) AuditLog(ctx context.Context, admin string, filter *model.AuditLogFilter, first *int, after *string) (*model.AuditEntryConnection, error) {
+	return r.Resolver.AuditLog(ctx, admin, filter, first, after)
 }
 
 // CommentAdded is the resolver for the commentAdded field.
-func (r *subscriptionResolver) CommentAdded(ctx context.Context, postID string) (<-chan *model.Comment, error) {
-	return r.Resolver.CommentAdded(ctx, postID)
+func (r *subscriptionResolver) CommentAdded(ctx context.Context, postID string, since *time.Time, afterCursor *string) (<-chan *model.Comment, error) {
+	return r.Resolver.CommentAdded(ctx, postID, since, afterCursor)
+}
+
+// CommentUpdated is the resolver for the commentUpdated field.
+func (r *subscriptionResolver) CommentUpdated(ctx context.Context, postID string) (<-chan *model.Comment, error) {
+	return r.Resolver.CommentUpdated(ctx, postID)
+}
+
+// CommentDeleted is the resolver for the commentDeleted field.
+func (r *subscriptionResolver) CommentDeleted(ctx context.Context, postID string) (<-chan *model.CommentDeletion, error) {
+	return r.Resolver.CommentDeleted(ctx, postID)
+}
+
+// ReplyAdded is the resolver for the replyAdded field.
+func (r *subscriptionResolver) ReplyAdded(ctx context.Context, commentID string) (<-chan *model.Comment, error) {
+	return r.Resolver.ReplyAdded(ctx, commentID)
 }
 
+// PostCreated is the resolver for the postCreated field.
+func (r *subscriptionResolver) PostCreated(ctx context.Context, filter *model.PostFilter) (<-chan *model.Post, error) {
+	return r.Resolver.PostCreated(ctx, filter)
+}
+
+// PostUpdated is the resolver for the postUpdated field.
+func (r *subscriptionResolver) PostUpdated(ctx context.Context, postID string) (<-chan *model.Post, error) {
+	return r.Resolver.PostUpdated(ctx, postID)
+}
+
+// PresenceChanged is the resolver for the presenceChanged field.
+func (r *subscriptionResolver) PresenceChanged(ctx context.Context, postID string) (<-chan *model.Presence, error) {
+	return r.Resolver.PresenceChanged(ctx, postID)
+}
+
+// Comment returns generated.CommentResolver implementation.
+func (r *Resolver) Comment() generated.CommentResolver { return &commentResolver{r} }
+
 // Mutation returns generated.MutationResolver implementation.
 func (r *Resolver) Mutation() generated.MutationResolver { return &mutationResolver{r} }
 
+// Post returns generated.PostResolver implementation.
+func (r *Resolver) Post() generated.PostResolver { return &postResolver{r} }
+
 // Query returns generated.QueryResolver implementation.
 func (r *Resolver) Query() generated.QueryResolver { return &queryResolver{r} }
 
 // Subscription returns generated.SubscriptionResolver implementation.
 func (r *Resolver) Subscription() generated.SubscriptionResolver { return &subscriptionResolver{r} }
 
+type commentResolver struct{ *Resolver }
 type mutationResolver struct{ *Resolver }
+type postResolver struct{ *Resolver }
 type queryResolver struct{ *Resolver }
 type subscriptionResolver struct{ *Resolver }
@@ -2,36 +2,221 @@ package resolver
 
 import (
 	"context"
+	"errors"
 	"hivemind/graph/model"
+	"hivemind/internal/sse"
+	"hivemind/internal/storage"
+	"log/slog"
+	"time"
+
+	"github.com/vektah/gqlparser/v2/gqlerror"
 )
 
+const replayPageSize = 100
+
 func (r *Resolver) Subscribe(postID string) <-chan *model.Comment {
-	ch := make(chan *model.Comment, 1)
-	r.mu.Lock()
-	defer r.mu.Unlock()
-
-	if r.subscribers == nil {
-		r.subscribers = make(map[string][]chan *model.Comment)
-	}
-
-	r.subscribers[postID] = append(r.subscribers[postID], ch)
-	return ch
-}
-
-func (r *Resolver) CommentAdded(ctx context.Context, postID string) (<-chan *model.Comment, error) {
-	commentChan := r.Subscribe(postID)
-	go func() {
-		<-ctx.Done()
-		r.mu.Lock()
-		defer r.mu.Unlock()
-		subs := r.subscribers[postID]
-		for i, ch := range subs {
-			if ch == commentChan {
-				r.subscribers[postID] = append(subs[:i], subs[i+1:]...)
-				close(ch)
-				break
+	return r.events.commentAdded.hub.Subscribe(postID, nil).C()
+}
+
+func (r *Resolver) CommentAdded(ctx context.Context, postID string, since *time.Time, afterCursor *string) (<-chan *model.Comment, error) {
+	// Клиент SSE после обрыва присылает id последнего события — курсор комментария.
+	if last := sse.LastEventID(ctx); last != "" && since == nil && afterCursor == nil {
+		afterCursor = &last
+	}
+	if err := r.authorizePost(ctx, postID); err != nil {
+		return nil, err
+	}
+	from, err := r.replayStart(ctx, postID, since, afterCursor)
+	if err != nil {
+		return nil, err
+	}
+	// Подписка оформляется до догрузки, чтобы комментарии, созданные во время
+	// неё, не потерялись; повторы отсекаются в relay.
+	sub, err := r.events.commentAdded.subscribe(ctx, postID)
+	if err != nil {
+		return nil, err
+	}
+	r.watch(ctx, postID)
+	ids := sse.FromContext(ctx)
+	if from == nil && ids == nil {
+		return sub.C(), nil
+	}
+	out := make(chan *model.Comment)
+	go r.relay(ctx, sub.C(), out, ids, postID, from)
+	return out, nil
+}
+
+func (r *Resolver) CommentUpdated(ctx context.Context, postID string) (<-chan *model.Comment, error) {
+	if err := r.authorizePost(ctx, postID); err != nil {
+		return nil, err
+	}
+	sub, err := r.events.commentUpdated.subscribe(ctx, postID)
+	if err != nil {
+		return nil, err
+	}
+	return sub.C(), nil
+}
+
+func (r *Resolver) CommentDeleted(ctx context.Context, postID string) (<-chan *model.CommentDeletion, error) {
+	if err := r.authorizePost(ctx, postID); err != nil {
+		return nil, err
+	}
+	sub, err := r.events.commentDeleted.subscribe(ctx, postID)
+	if err != nil {
+		return nil, err
+	}
+	return sub.C(), nil
+}
+
+func (r *Resolver) ReplyAdded(ctx context.Context, commentID string) (<-chan *model.Comment, error) {
+	comment, err := r.Storage.GetCommentByID(ctx, commentID)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.authorizePost(ctx, comment.PostID); err != nil {
+		return nil, err
+	}
+	sub, err := r.events.replyAdded.subscribe(ctx, commentID)
+	if err != nil {
+		return nil, err
+	}
+	return sub.C(), nil
+}
+
+// PostCreated подписывает на новые посты. Посты публикуются под общим ключом
+// и под ключом автора, поэтому фильтр по автору не требует перебора.
+func (r *Resolver) PostCreated(ctx context.Context, filter *model.PostFilter) (<-chan *model.Post, error) {
+	key := ""
+	if filter != nil && filter.Author != nil {
+		key = authorKey(*filter.Author)
+	}
+	sub, err := r.events.postCreated.subscribe(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	return sub.C(), nil
+}
+
+func (r *Resolver) PostUpdated(ctx context.Context, postID string) (<-chan *model.Post, error) {
+	if err := r.authorizePost(ctx, postID); err != nil {
+		return nil, err
+	}
+	sub, err := r.events.postUpdated.subscribe(ctx, postID)
+	if err != nil {
+		return nil, err
+	}
+	return sub.C(), nil
+}
+
+// authorizePost проверяет, что пост существует и виден подписчику: скрытый
+// пост виден только его автору, остальным он не отличается от несуществующего.
+func (r *Resolver) authorizePost(ctx context.Context, postID string) error {
+	post, visibility, err := r.Storage.GetPostWithVisibility(ctx, postID)
+	if err != nil {
+		return err
+	}
+	if visibility != storage.VisibilityPublic && post.Author != Subscriber(ctx) {
+		return errors.New("post not found")
+	}
+	return nil
+}
+
+// replayPoint — место в ленте комментариев, после которого нужно догрузить
+// пропущенное.
+type replayPoint struct {
+	since   time.Time
+	afterID string
+}
+
+func (r *Resolver) replayStart(ctx context.Context, postID string, since *time.Time, afterCursor *string) (*replayPoint, error) {
+	switch {
+	case since != nil && afterCursor != nil:
+		return nil, errors.New("since and afterCursor cannot be used together")
+	case since != nil:
+		return &replayPoint{since: *since}, nil
+	case afterCursor != nil:
+		id, err := decodeCursor(*afterCursor)
+		if err != nil {
+			return nil, err
+		}
+		comment, err := r.Storage.GetCommentByID(ctx, id)
+		if err != nil || comment.PostID != postID {
+			return nil, errors.New("invalid cursor")
+		}
+		return &replayPoint{since: comment.CreatedAt, afterID: comment.ID}, nil
+	}
+	return nil, nil
+}
+
+// relay отдаёт пропущенные комментарии из хранилища, если задан from, а затем
+// живые события подписки, пропуская уже отправленные. Для SSE перед каждым
+// комментарием в ids кладётся его курсор.
+func (r *Resolver) relay(ctx context.Context, live <-chan *model.Comment, out chan<- *model.Comment, ids *sse.EventIDs, postID string, from *replayPoint) {
+	defer close(out)
+	send := func(comment *model.Comment) bool {
+		if ids != nil {
+			ids.Push(r.CommentCursor(comment))
+		}
+		select {
+		case out <- comment:
+			return true
+		case <-ctx.Done():
+			return false
+		}
+	}
+
+	seen := make(map[string]struct{})
+	for from != nil {
+		page, err := r.Storage.GetCommentsSince(ctx, postID, from.since, from.afterID, replayPageSize)
+		if err != nil {
+			slog.ErrorContext(ctx, "failed to replay comments", "post_id", postID, "error", err)
+			noticeFromContext(ctx).fail(gqlerror.Errorf("failed to replay missed comments"))
+			return
+		}
+		for _, comment := range page {
+			if !send(comment) {
+				return
 			}
+			seen[comment.ID] = struct{}{}
 		}
-	}()
-	return commentChan, nil
+		if len(page) < replayPageSize {
+			break
+		}
+		last := page[len(page)-1]
+		from = &replayPoint{since: last.CreatedAt, afterID: last.ID}
+	}
+
+	for comment := range live {
+		if _, ok := seen[comment.ID]; ok {
+			continue
+		}
+		if !send(comment) {
+			return
+		}
+	}
+}
+
+func authorKey(author string) string {
+	return "author:" + author
+}
+
+func (r *Resolver) publishPostCreated(post *model.Post) {
+	r.events.postCreated.publish(r.events, "", post)
+	r.events.postCreated.publish(r.events, authorKey(post.Author), post)
+}
+
+func (r *Resolver) publishPostUpdated(post *model.Post) {
+	r.events.postUpdated.publish(r.events, post.ID, post)
+}
+
+func (r *Resolver) publishCommentUpdated(comment *model.Comment) {
+	r.events.commentUpdated.publish(r.events, comment.PostID, comment)
+}
+
+func (r *Resolver) publishCommentDeleted(comment *model.Comment) {
+	r.events.commentDeleted.publish(r.events, comment.PostID, &model.CommentDeletion{
+		ID:       comment.ID,
+		PostID:   comment.PostID,
+		ParentID: comment.ParentID,
+	})
 }
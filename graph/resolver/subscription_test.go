@@ -2,13 +2,22 @@ package resolver_test
 
 import (
 	"context"
+	"errors"
 	"hivemind/graph/model"
 	"hivemind/graph/resolver"
+	"hivemind/internal/hub"
+	"hivemind/internal/pubsub"
+	"hivemind/internal/storage"
 	"hivemind/internal/storage/mocks"
 	"testing"
 	"time"
 
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+	"github.com/gojuno/minimock/v3"
 	"github.com/stretchr/testify/assert"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
 )
 
 func TestSubscribe(t *testing.T) {
@@ -57,11 +66,11 @@ func TestSubscribe(t *testing.T) {
 
 func TestCommentAdded(t *testing.T) {
 	t.Run("successful comment added", func(t *testing.T) {
-		mockStorage := mocks.NewStorageMock(t)
+		mockStorage := publicPost(mocks.NewStorageMock(t), "post123")
 		res := resolver.NewResolver(mockStorage)
 
 		postID := "post123"
-		commentChan, err := res.CommentAdded(context.Background(), postID)
+		commentChan, err := res.CommentAdded(context.Background(), postID, nil, nil)
 		if err != nil {
 			t.Fatalf("unexpected error: %v", err)
 		}
@@ -87,12 +96,12 @@ func TestCommentAdded(t *testing.T) {
 	})
 
 	t.Run("subscription cancellation", func(t *testing.T) {
-		mockStorage := mocks.NewStorageMock(t)
+		mockStorage := publicPost(mocks.NewStorageMock(t), "post123")
 		res := resolver.NewResolver(mockStorage)
 
 		postID := "post123"
 		ctx, cancel := context.WithCancel(context.Background())
-		commentChan, err := res.CommentAdded(ctx, postID)
+		commentChan, err := res.CommentAdded(ctx, postID, nil, nil)
 		if err != nil {
 			t.Fatalf("unexpected error: %v", err)
 		}
@@ -122,3 +131,350 @@ func TestCommentAdded(t *testing.T) {
 		}
 	})
 }
+
+// publicPost разрешает подписки на события публичного поста.
+func publicPost(m *mocks.StorageMock, postID string) *mocks.StorageMock {
+	m.GetPostWithVisibilityMock.Return(&model.Post{ID: postID, Author: "alice"}, storage.VisibilityPublic, nil)
+	return m
+}
+
+func TestCrossInstanceDelivery(t *testing.T) {
+	broker := pubsub.NewInProcess()
+	first := resolver.NewResolver(mocks.NewStorageMock(t), resolver.WithBroker(broker))
+	second := resolver.NewResolver(mocks.NewStorageMock(t), resolver.WithBroker(broker))
+
+	postID := "post123"
+	localChan := first.Subscribe(postID)
+	remoteChan := second.Subscribe(postID)
+
+	comment := &model.Comment{
+		ID:        "comment123",
+		PostID:    postID,
+		Content:   "Test comment",
+		Format:    model.ContentFormatPlain,
+		Author:    "alice",
+		CreatedAt: time.Now(),
+	}
+	first.NotifySubscribers(postID, comment)
+
+	for name, ch := range map[string]<-chan *model.Comment{"local": localChan, "remote": remoteChan} {
+		select {
+		case received := <-ch:
+			assert.Equal(t, comment.ID, received.ID, name)
+		case <-time.After(time.Second):
+			t.Errorf("timeout waiting for %s comment", name)
+		}
+	}
+
+	select {
+	case <-localChan:
+		t.Error("local subscriber received the comment twice")
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+func TestSubscriptionOverflow(t *testing.T) {
+	newComment := func(id string) *model.Comment {
+		return &model.Comment{ID: id, PostID: "post123", Author: "alice", CreatedAt: time.Now()}
+	}
+
+	t.Run("drop oldest", func(t *testing.T) {
+		res := resolver.NewResolver(mocks.NewStorageMock(t), resolver.WithSubscriptionQueue(2, hub.DropOldest))
+		commentChan := res.Subscribe("post123")
+		for _, id := range []string{"c1", "c2", "c3"} {
+			res.NotifySubscribers("post123", newComment(id))
+		}
+
+		assert.Equal(t, "c2", (<-commentChan).ID)
+		assert.Equal(t, "c3", (<-commentChan).ID)
+		stats := res.DeliveryStats()
+		assert.Equal(t, uint64(1), stats.Dropped)
+		assert.Equal(t, uint64(3), stats.Delivered)
+	})
+
+	t.Run("disconnect", func(t *testing.T) {
+		res := resolver.NewResolver(publicPost(mocks.NewStorageMock(t), "post123"), resolver.WithSubscriptionQueue(1, hub.Disconnect))
+		ctx := subscriptionContext(t)
+		commentChan, err := res.CommentAdded(ctx, "post123", nil, nil)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		res.NotifySubscribers("post123", newComment("c1"))
+		res.NotifySubscribers("post123", newComment("c2"))
+
+		next := receive(commentChan)
+		resp := resolver.SubscriptionNotices{}.InterceptResponse(ctx, next)
+		if resp == nil || len(resp.Errors) != 0 {
+			t.Fatalf("expected queued comment, got: %+v", resp)
+		}
+		resp = resolver.SubscriptionNotices{}.InterceptResponse(ctx, next)
+		if resp == nil || len(resp.Errors) != 1 || resp.Errors[0].Extensions["code"] != resolver.ErrSubscriberTooSlow {
+			t.Fatalf("expected %s error, got: %+v", resolver.ErrSubscriberTooSlow, resp)
+		}
+		if resp = (resolver.SubscriptionNotices{}).InterceptResponse(ctx, next); resp != nil {
+			t.Errorf("expected subscription to complete, got: %+v", resp)
+		}
+		assert.Equal(t, uint64(1), res.DeliveryStats().Disconnected)
+	})
+
+	t.Run("coalesce", func(t *testing.T) {
+		res := resolver.NewResolver(publicPost(mocks.NewStorageMock(t), "post123"), resolver.WithSubscriptionQueue(1, hub.Coalesce))
+		ctx := subscriptionContext(t)
+		commentChan, err := res.CommentAdded(ctx, "post123", nil, nil)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		for _, id := range []string{"c1", "c2", "c3"} {
+			res.NotifySubscribers("post123", newComment(id))
+		}
+
+		next := receive(commentChan)
+		resp := resolver.SubscriptionNotices{}.InterceptResponse(ctx, next)
+		if resp == nil || len(resp.Errors) != 1 || resp.Errors[0].Extensions["code"] != resolver.ErrEventsMissed {
+			t.Fatalf("expected %s notice, got: %+v", resolver.ErrEventsMissed, resp)
+		}
+		assert.Equal(t, 2, resp.Errors[0].Extensions["missed"])
+		resp = resolver.SubscriptionNotices{}.InterceptResponse(ctx, next)
+		if resp == nil || string(resp.Data) != "c3" {
+			t.Errorf("expected latest comment after notice, got: %+v", resp)
+		}
+	})
+}
+
+// subscriptionContext возвращает контекст подписки так, как его видит резолвер
+// при подключённом расширении SubscriptionNotices.
+func subscriptionContext(t *testing.T) context.Context {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	ctx = graphql.WithOperationContext(ctx, &graphql.OperationContext{
+		Operation: &ast.OperationDefinition{Operation: ast.Subscription},
+	})
+	var opCtx context.Context
+	resolver.SubscriptionNotices{}.InterceptOperation(ctx, func(ctx context.Context) graphql.ResponseHandler {
+		opCtx = ctx
+		return nil
+	})
+	return opCtx
+}
+
+func receive(ch <-chan *model.Comment) graphql.ResponseHandler {
+	return func(ctx context.Context) *graphql.Response {
+		select {
+		case comment, ok := <-ch:
+			if !ok {
+				return nil
+			}
+			return &graphql.Response{Data: []byte(comment.ID)}
+		case <-time.After(time.Second):
+			return nil
+		}
+	}
+}
+
+func TestCommentAddedReplay(t *testing.T) {
+	postID := "post123"
+	base := time.Now()
+	comment := func(id string, offset time.Duration) *model.Comment {
+		return &model.Comment{ID: id, PostID: postID, Author: "alice", CreatedAt: base.Add(offset)}
+	}
+
+	t.Run("replay then live without duplicates", func(t *testing.T) {
+		mockStorage := publicPost(mocks.NewStorageMock(t), postID)
+		last := comment("c1", 0)
+		reply := comment("c3", 2*time.Second)
+		reply.ParentID = &last.ID
+		mockStorage.GetCommentByIDMock.Expect(minimock.AnyContext, "c1").Return(last, nil)
+		mockStorage.GetCommentsSinceMock.Expect(minimock.AnyContext, postID, last.CreatedAt, "c1", 100).
+			Return([]*model.Comment{comment("c2", time.Second), reply}, nil)
+
+		res := resolver.NewResolver(mockStorage)
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		cursor := res.CommentCursor(last)
+		commentChan, err := res.CommentAdded(ctx, postID, nil, &cursor)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		res.NotifySubscribers(postID, reply)
+		res.NotifySubscribers(postID, comment("c4", 3*time.Second))
+
+		var ids []string
+		for len(ids) < 3 {
+			select {
+			case received := <-commentChan:
+				ids = append(ids, received.ID)
+			case <-time.After(time.Second):
+				t.Fatalf("timeout waiting for comments, got: %v", ids)
+			}
+		}
+		assert.Equal(t, []string{"c2", "c3", "c4"}, ids)
+		select {
+		case received := <-commentChan:
+			t.Errorf("unexpected comment %s", received.ID)
+		case <-time.After(100 * time.Millisecond):
+		}
+	})
+
+	t.Run("cursor from another post", func(t *testing.T) {
+		mockStorage := publicPost(mocks.NewStorageMock(t), postID)
+		other := comment("c1", 0)
+		other.PostID = "post456"
+		mockStorage.GetCommentByIDMock.Return(other, nil)
+
+		res := resolver.NewResolver(mockStorage)
+		cursor := res.CommentCursor(other)
+		_, err := res.CommentAdded(context.Background(), postID, nil, &cursor)
+		if err == nil || err.Error() != "invalid cursor" {
+			t.Errorf("expected 'invalid cursor' error, got: %v", err)
+		}
+	})
+
+	t.Run("since and cursor together", func(t *testing.T) {
+		res := resolver.NewResolver(publicPost(mocks.NewStorageMock(t), postID))
+		cursor := "YzE"
+		_, err := res.CommentAdded(context.Background(), postID, &base, &cursor)
+		if err == nil {
+			t.Error("expected error when both since and afterCursor are set")
+		}
+	})
+}
+
+func TestSubscriptionAuthorization(t *testing.T) {
+	limits := resolver.NewSubscriptionLimits(0, 0, 0)
+	connect := func(user string) context.Context {
+		ctx, _, err := limits.InitConnection(context.Background(), transport.InitPayload{"user": user})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		return ctx
+	}
+
+	t.Run("nonexistent post", func(t *testing.T) {
+		mockStorage := mocks.NewStorageMock(t)
+		mockStorage.GetPostWithVisibilityMock.Return(nil, "", errors.New("post not found"))
+		res := resolver.NewResolver(mockStorage)
+
+		_, err := res.CommentAdded(context.Background(), "missing", nil, nil)
+		if err == nil || err.Error() != "post not found" {
+			t.Errorf("expected 'post not found' error, got: %v", err)
+		}
+		assert.Equal(t, int64(0), res.DeliveryStats().Subscribers)
+	})
+
+	t.Run("held post is visible only to its author", func(t *testing.T) {
+		mockStorage := mocks.NewStorageMock(t)
+		mockStorage.GetPostWithVisibilityMock.Return(&model.Post{ID: "post123", Author: "alice"}, storage.VisibilityHeld, nil)
+		res := resolver.NewResolver(mockStorage)
+
+		if _, err := res.CommentUpdated(connect("bob"), "post123"); err == nil || err.Error() != "post not found" {
+			t.Errorf("expected 'post not found' error, got: %v", err)
+		}
+		if _, err := res.CommentUpdated(context.Background(), "post123"); err == nil {
+			t.Error("expected anonymous subscriber to be rejected")
+		}
+		if _, err := res.CommentUpdated(connect("alice"), "post123"); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+}
+
+func TestSubscriptionLimits(t *testing.T) {
+	operation := func(ctx context.Context) context.Context {
+		return graphql.WithOperationContext(ctx, &graphql.OperationContext{
+			Operation: &ast.OperationDefinition{Operation: ast.Subscription},
+		})
+	}
+	// open начинает подписку и возвращает её первый ответ: ошибку лимита или
+	// пустой ответ, после которого подписка остаётся открытой до отмены ctx.
+	open := func(limits *resolver.SubscriptionLimits, ctx context.Context) *graphql.Response {
+		responses := limits.InterceptOperation(operation(ctx), func(ctx context.Context) graphql.ResponseHandler {
+			return graphql.OneShot(&graphql.Response{})
+		})
+		return responses(ctx)
+	}
+	connect := func(limits *resolver.SubscriptionLimits, user string) (context.Context, context.CancelFunc) {
+		ctx, cancel := context.WithCancel(context.Background())
+		t.Cleanup(cancel)
+		ctx, _, err := limits.InitConnection(ctx, transport.InitPayload{"user": user})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		return ctx, cancel
+	}
+	isLimited := func(resp *graphql.Response) bool {
+		return resp != nil && len(resp.Errors) == 1 && resp.Errors[0].Extensions["code"] == resolver.ErrSubscriptionLimit
+	}
+
+	t.Run("per connection", func(t *testing.T) {
+		limits := resolver.NewSubscriptionLimits(2, 0, 0)
+		conn, _ := connect(limits, "alice")
+		first, cancel := context.WithCancel(conn)
+		assert.False(t, isLimited(open(limits, first)))
+		assert.False(t, isLimited(open(limits, conn)))
+		assert.True(t, isLimited(open(limits, conn)))
+
+		cancel()
+		assert.Eventually(t, func() bool { return !isLimited(open(limits, conn)) }, time.Second, 10*time.Millisecond)
+	})
+
+	t.Run("per user across connections", func(t *testing.T) {
+		limits := resolver.NewSubscriptionLimits(0, 1, 0)
+		first, _ := connect(limits, "alice")
+		second, _ := connect(limits, "alice")
+		other, _ := connect(limits, "bob")
+		assert.False(t, isLimited(open(limits, first)))
+		assert.True(t, isLimited(open(limits, second)))
+		assert.False(t, isLimited(open(limits, other)))
+	})
+
+	t.Run("idle connection is closed", func(t *testing.T) {
+		limits := resolver.NewSubscriptionLimits(0, 0, 50*time.Millisecond)
+		conn, _ := connect(limits, "alice")
+		sub, cancel := context.WithCancel(conn)
+		open(limits, sub)
+		select {
+		case <-conn.Done():
+			t.Fatal("connection with active subscription was closed")
+		case <-time.After(100 * time.Millisecond):
+		}
+
+		cancel()
+		select {
+		case <-conn.Done():
+		case <-time.After(time.Second):
+			t.Fatal("idle connection was not closed")
+		}
+	})
+}
+
+func TestDrain(t *testing.T) {
+	res := resolver.NewResolver(publicPost(mocks.NewStorageMock(t), "post123"))
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	commentChan, err := res.CommentAdded(ctx, "post123", nil, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	posts, _ := res.PostCreated(ctx, nil)
+	if _, err := res.SubscriptionHealth(ctx); err != nil {
+		t.Fatalf("unexpected health error: %v", err)
+	}
+	res.Drain()
+
+	for _, closed := range []func() bool{
+		func() bool { _, ok := <-commentChan; return !ok },
+		func() bool { _, ok := <-posts; return !ok },
+	} {
+		assert.True(t, closed(), "subscription must complete on drain")
+	}
+
+	_, err = res.CommentAdded(ctx, "post123", nil, nil)
+	var gqlErr *gqlerror.Error
+	if !errors.As(err, &gqlErr) || gqlErr.Extensions["code"] != resolver.ErrShuttingDown {
+		t.Errorf("expected %s error, got: %v", resolver.ErrShuttingDown, err)
+	}
+	if _, err := res.SubscriptionHealth(ctx); err == nil {
+		t.Error("expected drained subscriptions to be reported as unhealthy")
+	}
+}
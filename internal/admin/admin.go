@@ -0,0 +1,79 @@
+// Package admin определяет администратора по токену из заголовка
+// Authorization, чтобы доступ к служебным функциям нельзя было получить,
+// просто назвавшись администратором.
+package admin
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"net/http"
+	"strings"
+)
+
+type nameKey struct{}
+
+// Tokens сопоставляет токены именам администраторов.
+type Tokens map[string]string
+
+// ParseTokens разбирает записи вида "имя:токен".
+func ParseTokens(entries []string) (Tokens, error) {
+	tokens := make(Tokens, len(entries))
+	for _, entry := range entries {
+		name, token, ok := strings.Cut(entry, ":")
+		if !ok || name == "" || token == "" {
+			return nil, errors.New("expected name:token")
+		}
+		if _, dup := tokens[token]; dup {
+			return nil, errors.New("duplicate token for " + name)
+		}
+		tokens[token] = name
+	}
+	return tokens, nil
+}
+
+// Name возвращает имя администратора, подтверждённое Middleware.
+func Name(ctx context.Context) string {
+	name, _ := ctx.Value(nameKey{}).(string)
+	return name
+}
+
+// WithName сохраняет подтверждённое имя администратора в контексте.
+func WithName(ctx context.Context, name string) context.Context {
+	return context.WithValue(ctx, nameKey{}, name)
+}
+
+// Middleware подтверждает администратора по заголовку
+// "Authorization: Bearer <токен>". Запрос без токена или с неизвестным
+// токеном проходит дальше без имени администратора.
+func Middleware(tokens Tokens, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if name := tokens.lookup(bearer(r)); name != "" {
+			r = r.WithContext(WithName(r.Context(), name))
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+func bearer(r *http.Request) string {
+	scheme, token, ok := strings.Cut(r.Header.Get("Authorization"), " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") {
+		return ""
+	}
+	return strings.TrimSpace(token)
+}
+
+// lookup сравнивает токен со всеми известными за постоянное время, чтобы по
+// времени ответа нельзя было подобрать токен.
+func (t Tokens) lookup(token string) string {
+	if token == "" {
+		return ""
+	}
+	name := ""
+	for known, n := range t {
+		if subtle.ConstantTimeCompare([]byte(known), []byte(token)) == 1 {
+			name = n
+		}
+	}
+	return name
+}
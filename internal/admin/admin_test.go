@@ -0,0 +1,47 @@
+package admin_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"hivemind/internal/admin"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTokens(t *testing.T) {
+	tokens, err := admin.ParseTokens([]string{"root:secret", "ops:other"})
+	require.NoError(t, err)
+	assert.Equal(t, admin.Tokens{"secret": "root", "other": "ops"}, tokens)
+
+	_, err = admin.ParseTokens([]string{"root"})
+	assert.Error(t, err)
+	_, err = admin.ParseTokens([]string{"root:"})
+	assert.Error(t, err)
+	_, err = admin.ParseTokens([]string{"root:secret", "ops:secret"})
+	assert.Error(t, err)
+}
+
+func TestMiddleware(t *testing.T) {
+	tokens := admin.Tokens{"secret": "root"}
+	serve := func(authorization string) string {
+		var name string
+		h := admin.Middleware(tokens, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			name = admin.Name(r.Context())
+		}))
+		r := httptest.NewRequest(http.MethodGet, "/admin/audit.ndjson", nil)
+		if authorization != "" {
+			r.Header.Set("Authorization", authorization)
+		}
+		h.ServeHTTP(httptest.NewRecorder(), r)
+		return name
+	}
+
+	assert.Equal(t, "root", serve("Bearer secret"))
+	assert.Equal(t, "root", serve("bearer secret"))
+	assert.Empty(t, serve(""))
+	assert.Empty(t, serve("Bearer wrong"))
+	assert.Empty(t, serve("Basic secret"))
+}
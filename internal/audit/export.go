@@ -0,0 +1,138 @@
+package audit
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"hivemind/graph/model"
+	"hivemind/internal/admin"
+	"hivemind/internal/storage"
+)
+
+const exportPageSize = 500
+
+type exportLine struct {
+	ID         string                `json:"id"`
+	Actor      string                `json:"actor"`
+	Action     model.AuditAction     `json:"action"`
+	TargetType model.AuditTargetType `json:"targetType"`
+	TargetID   string                `json:"targetId"`
+	Before     json.RawMessage       `json:"before,omitempty"`
+	After      json.RawMessage       `json:"after,omitempty"`
+	CreatedAt  time.Time             `json:"createdAt"`
+}
+
+// exportError завершает выгрузку, если хранилище отказало после начала
+// ответа: статус уже отправлен, и без этой строки обрезанный журнал не
+// отличить от полного.
+type exportError struct {
+	Error string `json:"error"`
+}
+
+// ExportHandler выгружает журнал аудита в формате NDJSON (одна запись на строку).
+// Доступ есть только у администраторов, подтверждённых admin.Middleware;
+// фильтры передаются query-параметрами actor, action, targetId, since и
+// until (RFC 3339).
+func ExportHandler(store storage.Storage, admins []string) http.Handler {
+	allowed := make(map[string]struct{}, len(admins))
+	for _, name := range admins {
+		allowed[name] = struct{}{}
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		name := admin.Name(r.Context())
+		if name == "" {
+			w.Header().Set("WWW-Authenticate", "Bearer")
+			http.Error(w, "admin token required", http.StatusUnauthorized)
+			return
+		}
+		if _, ok := allowed[name]; !ok {
+			http.Error(w, "only admins can export the audit log", http.StatusForbidden)
+			return
+		}
+		filter, err := parseFilter(r.URL.Query().Get)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		flusher, _ := w.(http.Flusher)
+		enc := json.NewEncoder(w)
+		afterID := ""
+		for {
+			entries, err := store.GetAuditEntries(r.Context(), filter, exportPageSize, afterID)
+			if err != nil {
+				slog.ErrorContext(r.Context(), "audit export failed", "error", err)
+				if afterID == "" {
+					http.Error(w, "audit export failed", http.StatusInternalServerError)
+					return
+				}
+				enc.Encode(exportError{Error: "audit export failed"})
+				return
+			}
+			if afterID == "" {
+				w.Header().Set("Content-Type", "application/x-ndjson")
+			}
+			for _, e := range entries {
+				if err := enc.Encode(toExportLine(e)); err != nil {
+					return
+				}
+			}
+			if flusher != nil {
+				flusher.Flush()
+			}
+			if len(entries) < exportPageSize {
+				return
+			}
+			afterID = entries[len(entries)-1].ID
+		}
+	})
+}
+
+func parseFilter(get func(string) string) (model.AuditLogFilter, error) {
+	var f model.AuditLogFilter
+	if v := get("actor"); v != "" {
+		f.Actor = &v
+	}
+	if v := get("action"); v != "" {
+		action := model.AuditAction(v)
+		if !action.IsValid() {
+			return f, errors.New("unknown action " + v)
+		}
+		f.Action = &action
+	}
+	if v := get("targetId"); v != "" {
+		f.TargetID = &v
+	}
+	for key, dst := range map[string]**time.Time{"since": &f.Since, "until": &f.Until} {
+		if v := get(key); v != "" {
+			t, err := time.Parse(time.RFC3339, v)
+			if err != nil {
+				return f, errors.New("invalid " + key + ": expected RFC 3339 time")
+			}
+			*dst = &t
+		}
+	}
+	return f, nil
+}
+
+func toExportLine(e *model.AuditEntry) exportLine {
+	line := exportLine{
+		ID:         e.ID,
+		Actor:      e.Actor,
+		Action:     e.Action,
+		TargetType: e.TargetType,
+		TargetID:   e.TargetID,
+		CreatedAt:  e.CreatedAt,
+	}
+	if e.Before != nil {
+		line.Before = json.RawMessage(*e.Before)
+	}
+	if e.After != nil {
+		line.After = json.RawMessage(*e.After)
+	}
+	return line
+}
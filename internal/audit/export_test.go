@@ -0,0 +1,76 @@
+package audit_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"hivemind/graph/model"
+	"hivemind/internal/admin"
+	"hivemind/internal/audit"
+	"hivemind/internal/storage/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExportHandler(t *testing.T) {
+	tokens := admin.Tokens{"secret": "root", "other": "ops"}
+	export := func(store *mocks.StorageMock, token string) *httptest.ResponseRecorder {
+		h := admin.Middleware(tokens, audit.ExportHandler(store, []string{"root"}))
+		r := httptest.NewRequest(http.MethodGet, "/admin/audit.ndjson?admin=root", nil)
+		if token != "" {
+			r.Header.Set("Authorization", "Bearer "+token)
+		}
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, r)
+		return rec
+	}
+
+	t.Run("admin query parameter is not enough", func(t *testing.T) {
+		rec := export(mocks.NewStorageMock(t), "")
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	})
+
+	t.Run("token of a removed admin", func(t *testing.T) {
+		rec := export(mocks.NewStorageMock(t), "other")
+		assert.Equal(t, http.StatusForbidden, rec.Code)
+	})
+
+	t.Run("entries are exported", func(t *testing.T) {
+		store := mocks.NewStorageMock(t)
+		store.GetAuditEntriesMock.Return([]*model.AuditEntry{{ID: "1", Actor: "alice", Action: model.AuditActionCreatePost}}, nil)
+		rec := export(store, "secret")
+		require.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "application/x-ndjson", rec.Header().Get("Content-Type"))
+		assert.Contains(t, rec.Body.String(), `"actor":"alice"`)
+	})
+
+	t.Run("failure before the first line", func(t *testing.T) {
+		store := mocks.NewStorageMock(t)
+		store.GetAuditEntriesMock.Return(nil, assert.AnError)
+		rec := export(store, "secret")
+		assert.Equal(t, http.StatusInternalServerError, rec.Code)
+	})
+
+	t.Run("failure mid-stream ends with an error record", func(t *testing.T) {
+		store := mocks.NewStorageMock(t)
+		page := make([]*model.AuditEntry, 500)
+		for i := range page {
+			page[i] = &model.AuditEntry{ID: "id", Action: model.AuditActionCreatePost}
+		}
+		store.GetAuditEntriesMock.Set(func(ctx context.Context, filter model.AuditLogFilter, limit int, afterID string) ([]*model.AuditEntry, error) {
+			if afterID == "" {
+				return page, nil
+			}
+			return nil, assert.AnError
+		})
+		rec := export(store, "secret")
+		require.Equal(t, http.StatusOK, rec.Code)
+		lines := strings.Split(strings.TrimSpace(rec.Body.String()), "\n")
+		require.Len(t, lines, 501)
+		assert.JSONEq(t, `{"error":"audit export failed"}`, lines[500])
+	})
+}
@@ -0,0 +1,112 @@
+package cache
+
+import (
+	"container/list"
+	"sync"
+	"time"
+)
+
+type entry struct {
+	key     string
+	tag     string
+	value   any
+	size    int
+	expires time.Time
+}
+
+// lru хранит значения с ограничением по суммарному размеру и времени жизни.
+// Записи группируются по тегу, чтобы сбрасывать все страницы одного поста
+// сразу.
+type lru struct {
+	mu       sync.Mutex
+	maxBytes int
+	ttl      time.Duration
+
+	bytes   int
+	order   *list.List
+	entries map[string]*list.Element
+	tags    map[string]map[string]struct{}
+	// gen растёт при каждом сбросе. Чтение запоминает его до обращения к
+	// хранилищу и не кладёт результат, если за это время что-то сбросили:
+	// иначе устаревшие данные могли бы пережить инвалидацию.
+	gen uint64
+}
+
+func newLRU(maxBytes int, ttl time.Duration) *lru {
+	return &lru{
+		maxBytes: maxBytes,
+		ttl:      ttl,
+		order:    list.New(),
+		entries:  make(map[string]*list.Element),
+		tags:     make(map[string]map[string]struct{}),
+	}
+}
+
+func (c *lru) get(key string) (any, uint64, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	el, ok := c.entries[key]
+	if !ok {
+		return nil, c.gen, false
+	}
+	e := el.Value.(*entry)
+	if !time.Now().Before(e.expires) {
+		c.remove(el)
+		return nil, c.gen, false
+	}
+	c.order.MoveToFront(el)
+	return e.value, c.gen, true
+}
+
+// add кладёт значение, если с момента чтения gen не было сбросов.
+func (c *lru) add(key, tag string, value any, size int, gen uint64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if gen != c.gen || size > c.maxBytes {
+		return
+	}
+	if el, ok := c.entries[key]; ok {
+		c.remove(el)
+	}
+	e := &entry{key: key, tag: tag, value: value, size: size, expires: time.Now().Add(c.ttl)}
+	c.entries[key] = c.order.PushFront(e)
+	keys := c.tags[tag]
+	if keys == nil {
+		keys = make(map[string]struct{})
+		c.tags[tag] = keys
+	}
+	keys[key] = struct{}{}
+	c.bytes += size
+	for c.bytes > c.maxBytes {
+		c.remove(c.order.Back())
+	}
+}
+
+func (c *lru) invalidate(tags ...string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.gen++
+	for _, tag := range tags {
+		for key := range c.tags[tag] {
+			c.remove(c.entries[key])
+		}
+	}
+}
+
+func (c *lru) remove(el *list.Element) {
+	e := c.order.Remove(el).(*entry)
+	delete(c.entries, e.key)
+	if keys := c.tags[e.tag]; keys != nil {
+		delete(keys, e.key)
+		if len(keys) == 0 {
+			delete(c.tags, e.tag)
+		}
+	}
+	c.bytes -= e.size
+}
+
+func (c *lru) stats() (entries, bytes int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.entries), c.bytes
+}
@@ -0,0 +1,324 @@
+// Package cache кеширует горячие чтения хранилища: посты по идентификатору и
+// страницы комментариев и ответов.
+//
+// Записи, меняющие закешированные данные, сбрасывают их сразу и рассылают
+// сброс через брокер, чтобы другие реплики сервиса не отдавали устаревшее.
+// TTL ограничивает время, в течение которого реплика может не знать о
+// сбросе, если сообщение брокера потерялось.
+//
+// Промахи заполняются с реплик. Реплика может отставать и сразу после сброса
+// вернуть старые данные, поэтому в течение окна WithPrimaryFillWindow после
+// сброса тега его записи заполняются из основной базы.
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"strconv"
+	"sync"
+	"time"
+
+	"hivemind/graph/model"
+	"hivemind/internal/pubsub"
+	"hivemind/internal/storage"
+)
+
+const topic = "hivemind_cache"
+
+// entryOverhead приблизительно учитывает память под структуру записи, ключ
+// и служебные поля, чтобы мелкие значения тоже ограничивались maxBytes.
+const entryOverhead = 256
+
+type message struct {
+	Tags []string `json:"tags"`
+}
+
+// Storage — хранилище с кешем. Остальные методы передаются в next без
+// изменений.
+type Storage struct {
+	storage.Storage
+	cache  *lru
+	broker pubsub.Broker
+
+	primaryWindow time.Duration
+	mu            sync.Mutex
+	// invalidated хранит время сброса тегов, сброшенных не раньше чем
+	// primaryWindow назад.
+	invalidated map[string]time.Time
+	pruned      time.Time
+}
+
+type Option func(*Storage)
+
+// WithPrimaryFillWindow задаёт, сколько после сброса тега его записи
+// заполняются из основной базы, а не с реплики. Обычно равно
+// database.sticky_primary_window; ноль всегда читает реплики.
+func WithPrimaryFillWindow(d time.Duration) Option {
+	return func(s *Storage) {
+		s.primaryWindow = d
+	}
+}
+
+var _ storage.Storage = (*Storage)(nil)
+
+// New оборачивает next кешем размером не более maxBytes байт, записи которого
+// живут ttl.
+func New(next storage.Storage, broker pubsub.Broker, maxBytes int, ttl time.Duration, opts ...Option) *Storage {
+	s := &Storage{Storage: next, cache: newLRU(maxBytes, ttl), broker: broker, invalidated: make(map[string]time.Time)}
+	for _, opt := range opts {
+		opt(s)
+	}
+	if err := broker.Subscribe(topic, s.handle); err != nil {
+		slog.Error("failed to subscribe to cache invalidation", "topic", topic, "error", err)
+	}
+	return s
+}
+
+// Stats возвращает число записей и их приблизительный размер в байтах.
+func (s *Storage) Stats() (entries, bytes int) {
+	return s.cache.stats()
+}
+
+func postTag(id string) string {
+	return "post:" + id
+}
+
+// pageTag — тег страниц, в которые попадает комментарий: комментарии
+// верхнего уровня поста или ответы на родительский комментарий.
+func pageTag(c *model.Comment) string {
+	if c.ParentID != nil {
+		return repliesTag(*c.ParentID)
+	}
+	return commentsTag(c.PostID)
+}
+
+func commentsTag(postID string) string {
+	return "comments:" + postID
+}
+
+func repliesTag(parentID string) string {
+	return "replies:" + parentID
+}
+
+func pageKey(tag string, limit, offset int) string {
+	return tag + ":" + strconv.Itoa(limit) + ":" + strconv.Itoa(offset)
+}
+
+// GetPostByID читает мимо кеша, если запрошена основная база; то же верно
+// для страниц.
+func (s *Storage) GetPostByID(ctx context.Context, id string) (*model.Post, error) {
+	if storage.PrimaryRequested(ctx) {
+		return s.Storage.GetPostByID(ctx, id)
+	}
+	key := postTag(id)
+	v, gen, ok := s.cache.get(key)
+	if ok {
+		return copyPost(v.(*model.Post)), nil
+	}
+	post, err := s.Storage.GetPostByID(s.fillContext(ctx, key), id)
+	if err != nil {
+		return nil, err
+	}
+	cached := copyPost(post)
+	s.cache.add(key, key, cached, postSize(cached), gen)
+	return post, nil
+}
+
+func (s *Storage) GetCommentsByPostID(ctx context.Context, postID string, limit, offset int) ([]*model.Comment, error) {
+	return s.page(ctx, commentsTag(postID), limit, offset, func(ctx context.Context) ([]*model.Comment, error) {
+		return s.Storage.GetCommentsByPostID(ctx, postID, limit, offset)
+	})
+}
+
+func (s *Storage) GetReplies(ctx context.Context, parentID string, limit, offset int) ([]*model.Comment, error) {
+	return s.page(ctx, repliesTag(parentID), limit, offset, func(ctx context.Context) ([]*model.Comment, error) {
+		return s.Storage.GetReplies(ctx, parentID, limit, offset)
+	})
+}
+
+func (s *Storage) page(ctx context.Context, tag string, limit, offset int, load func(ctx context.Context) ([]*model.Comment, error)) ([]*model.Comment, error) {
+	if storage.PrimaryRequested(ctx) {
+		return load(ctx)
+	}
+	key := pageKey(tag, limit, offset)
+	v, gen, ok := s.cache.get(key)
+	if ok {
+		return copyComments(v.([]*model.Comment)), nil
+	}
+	comments, err := load(s.fillContext(ctx, tag))
+	if err != nil {
+		return nil, err
+	}
+	cached := copyComments(comments)
+	size := entryOverhead
+	for _, c := range cached {
+		size += commentSize(c)
+	}
+	s.cache.add(key, tag, cached, size, gen)
+	return comments, nil
+}
+
+func (s *Storage) ToggleComments(ctx context.Context, postID string, enabled bool, author string) (*model.Post, error) {
+	post, err := s.Storage.ToggleComments(ctx, postID, enabled, author)
+	if err != nil {
+		return nil, err
+	}
+	s.invalidate(ctx, postTag(postID))
+	return post, nil
+}
+
+func (s *Storage) RemovePost(ctx context.Context, id string) error {
+	if err := s.Storage.RemovePost(ctx, id); err != nil {
+		return err
+	}
+	s.invalidate(ctx, postTag(id))
+	return nil
+}
+
+func (s *Storage) PublishPost(ctx context.Context, id string) error {
+	if err := s.Storage.PublishPost(ctx, id); err != nil {
+		return err
+	}
+	s.invalidate(ctx, postTag(id))
+	return nil
+}
+
+func (s *Storage) CreateComment(ctx context.Context, c *model.Comment, visibility storage.Visibility) error {
+	if err := s.Storage.CreateComment(ctx, c, visibility); err != nil {
+		return err
+	}
+	// Скрытые и ждущие модерации комментарии в страницы не попадают.
+	if visibility == storage.VisibilityPublic {
+		s.invalidate(ctx, pageTag(c))
+	}
+	return nil
+}
+
+func (s *Storage) UpdateComment(ctx context.Context, id, content string, editedAt time.Time) (*model.Comment, storage.Visibility, error) {
+	c, visibility, err := s.Storage.UpdateComment(ctx, id, content, editedAt)
+	if err != nil {
+		return nil, "", err
+	}
+	s.invalidate(ctx, pageTag(c))
+	return c, visibility, nil
+}
+
+func (s *Storage) RemoveComment(ctx context.Context, id string) error {
+	// После удаления комментарий уже не найти, поэтому его место в страницах
+	// узнаём заранее.
+	c, err := s.Storage.GetCommentByID(ctx, id)
+	if err != nil {
+		return s.Storage.RemoveComment(ctx, id)
+	}
+	if err := s.Storage.RemoveComment(ctx, id); err != nil {
+		return err
+	}
+	s.invalidate(ctx, pageTag(c))
+	return nil
+}
+
+func (s *Storage) PublishComment(ctx context.Context, id string) error {
+	if err := s.Storage.PublishComment(ctx, id); err != nil {
+		return err
+	}
+	c, err := s.Storage.GetCommentByID(ctx, id)
+	if err != nil {
+		slog.WarnContext(ctx, "failed to load published comment for cache invalidation", "comment_id", id, "error", err)
+		return nil
+	}
+	s.invalidate(ctx, pageTag(c))
+	return nil
+}
+
+// fillContext направляет заполнение в основную базу, только если тег
+// сбросили недавно и реплики могли ещё не получить запись.
+func (s *Storage) fillContext(ctx context.Context, tag string) context.Context {
+	if s.primaryWindow <= 0 {
+		return ctx
+	}
+	s.mu.Lock()
+	at, ok := s.invalidated[tag]
+	s.mu.Unlock()
+	if ok && time.Since(at) < s.primaryWindow {
+		return storage.WithPrimary(ctx)
+	}
+	return ctx
+}
+
+// markInvalidated запоминает время сброса и раз в окно забывает старые теги.
+func (s *Storage) markInvalidated(tags []string) {
+	if s.primaryWindow <= 0 {
+		return
+	}
+	now := time.Now()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, tag := range tags {
+		s.invalidated[tag] = now
+	}
+	if now.Sub(s.pruned) < s.primaryWindow {
+		return
+	}
+	s.pruned = now
+	for tag, at := range s.invalidated {
+		if now.Sub(at) >= s.primaryWindow {
+			delete(s.invalidated, tag)
+		}
+	}
+}
+
+// invalidate сбрасывает теги на этой реплике и рассылает сброс остальным.
+func (s *Storage) invalidate(ctx context.Context, tags ...string) {
+	s.markInvalidated(tags)
+	s.cache.invalidate(tags...)
+	payload, err := json.Marshal(message{Tags: tags})
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to encode cache invalidation", "error", err)
+		return
+	}
+	if err := s.broker.Publish(context.WithoutCancel(ctx), topic, payload); err != nil {
+		slog.ErrorContext(ctx, "failed to publish cache invalidation", "tags", tags, "error", err)
+	}
+}
+
+func (s *Storage) handle(payload []byte) {
+	var msg message
+	if err := json.Unmarshal(payload, &msg); err != nil {
+		slog.Error("failed to decode cache invalidation", "error", err)
+		return
+	}
+	s.markInvalidated(msg.Tags)
+	s.cache.invalidate(msg.Tags...)
+}
+
+// copyPost и copyComments отдают копии, чтобы вызывающий код не мог изменить
+// закешированное значение.
+func copyPost(p *model.Post) *model.Post {
+	post := *p
+	return &post
+}
+
+func copyComments(comments []*model.Comment) []*model.Comment {
+	if comments == nil {
+		return nil
+	}
+	out := make([]*model.Comment, len(comments))
+	for i, c := range comments {
+		comment := *c
+		out[i] = &comment
+	}
+	return out
+}
+
+func postSize(p *model.Post) int {
+	return entryOverhead + len(p.ID) + len(p.Title) + len(p.Content) + len(p.Author)
+}
+
+func commentSize(c *model.Comment) int {
+	size := entryOverhead + len(c.ID) + len(c.PostID) + len(c.Author) + len(c.Content)
+	if c.ParentID != nil {
+		size += len(*c.ParentID)
+	}
+	return size
+}
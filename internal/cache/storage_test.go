@@ -0,0 +1,226 @@
+package cache_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"hivemind/graph/model"
+	"hivemind/internal/cache"
+	"hivemind/internal/pubsub"
+	"hivemind/internal/storage"
+	"hivemind/internal/storage/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func ptr(s string) *string {
+	return &s
+}
+
+func TestStorage(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("post is read from storage once", func(t *testing.T) {
+		mockStorage := mocks.NewStorageMock(t)
+		mockStorage.GetPostByIDMock.Return(&model.Post{ID: "post1", Title: "Title"}, nil)
+		s := cache.New(mockStorage, pubsub.NewInProcess(), 1<<20, time.Minute)
+
+		first, err := s.GetPostByID(ctx, "post1")
+		require.NoError(t, err)
+		first.Title = "changed by caller"
+
+		second, err := s.GetPostByID(ctx, "post1")
+		require.NoError(t, err)
+		assert.Equal(t, "Title", second.Title)
+		assert.EqualValues(t, 1, mockStorage.GetPostByIDAfterCounter())
+	})
+
+	t.Run("cache is filled from replicas", func(t *testing.T) {
+		mockStorage := mocks.NewStorageMock(t)
+		mockStorage.GetPostByIDMock.Set(func(ctx context.Context, id string) (*model.Post, error) {
+			assert.False(t, storage.PrimaryRequested(ctx))
+			return &model.Post{ID: id}, nil
+		})
+		mockStorage.GetCommentsByPostIDMock.Set(func(ctx context.Context, postID string, limit, offset int) ([]*model.Comment, error) {
+			assert.False(t, storage.PrimaryRequested(ctx))
+			return []*model.Comment{}, nil
+		})
+		s := cache.New(mockStorage, pubsub.NewInProcess(), 1<<20, time.Minute, cache.WithPrimaryFillWindow(time.Minute))
+
+		_, err := s.GetPostByID(ctx, "post1")
+		require.NoError(t, err)
+		_, err = s.GetCommentsByPostID(ctx, "post1", 10, 0)
+		require.NoError(t, err)
+	})
+
+	t.Run("recently invalidated entries are filled from the primary", func(t *testing.T) {
+		var primary []bool
+		mockStorage := mocks.NewStorageMock(t)
+		mockStorage.GetPostByIDMock.Set(func(ctx context.Context, id string) (*model.Post, error) {
+			primary = append(primary, storage.PrimaryRequested(ctx))
+			return &model.Post{ID: id}, nil
+		})
+		mockStorage.ToggleCommentsMock.Return(&model.Post{ID: "post1"}, nil)
+		s := cache.New(mockStorage, pubsub.NewInProcess(), 1<<20, time.Minute, cache.WithPrimaryFillWindow(50*time.Millisecond))
+
+		_, err := s.ToggleComments(ctx, "post1", false, "alice")
+		require.NoError(t, err)
+		_, err = s.GetPostByID(ctx, "post1")
+		require.NoError(t, err)
+
+		// После окна запись снова заполняется с реплики.
+		_, err = s.ToggleComments(ctx, "post1", true, "alice")
+		require.NoError(t, err)
+		time.Sleep(60 * time.Millisecond)
+		_, err = s.GetPostByID(ctx, "post1")
+		require.NoError(t, err)
+		assert.Equal(t, []bool{true, false}, primary)
+	})
+
+	t.Run("primary reads bypass the cache", func(t *testing.T) {
+		mockStorage := mocks.NewStorageMock(t)
+		mockStorage.GetPostByIDMock.Return(&model.Post{ID: "post1"}, nil)
+		s := cache.New(mockStorage, pubsub.NewInProcess(), 1<<20, time.Minute)
+
+		_, err := s.GetPostByID(ctx, "post1")
+		require.NoError(t, err)
+		_, err = s.GetPostByID(storage.WithPrimary(ctx), "post1")
+		require.NoError(t, err)
+		assert.EqualValues(t, 2, mockStorage.GetPostByIDAfterCounter())
+	})
+
+	t.Run("errors are not cached", func(t *testing.T) {
+		mockStorage := mocks.NewStorageMock(t)
+		mockStorage.GetPostByIDMock.Return(nil, assert.AnError)
+		s := cache.New(mockStorage, pubsub.NewInProcess(), 1<<20, time.Minute)
+
+		_, err := s.GetPostByID(ctx, "post1")
+		assert.Error(t, err)
+		_, err = s.GetPostByID(ctx, "post1")
+		assert.Error(t, err)
+		assert.EqualValues(t, 2, mockStorage.GetPostByIDAfterCounter())
+	})
+
+	t.Run("toggle comments invalidates the post", func(t *testing.T) {
+		mockStorage := mocks.NewStorageMock(t)
+		mockStorage.GetPostByIDMock.Return(&model.Post{ID: "post1", CommentsEnabled: true}, nil)
+		mockStorage.ToggleCommentsMock.Return(&model.Post{ID: "post1"}, nil)
+		s := cache.New(mockStorage, pubsub.NewInProcess(), 1<<20, time.Minute)
+
+		_, err := s.GetPostByID(ctx, "post1")
+		require.NoError(t, err)
+		_, err = s.ToggleComments(ctx, "post1", false, "alice")
+		require.NoError(t, err)
+		_, err = s.GetPostByID(ctx, "post1")
+		require.NoError(t, err)
+		assert.EqualValues(t, 2, mockStorage.GetPostByIDAfterCounter())
+	})
+
+	t.Run("new comment invalidates only its pages", func(t *testing.T) {
+		mockStorage := mocks.NewStorageMock(t)
+		mockStorage.GetCommentsByPostIDMock.Return([]*model.Comment{{ID: "c1", PostID: "post1"}}, nil)
+		mockStorage.GetRepliesMock.Return([]*model.Comment{}, nil)
+		mockStorage.CreateCommentMock.Return(nil)
+		s := cache.New(mockStorage, pubsub.NewInProcess(), 1<<20, time.Minute)
+
+		load := func() {
+			_, err := s.GetCommentsByPostID(ctx, "post1", 10, 0)
+			require.NoError(t, err)
+			_, err = s.GetReplies(ctx, "c1", 10, 0)
+			require.NoError(t, err)
+		}
+		load()
+		load()
+		assert.EqualValues(t, 1, mockStorage.GetCommentsByPostIDAfterCounter())
+		assert.EqualValues(t, 1, mockStorage.GetRepliesAfterCounter())
+
+		reply := &model.Comment{ID: "c2", PostID: "post1", ParentID: ptr("c1")}
+		require.NoError(t, s.CreateComment(ctx, reply, storage.VisibilityPublic))
+		load()
+		assert.EqualValues(t, 1, mockStorage.GetCommentsByPostIDAfterCounter())
+		assert.EqualValues(t, 2, mockStorage.GetRepliesAfterCounter())
+
+		held := &model.Comment{ID: "c3", PostID: "post1"}
+		require.NoError(t, s.CreateComment(ctx, held, storage.VisibilityHeld))
+		load()
+		assert.EqualValues(t, 1, mockStorage.GetCommentsByPostIDAfterCounter())
+
+		top := &model.Comment{ID: "c4", PostID: "post1"}
+		require.NoError(t, s.CreateComment(ctx, top, storage.VisibilityPublic))
+		load()
+		assert.EqualValues(t, 2, mockStorage.GetCommentsByPostIDAfterCounter())
+		assert.EqualValues(t, 2, mockStorage.GetRepliesAfterCounter())
+	})
+
+	t.Run("removed comment invalidates its page", func(t *testing.T) {
+		mockStorage := mocks.NewStorageMock(t)
+		mockStorage.GetRepliesMock.Return([]*model.Comment{{ID: "c2", PostID: "post1", ParentID: ptr("c1")}}, nil)
+		mockStorage.GetCommentByIDMock.Return(&model.Comment{ID: "c2", PostID: "post1", ParentID: ptr("c1")}, nil)
+		mockStorage.RemoveCommentMock.Return(nil)
+		s := cache.New(mockStorage, pubsub.NewInProcess(), 1<<20, time.Minute)
+
+		_, err := s.GetReplies(ctx, "c1", 10, 0)
+		require.NoError(t, err)
+		require.NoError(t, s.RemoveComment(ctx, "c2"))
+		_, err = s.GetReplies(ctx, "c1", 10, 0)
+		require.NoError(t, err)
+		assert.EqualValues(t, 2, mockStorage.GetRepliesAfterCounter())
+	})
+
+	t.Run("edit on one replica invalidates another", func(t *testing.T) {
+		broker := pubsub.NewInProcess()
+		first := mocks.NewStorageMock(t)
+		first.UpdateCommentMock.Return(&model.Comment{ID: "c1", PostID: "post1"}, storage.VisibilityPublic, nil)
+		second := mocks.NewStorageMock(t)
+		second.GetCommentsByPostIDMock.Return([]*model.Comment{{ID: "c1", PostID: "post1"}}, nil)
+		writer := cache.New(first, broker, 1<<20, time.Minute)
+		reader := cache.New(second, broker, 1<<20, time.Minute)
+
+		_, err := reader.GetCommentsByPostID(ctx, "post1", 10, 0)
+		require.NoError(t, err)
+		_, _, err = writer.UpdateComment(ctx, "c1", "edited", time.Now())
+		require.NoError(t, err)
+		_, err = reader.GetCommentsByPostID(ctx, "post1", 10, 0)
+		require.NoError(t, err)
+		assert.EqualValues(t, 2, second.GetCommentsByPostIDAfterCounter())
+	})
+
+	t.Run("entries expire after ttl", func(t *testing.T) {
+		mockStorage := mocks.NewStorageMock(t)
+		mockStorage.GetPostByIDMock.Return(&model.Post{ID: "post1"}, nil)
+		s := cache.New(mockStorage, pubsub.NewInProcess(), 1<<20, 20*time.Millisecond)
+
+		_, err := s.GetPostByID(ctx, "post1")
+		require.NoError(t, err)
+		time.Sleep(30 * time.Millisecond)
+		_, err = s.GetPostByID(ctx, "post1")
+		require.NoError(t, err)
+		assert.EqualValues(t, 2, mockStorage.GetPostByIDAfterCounter())
+	})
+
+	t.Run("memory stays within the limit", func(t *testing.T) {
+		mockStorage := mocks.NewStorageMock(t)
+		mockStorage.GetPostByIDMock.Set(func(ctx context.Context, id string) (*model.Post, error) {
+			return &model.Post{ID: id, Content: strings.Repeat("x", 1000)}, nil
+		})
+		s := cache.New(mockStorage, pubsub.NewInProcess(), 5000, time.Minute)
+
+		for _, id := range []string{"p1", "p2", "p3", "p4", "p5", "p6"} {
+			_, err := s.GetPostByID(ctx, id)
+			require.NoError(t, err)
+		}
+		entries, bytes := s.Stats()
+		assert.LessOrEqual(t, bytes, 5000)
+		assert.Less(t, entries, 6)
+
+		// Последний пост остался в кеше, первый вытеснен.
+		_, err := s.GetPostByID(ctx, "p6")
+		require.NoError(t, err)
+		_, err = s.GetPostByID(ctx, "p1")
+		require.NoError(t, err)
+		assert.EqualValues(t, 7, mockStorage.GetPostByIDAfterCounter())
+	})
+}
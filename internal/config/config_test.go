@@ -0,0 +1,173 @@
+package config_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"hivemind/internal/config"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeFile(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	return path
+}
+
+func TestLoad(t *testing.T) {
+	t.Run("defaults", func(t *testing.T) {
+		cfg, err := config.Load(nil)
+		require.NoError(t, err)
+		assert.Equal(t, config.Default(), cfg)
+	})
+
+	t.Run("example file is valid", func(t *testing.T) {
+		cfg, err := config.Load([]string{"-config", "../../configs/hivemind.example.yaml"})
+		require.NoError(t, err)
+		assert.Equal(t, "postgres", cfg.StorageType)
+		assert.Equal(t, []string{"alice", "bob"}, cfg.Moderators)
+		assert.False(t, cfg.FeaturePlayground)
+	})
+
+	t.Run("flags override env which overrides file", func(t *testing.T) {
+		path := writeFile(t, "server:\n  listen_addr: \":9000\"\n  shutdown_timeout: 1m\nsubscriptions:\n  queue_size: 8\n")
+		t.Setenv("LISTEN_ADDR", ":9001")
+		t.Setenv("SUBSCRIPTION_QUEUE_SIZE", "16")
+
+		cfg, err := config.Load([]string{"-config", path, "-server.listen_addr=:9002"})
+		require.NoError(t, err)
+		assert.Equal(t, ":9002", cfg.ListenAddr)
+		assert.Equal(t, 16, cfg.SubscriptionQueueSize)
+		assert.Equal(t, time.Minute, cfg.ShutdownTimeout)
+	})
+
+	t.Run("config file from env", func(t *testing.T) {
+		t.Setenv("CONFIG_FILE", writeFile(t, "comments:\n  max_length: 500\n"))
+
+		cfg, err := config.Load(nil)
+		require.NoError(t, err)
+		assert.Equal(t, 500, cfg.MaxCommentLength)
+	})
+
+	t.Run("lists and booleans", func(t *testing.T) {
+		path := writeFile(t, "moderation:\n  admins: [root, ops]\n")
+		t.Setenv("MODERATORS", "alice, bob")
+
+		cfg, err := config.Load([]string{"-config", path, "-tracing.fields", "-features.sse=false"})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"root", "ops"}, cfg.Admins)
+		assert.Equal(t, []string{"alice", "bob"}, cfg.Moderators)
+		assert.True(t, cfg.TracingFields)
+		assert.False(t, cfg.FeatureSSE)
+	})
+
+	t.Run("production turns debug pages off by default", func(t *testing.T) {
+		t.Setenv("SERVER_MODE", "production")
+
+		cfg, err := config.Load(nil)
+		require.NoError(t, err)
+		assert.False(t, cfg.FeaturePlayground)
+		assert.False(t, cfg.FeatureDebugVars)
+
+		cfg, err = config.Load([]string{"-features.debug_vars"})
+		require.NoError(t, err)
+		assert.False(t, cfg.FeaturePlayground)
+		assert.True(t, cfg.FeatureDebugVars)
+	})
+
+	t.Run("unknown key", func(t *testing.T) {
+		path := writeFile(t, "server:\n  listen_addr: \":9000\"\n  listen_port: 9000\n")
+
+		_, err := config.Load([]string{"-config", path})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), path+`:3: unknown key "server.listen_port"`)
+	})
+
+	t.Run("unknown section", func(t *testing.T) {
+		_, err := config.Load([]string{"-config", writeFile(t, "queue:\n  size: 1\n")})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `unknown key "queue"`)
+	})
+
+	t.Run("invalid values", func(t *testing.T) {
+		_, err := config.Load([]string{"-config", writeFile(t, "server:\n  shutdown_timeout: soon\n")})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `:2: server.shutdown_timeout: invalid duration "soon"`)
+
+		t.Setenv("MAX_COMMENT_LENGTH", "long")
+		_, err = config.Load(nil)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `MAX_COMMENT_LENGTH: invalid integer "long"`)
+	})
+
+	t.Run("unknown flag", func(t *testing.T) {
+		_, err := config.Load([]string{"-listen"})
+		assert.Error(t, err)
+	})
+}
+
+func TestValidate(t *testing.T) {
+	t.Run("reports all errors", func(t *testing.T) {
+		cfg := config.Default()
+		cfg.StorageType = "mongo"
+		cfg.SubscriptionQueueSize = 0
+		cfg.LogLevel = "loud"
+		cfg.CORSAllowedOrigins = []string{"https://ok.example.com", "not an origin"}
+
+		err := cfg.Validate()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `storage.type: unknown value "mongo"`)
+		assert.Contains(t, err.Error(), "subscriptions.queue_size: must be positive")
+		assert.Contains(t, err.Error(), `log.level: unknown value "loud"`)
+		assert.Contains(t, err.Error(), `cors.allowed_origins: invalid origin "not an origin"`)
+		assert.NotContains(t, err.Error(), "ok.example.com")
+	})
+
+	t.Run("production mode disables introspection", func(t *testing.T) {
+		cfg := config.Default()
+		assert.True(t, cfg.IntrospectionEnabled())
+
+		cfg.Mode = "production"
+		require.NoError(t, cfg.Validate())
+		assert.False(t, cfg.IntrospectionEnabled())
+
+		cfg.Mode = "staging"
+		assert.ErrorContains(t, cfg.Validate(), `server.mode: unknown value "staging"`)
+	})
+
+	t.Run("comment length fits the postgres schema", func(t *testing.T) {
+		cfg := config.Default()
+		cfg.MaxCommentLength = 5000
+		assert.NoError(t, cfg.Validate())
+
+		cfg.StorageType = "postgres"
+		assert.ErrorContains(t, cfg.Validate(), "comments.max_length: must not exceed 2000 with storage.type postgres")
+	})
+
+	t.Run("admin tokens belong to admins", func(t *testing.T) {
+		cfg := config.Default()
+		cfg.Admins = []string{"root"}
+		cfg.AdminTokens = []string{"root:secret"}
+		assert.NoError(t, cfg.Validate())
+
+		cfg.AdminTokens = []string{"ops:secret"}
+		assert.ErrorContains(t, cfg.Validate(), `moderation.admin_tokens: "ops" is not in moderation.admins`)
+
+		cfg.AdminTokens = []string{"root"}
+		assert.ErrorContains(t, cfg.Validate(), "moderation.admin_tokens: expected name:token")
+	})
+
+	t.Run("postgres rate limit store requires postgres storage", func(t *testing.T) {
+		cfg := config.Default()
+		cfg.RateLimitStore = "postgres"
+		assert.ErrorContains(t, cfg.Validate(), "rate_limit.store")
+
+		cfg.StorageType = "postgres"
+		assert.NoError(t, cfg.Validate())
+	})
+}
@@ -0,0 +1,234 @@
+package config
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// field — одна настройка Config вместе с её ключом и переменной окружения.
+type field struct {
+	key string
+	env string
+	v   reflect.Value
+}
+
+func fieldsOf(cfg *Config) []field {
+	v := reflect.ValueOf(cfg).Elem()
+	t := v.Type()
+	fields := make([]field, 0, t.NumField())
+	for i := range t.NumField() {
+		sf := t.Field(i)
+		fields = append(fields, field{key: sf.Tag.Get("key"), env: sf.Tag.Get("env"), v: v.Field(i)})
+	}
+	return fields
+}
+
+var durationType = reflect.TypeOf(time.Duration(0))
+
+// parse разбирает строковое значение настройки типа t. Списки задаются через
+// запятую.
+func parse(t reflect.Type, s string) (reflect.Value, error) {
+	switch {
+	case t == durationType:
+		d, err := time.ParseDuration(s)
+		if err != nil {
+			return reflect.Value{}, fmt.Errorf("invalid duration %q", s)
+		}
+		return reflect.ValueOf(d), nil
+	case t.Kind() == reflect.String:
+		return reflect.ValueOf(s), nil
+	case t.Kind() == reflect.Int:
+		n, err := strconv.Atoi(s)
+		if err != nil {
+			return reflect.Value{}, fmt.Errorf("invalid integer %q", s)
+		}
+		return reflect.ValueOf(n), nil
+	case t.Kind() == reflect.Bool:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return reflect.Value{}, fmt.Errorf("invalid boolean %q", s)
+		}
+		return reflect.ValueOf(b), nil
+	case t.Kind() == reflect.Slice && t.Elem().Kind() == reflect.String:
+		var list []string
+		for _, item := range strings.Split(s, ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				list = append(list, item)
+			}
+		}
+		return reflect.ValueOf(list), nil
+	}
+	panic("config: unsupported field type " + t.String())
+}
+
+// flagValue откладывает применение флага до чтения файла и окружения, чтобы
+// флаг имел наивысший приоритет независимо от порядка загрузки.
+type flagValue struct {
+	field   field
+	pending *[]func()
+}
+
+func (f flagValue) String() string {
+	return ""
+}
+
+func (f flagValue) Set(s string) error {
+	v, err := parse(f.field.v.Type(), s)
+	if err != nil {
+		return err
+	}
+	*f.pending = append(*f.pending, func() { f.field.v.Set(v) })
+	return nil
+}
+
+func (f flagValue) IsBoolFlag() bool {
+	return f.field.v.Kind() == reflect.Bool
+}
+
+// Load собирает настройки из значений по умолчанию, YAML-файла, окружения и
+// флагов args (без имени программы) и проверяет их. Путь к файлу задаётся
+// флагом -config или переменной CONFIG_FILE.
+func Load(args []string) (*Config, error) {
+	cfg, err := load(args, Default())
+	if err != nil {
+		return nil, err
+	}
+	// Режим известен только после чтения всех источников, поэтому в
+	// production настройки собираются заново от значений по умолчанию для
+	// него: отладочные страницы выключены, если их не включили явно.
+	if cfg.Mode == "production" {
+		if cfg, err = load(args, ProductionDefault()); err != nil {
+			return nil, err
+		}
+	}
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
+func load(args []string, cfg *Config) (*Config, error) {
+	fields := fieldsOf(cfg)
+
+	fs := flag.NewFlagSet("hivemind", flag.ContinueOnError)
+	path := fs.String("config", os.Getenv("CONFIG_FILE"), "path to YAML config file")
+	var pending []func()
+	for _, f := range fields {
+		fs.Var(flagValue{field: f, pending: &pending}, f.key, "overrides "+f.env)
+	}
+	if err := fs.Parse(args); err != nil {
+		return nil, err
+	}
+	if fs.NArg() > 0 {
+		return nil, fmt.Errorf("unexpected arguments: %s", strings.Join(fs.Args(), " "))
+	}
+
+	if *path != "" {
+		if err := loadFile(*path, fields); err != nil {
+			return nil, err
+		}
+	}
+	for _, f := range fields {
+		s, ok := os.LookupEnv(f.env)
+		if !ok {
+			continue
+		}
+		v, err := parse(f.v.Type(), s)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", f.env, err)
+		}
+		f.v.Set(v)
+	}
+	for _, apply := range pending {
+		apply()
+	}
+	return cfg, nil
+}
+
+func loadFile(path string, fields []field) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	var root yaml.Node
+	if err := yaml.Unmarshal(data, &root); err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+	if len(root.Content) == 0 {
+		return nil
+	}
+	byKey := make(map[string]field, len(fields))
+	for _, f := range fields {
+		byKey[f.key] = f
+	}
+	return loadNode(path, "", root.Content[0], byKey)
+}
+
+func loadNode(path, prefix string, node *yaml.Node, fields map[string]field) error {
+	if node.Kind != yaml.MappingNode {
+		return fmt.Errorf("%s:%d: %s must be a mapping", path, node.Line, prefix)
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		k, v := node.Content[i], node.Content[i+1]
+		key := k.Value
+		if prefix != "" {
+			key = prefix + "." + key
+		}
+		f, ok := fields[key]
+		if !ok {
+			if v.Kind == yaml.MappingNode && isSection(key, fields) {
+				if err := loadNode(path, key, v, fields); err != nil {
+					return err
+				}
+				continue
+			}
+			return fmt.Errorf("%s:%d: unknown key %q", path, k.Line, key)
+		}
+		if err := loadValue(f, v); err != nil {
+			return fmt.Errorf("%s:%d: %s: %w", path, v.Line, key, err)
+		}
+	}
+	return nil
+}
+
+func isSection(key string, fields map[string]field) bool {
+	for k := range fields {
+		if strings.HasPrefix(k, key+".") {
+			return true
+		}
+	}
+	return false
+}
+
+func loadValue(f field, node *yaml.Node) error {
+	switch node.Kind {
+	case yaml.ScalarNode:
+		v, err := parse(f.v.Type(), node.Value)
+		if err != nil {
+			return err
+		}
+		f.v.Set(v)
+		return nil
+	case yaml.SequenceNode:
+		if f.v.Kind() != reflect.Slice {
+			return fmt.Errorf("expected a single value, got a list")
+		}
+		list := make([]string, 0, len(node.Content))
+		for _, item := range node.Content {
+			if item.Kind != yaml.ScalarNode {
+				return fmt.Errorf("list items must be strings")
+			}
+			list = append(list, item.Value)
+		}
+		f.v.Set(reflect.ValueOf(list))
+		return nil
+	}
+	return fmt.Errorf("expected a value")
+}
@@ -0,0 +1,109 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"slices"
+	"strings"
+
+	"hivemind/internal/admin"
+	"hivemind/internal/hub"
+)
+
+// maxPostgresCommentLength повторяет ограничение длины комментария в
+// db/migrations/schema.sql.
+const maxPostgresCommentLength = 2000
+
+// Validate проверяет согласованность настроек и возвращает все найденные
+// ошибки сразу.
+func (c *Config) Validate() error {
+	var errs []error
+	check := func(ok bool, key, format string, args ...any) {
+		if !ok {
+			errs = append(errs, fmt.Errorf("%s: %s", key, fmt.Sprintf(format, args...)))
+		}
+	}
+	oneOf := func(key, value string, allowed ...string) {
+		check(slices.Contains(allowed, value), key, "unknown value %q, expected one of %s", value, strings.Join(allowed, ", "))
+	}
+
+	check(c.ListenAddr != "", "server.listen_addr", "must not be empty")
+	check(c.ReadHeaderTimeout >= 0, "server.read_header_timeout", "must not be negative")
+	check(c.IdleTimeout >= 0, "server.idle_timeout", "must not be negative")
+	check(c.ShutdownTimeout > 0, "server.shutdown_timeout", "must be positive")
+	check(c.HealthCheckTimeout > 0, "server.health_check_timeout", "must be positive")
+	oneOf("server.mode", c.Mode, "development", "production")
+
+	oneOf("storage.type", c.StorageType, "memory", "postgres")
+	oneOf("pubsub.type", c.PubSubType, "memory", "postgres")
+	oneOf("rate_limit.store", c.RateLimitStore, "memory", "postgres")
+	if c.StorageType == "postgres" || c.PubSubType == "postgres" {
+		check(c.DatabaseURL != "", "database.url", "required for postgres")
+	}
+	check(c.RateLimitStore != "postgres" || c.StorageType == "postgres", "rate_limit.store", "postgres requires storage.type postgres")
+	check(c.DBMaxOpenConns >= 0, "database.max_open_conns", "must not be negative")
+	check(c.DBMaxIdleConns >= 0, "database.max_idle_conns", "must not be negative")
+	check(c.DBMaxOpenConns == 0 || c.DBMaxIdleConns <= c.DBMaxOpenConns, "database.max_idle_conns", "must not exceed database.max_open_conns")
+	check(c.DBConnMaxLifetime >= 0, "database.conn_max_lifetime", "must not be negative")
+	check(c.DBConnMaxIdleTime >= 0, "database.conn_max_idle_time", "must not be negative")
+	check(c.DBQueryTimeout >= 0, "database.query_timeout", "must not be negative")
+	check(c.DBConnectTimeout >= 0, "database.connect_timeout", "must not be negative")
+	check(c.DBReadRetries >= 0, "database.read_retries", "must not be negative")
+	for _, u := range c.DBReplicaURLs {
+		check(u != "", "database.replica_urls", "must not contain empty values")
+	}
+	check(len(c.DBReplicaURLs) == 0 || c.StorageType == "postgres", "database.replica_urls", "require storage.type postgres")
+	check(c.DBReplicaCheckInterval > 0, "database.replica_check_interval", "must be positive")
+	check(c.DBStickyPrimaryWindow >= 0, "database.sticky_primary_window", "must not be negative")
+	check(c.RenderCacheSize > 0, "render.cache_size", "must be positive")
+	check(c.CacheMaxBytes > 0, "cache.max_bytes", "must be positive")
+	check(c.CacheTTL > 0, "cache.ttl", "must be positive")
+	check(c.PersistedQueryCacheSize > 0, "persisted_queries.cache_size", "must be positive")
+	check(c.GraphQLMaxDepth >= 0, "graphql.max_depth", "must not be negative")
+	check(c.GraphQLMaxComplexity >= 0, "graphql.max_complexity", "must not be negative")
+	check(c.MaxCommentLength > 0, "comments.max_length", "must be positive")
+	// Столбец comments.content в схеме ограничен CHECK на 2000 символов.
+	check(c.StorageType != "postgres" || c.MaxCommentLength <= maxPostgresCommentLength, "comments.max_length", "must not exceed %d with storage.type postgres", maxPostgresCommentLength)
+	if tokens, err := admin.ParseTokens(c.AdminTokens); err != nil {
+		check(false, "moderation.admin_tokens", "%v", err)
+	} else {
+		for _, name := range tokens {
+			check(slices.Contains(c.Admins, name), "moderation.admin_tokens", "%q is not in moderation.admins", name)
+		}
+	}
+	check(c.IdempotencyRetention > 0, "idempotency.retention", "must be positive")
+
+	check(c.SubscriptionQueueSize > 0, "subscriptions.queue_size", "must be positive")
+	if _, err := hub.ParsePolicy(c.SubscriptionOverflow); err != nil {
+		check(false, "subscriptions.overflow", "%v", err)
+	}
+	check(c.MaxSubscriptionsPerConnection >= 0, "subscriptions.max_per_connection", "must not be negative")
+	check(c.MaxSubscriptionsPerUser >= 0, "subscriptions.max_per_user", "must not be negative")
+	check(c.SSEHeartbeat > 0, "subscriptions.sse_heartbeat", "must be positive")
+	check(c.TypingTTL > 0, "subscriptions.typing_ttl", "must be positive")
+
+	check(c.WebsocketKeepAlive >= 0, "websocket.keepalive", "must not be negative")
+	check(c.WebsocketInitTimeout >= 0, "websocket.init_timeout", "must not be negative")
+	check(c.WebsocketPingPong >= 0, "websocket.ping_pong", "must not be negative")
+	check(c.WebsocketIdleTimeout >= 0, "websocket.idle_timeout", "must not be negative")
+
+	for _, origin := range c.CORSAllowedOrigins {
+		check(validOrigin(origin), "cors.allowed_origins", "invalid origin %q, expected scheme://host[:port] or *", origin)
+	}
+
+	oneOf("tracing.exporter", c.TracingExporter, "none", "stdout", "otlp")
+	var level slog.Level
+	check(level.UnmarshalText([]byte(c.LogLevel)) == nil, "log.level", "unknown value %q, expected one of debug, info, warn, error", c.LogLevel)
+
+	return errors.Join(errs...)
+}
+
+func validOrigin(origin string) bool {
+	if origin == "*" {
+		return true
+	}
+	u, err := url.Parse(origin)
+	return err == nil && u.Scheme != "" && u.Host != "" && u.Path == "" && u.RawQuery == ""
+}
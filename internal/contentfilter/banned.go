@@ -0,0 +1,39 @@
+package contentfilter
+
+import (
+	"context"
+	"strings"
+	"unicode"
+)
+
+// BannedWords срабатывает, если текст содержит слово из списка.
+// Сравнение регистронезависимое и по целым словам.
+type BannedWords struct {
+	words  map[string]struct{}
+	action Action
+}
+
+func NewBannedWords(words []string, action Action) *BannedWords {
+	f := &BannedWords{words: make(map[string]struct{}, len(words)), action: action}
+	for _, w := range words {
+		f.words[strings.ToLower(w)] = struct{}{}
+	}
+	return f
+}
+
+func (f *BannedWords) Name() string { return "banned_words" }
+
+func (f *BannedWords) Check(ctx context.Context, c Content) (Verdict, error) {
+	for _, word := range tokenize(c.Title + " " + c.Text) {
+		if _, ok := f.words[word]; ok {
+			return Verdict{Action: f.action, Reason: "contains banned word"}, nil
+		}
+	}
+	return Verdict{Action: Allow}, nil
+}
+
+func tokenize(text string) []string {
+	return strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r) && r != '\''
+	})
+}
@@ -0,0 +1,103 @@
+package contentfilter
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+)
+
+const (
+	bayesInterestingTokens = 15
+	bayesMinProbability    = 0.01
+	bayesMaxProbability    = 0.99
+)
+
+// Bayes — простой наивный байесовский классификатор спама в духе
+// «A Plan for Spam»: оценка строится по самым показательным словам текста.
+type Bayes struct {
+	spam, ham         map[string]int
+	spamDocs, hamDocs int
+	holdAbove         float64
+	rejectAbove       float64
+}
+
+func NewBayes(holdAbove, rejectAbove float64) *Bayes {
+	return &Bayes{
+		spam:        make(map[string]int),
+		ham:         make(map[string]int),
+		holdAbove:   holdAbove,
+		rejectAbove: rejectAbove,
+	}
+}
+
+// Train добавляет пример в обучающую выборку. Не потокобезопасен:
+// обучение выполняется при загрузке конфигурации.
+func (b *Bayes) Train(spam bool, text string) {
+	counts := b.ham
+	if spam {
+		counts = b.spam
+		b.spamDocs++
+	} else {
+		b.hamDocs++
+	}
+	for token := range uniqueTokens(text) {
+		counts[token]++
+	}
+}
+
+// Score возвращает вероятность того, что текст — спам. Без обучающих
+// примеров обоих классов классификатор ничего не утверждает и возвращает 0.
+func (b *Bayes) Score(text string) float64 {
+	if b.spamDocs == 0 || b.hamDocs == 0 {
+		return 0
+	}
+	var probs []float64
+	for token := range uniqueTokens(text) {
+		s, h := b.spam[token], b.ham[token]
+		if s+h == 0 {
+			continue
+		}
+		spamFreq := float64(s) / float64(b.spamDocs)
+		hamFreq := float64(h) / float64(b.hamDocs)
+		p := spamFreq / (spamFreq + hamFreq)
+		probs = append(probs, math.Min(bayesMaxProbability, math.Max(bayesMinProbability, p)))
+	}
+	if len(probs) == 0 {
+		return 0
+	}
+	sort.Slice(probs, func(i, j int) bool {
+		return math.Abs(probs[i]-0.5) > math.Abs(probs[j]-0.5)
+	})
+	if len(probs) > bayesInterestingTokens {
+		probs = probs[:bayesInterestingTokens]
+	}
+	var logSpam, logHam float64
+	for _, p := range probs {
+		logSpam += math.Log(p)
+		logHam += math.Log(1 - p)
+	}
+	return 1 / (1 + math.Exp(logHam-logSpam))
+}
+
+func (b *Bayes) Name() string { return "bayes" }
+
+func (b *Bayes) Check(ctx context.Context, c Content) (Verdict, error) {
+	score := b.Score(c.Title + " " + c.Text)
+	reason := fmt.Sprintf("spam score %.2f", score)
+	switch {
+	case b.rejectAbove > 0 && score >= b.rejectAbove:
+		return Verdict{Action: Reject, Reason: reason}, nil
+	case b.holdAbove > 0 && score >= b.holdAbove:
+		return Verdict{Action: Hold, Reason: reason}, nil
+	}
+	return Verdict{Action: Allow}, nil
+}
+
+func uniqueTokens(text string) map[string]struct{} {
+	tokens := make(map[string]struct{})
+	for _, t := range tokenize(text) {
+		tokens[t] = struct{}{}
+	}
+	return tokens
+}
@@ -0,0 +1,125 @@
+package contentfilter
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Config описывает файл настроек фильтров. Секции, которых нет в файле,
+// не включаются.
+type Config struct {
+	BannedWords *struct {
+		Words  []string `yaml:"words"`
+		Action *Action  `yaml:"action"`
+	} `yaml:"bannedWords"`
+	Links *struct {
+		Max    int     `yaml:"max"`
+		Action *Action `yaml:"action"`
+	} `yaml:"links"`
+	Duplicates *struct {
+		Window time.Duration `yaml:"window"`
+		Action *Action       `yaml:"action"`
+	} `yaml:"duplicates"`
+	Bayes *struct {
+		SpamCorpus  string  `yaml:"spamCorpus"`
+		HamCorpus   string  `yaml:"hamCorpus"`
+		HoldAbove   float64 `yaml:"holdAbove"`
+		RejectAbove float64 `yaml:"rejectAbove"`
+	} `yaml:"bayes"`
+}
+
+// Load читает конфигурацию из YAML-файла и собирает конвейер фильтров.
+func Load(path string) (*Pipeline, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var cfg Config
+	dec := yaml.NewDecoder(f)
+	dec.KnownFields(true)
+	if err := dec.Decode(&cfg); err != nil {
+		return nil, fmt.Errorf("parse %s: %w", path, err)
+	}
+	return cfg.Build()
+}
+
+func (cfg *Config) Build() (*Pipeline, error) {
+	var filters []Filter
+	if c := cfg.BannedWords; c != nil {
+		action, err := requireAction("bannedWords", c.Action)
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, NewBannedWords(c.Words, action))
+	}
+	if c := cfg.Links; c != nil {
+		if c.Max < 0 {
+			return nil, fmt.Errorf("links.max must not be negative")
+		}
+		action, err := requireAction("links", c.Action)
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, NewLinkLimit(c.Max, action))
+	}
+	if c := cfg.Duplicates; c != nil {
+		if c.Window <= 0 {
+			return nil, fmt.Errorf("duplicates.window must be positive")
+		}
+		action, err := requireAction("duplicates", c.Action)
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, NewDuplicate(c.Window, action))
+	}
+	if c := cfg.Bayes; c != nil {
+		bayes := NewBayes(c.HoldAbove, c.RejectAbove)
+		for _, corpus := range []struct {
+			path string
+			spam bool
+		}{{c.SpamCorpus, true}, {c.HamCorpus, false}} {
+			if err := trainFromFile(bayes, corpus.path, corpus.spam); err != nil {
+				return nil, err
+			}
+		}
+		filters = append(filters, bayes)
+	}
+	return NewPipeline(filters...), nil
+}
+
+// requireAction проверяет, что в секции задано действие. Без этой проверки
+// пропущенный ключ молча означал бы allow. Неизвестные значения отклоняет
+// уже разбор YAML.
+func requireAction(section string, a *Action) (Action, error) {
+	if a == nil {
+		return Allow, fmt.Errorf("%s.action is required, expected one of allow, hold, shadow_hide, reject", section)
+	}
+	return *a, nil
+}
+
+// trainFromFile обучает классификатор на файле, где каждая строка — пример.
+func trainFromFile(b *Bayes, path string, spam bool) error {
+	if path == "" {
+		return nil
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		if line := strings.TrimSpace(scanner.Text()); line != "" {
+			b.Train(spam, line)
+		}
+	}
+	return scanner.Err()
+}
@@ -0,0 +1,120 @@
+package contentfilter_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"hivemind/internal/contentfilter"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func check(t *testing.T, f contentfilter.Filter, c contentfilter.Content) contentfilter.Verdict {
+	t.Helper()
+	v, err := f.Check(context.Background(), c)
+	require.NoError(t, err)
+	return v
+}
+
+func TestBannedWords(t *testing.T) {
+	f := contentfilter.NewBannedWords([]string{"Casino"}, contentfilter.Reject)
+	assert.Equal(t, contentfilter.Reject, check(t, f, contentfilter.Content{Text: "best CASINO in town"}).Action)
+	assert.Equal(t, contentfilter.Reject, check(t, f, contentfilter.Content{Title: "casino!", Text: "hi"}).Action)
+	assert.Equal(t, contentfilter.Allow, check(t, f, contentfilter.Content{Text: "casinos are whole words"}).Action)
+}
+
+func TestLinkLimit(t *testing.T) {
+	f := contentfilter.NewLinkLimit(1, contentfilter.Hold)
+	assert.Equal(t, contentfilter.Allow, check(t, f, contentfilter.Content{Text: "see https://example.com"}).Action)
+	assert.Equal(t, contentfilter.Hold, check(t, f, contentfilter.Content{Text: "https://a.io and www.b.io"}).Action)
+}
+
+func TestDuplicate(t *testing.T) {
+	f := contentfilter.NewDuplicate(time.Minute, contentfilter.Reject)
+	c := contentfilter.Content{Author: "alice", Text: "Hello, world"}
+	assert.Equal(t, contentfilter.Allow, check(t, f, c).Action)
+	assert.Equal(t, contentfilter.Allow, check(t, f, c).Action, "unsaved content must not be remembered")
+	f.Record(context.Background(), c)
+	assert.Equal(t, contentfilter.Reject, check(t, f, contentfilter.Content{Author: "alice", Text: "hello   WORLD"}).Action)
+	assert.Equal(t, contentfilter.Allow, check(t, f, contentfilter.Content{Author: "bob", Text: "Hello, world"}).Action)
+}
+
+func TestBayes(t *testing.T) {
+	b := contentfilter.NewBayes(0.8, 0.99)
+	assert.Zero(t, b.Score("anything"), "untrained classifier must not flag content")
+
+	for _, s := range []string{"cheap pills buy now", "buy cheap watches now", "win money now click"} {
+		b.Train(true, s)
+	}
+	for _, s := range []string{"great post thanks", "I disagree with the author", "thanks for the detailed answer"} {
+		b.Train(false, s)
+	}
+	assert.Greater(t, b.Score("buy cheap pills now"), 0.8)
+	assert.Less(t, b.Score("thanks for the answer"), 0.2)
+}
+
+func TestPipeline(t *testing.T) {
+	t.Run("strictest verdict wins", func(t *testing.T) {
+		p := contentfilter.NewPipeline(
+			contentfilter.NewLinkLimit(0, contentfilter.Hold),
+			contentfilter.NewBannedWords([]string{"spam"}, contentfilter.ShadowHide),
+		)
+		v, err := p.Check(context.Background(), contentfilter.Content{Text: "spam https://x.io"})
+		require.NoError(t, err)
+		assert.Equal(t, contentfilter.ShadowHide, v.Action)
+		assert.Equal(t, "banned_words", v.Filter)
+	})
+
+	t.Run("nil pipeline allows everything", func(t *testing.T) {
+		var p *contentfilter.Pipeline
+		v, err := p.Check(context.Background(), contentfilter.Content{Text: "anything"})
+		require.NoError(t, err)
+		assert.Equal(t, contentfilter.Allow, v.Action)
+	})
+}
+
+func TestLoad(t *testing.T) {
+	dir := t.TempDir()
+	write := func(name, content string) string {
+		path := filepath.Join(dir, name)
+		require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+		return path
+	}
+	spam := write("spam.txt", "buy cheap pills\nfree money now\n")
+	ham := write("ham.txt", "nice post\nthanks for sharing\n")
+
+	t.Run("valid config", func(t *testing.T) {
+		path := write("filters.yaml", strings.Join([]string{
+			"bannedWords: {words: [casino], action: reject}",
+			"links: {max: 2, action: hold}",
+			"duplicates: {window: 5m, action: shadow_hide}",
+			"bayes: {spamCorpus: " + spam + ", hamCorpus: " + ham + ", holdAbove: 0.9}",
+		}, "\n"))
+		p, err := contentfilter.Load(path)
+		require.NoError(t, err)
+		v, err := p.Check(context.Background(), contentfilter.Content{Text: "casino"})
+		require.NoError(t, err)
+		assert.Equal(t, contentfilter.Reject, v.Action)
+	})
+
+	t.Run("unknown action", func(t *testing.T) {
+		_, err := contentfilter.Load(write("bad.yaml", "links: {max: 2, action: ban}"))
+		assert.Error(t, err)
+	})
+
+	t.Run("missing action", func(t *testing.T) {
+		_, err := contentfilter.Load(write("noaction.yaml", "bannedWords: {words: [x]}"))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "bannedWords.action is required")
+	})
+
+	t.Run("unknown key", func(t *testing.T) {
+		_, err := contentfilter.Load(write("typo.yaml", "bannedWord: {words: [x], action: reject}"))
+		assert.Error(t, err)
+	})
+}
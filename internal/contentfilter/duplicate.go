@@ -0,0 +1,74 @@
+package contentfilter
+
+import (
+	"context"
+	"crypto/sha256"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Duplicate срабатывает, если автор публикует тот же текст повторно в
+// пределах окна. Текст запоминается только через Record, после того как
+// запись сохранена: отклонённую или несохранённую запись автор может
+// исправить и отправить снова. Состояние хранится в памяти процесса.
+type Duplicate struct {
+	window time.Duration
+	action Action
+	now    func() time.Time
+
+	mu       sync.Mutex
+	seen     map[[sha256.Size]byte]time.Time
+	lastScan time.Time
+}
+
+func NewDuplicate(window time.Duration, action Action) *Duplicate {
+	return &Duplicate{
+		window: window,
+		action: action,
+		now:    time.Now,
+		seen:   make(map[[sha256.Size]byte]time.Time),
+	}
+}
+
+func (f *Duplicate) Name() string { return "duplicate" }
+
+func (f *Duplicate) Check(ctx context.Context, c Content) (Verdict, error) {
+	key := duplicateKey(c)
+	now := f.now()
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.evict(now)
+	if at, ok := f.seen[key]; ok && now.Sub(at) < f.window {
+		return Verdict{Action: f.action, Reason: "duplicate content"}, nil
+	}
+	return Verdict{Action: Allow}, nil
+}
+
+func (f *Duplicate) Record(ctx context.Context, c Content) {
+	key := duplicateKey(c)
+	now := f.now()
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.evict(now)
+	f.seen[key] = now
+}
+
+func duplicateKey(c Content) [sha256.Size]byte {
+	normalized := strings.Join(tokenize(c.Title+" "+c.Text), " ")
+	return sha256.Sum256([]byte(c.Author + "\x00" + normalized))
+}
+
+func (f *Duplicate) evict(now time.Time) {
+	if now.Sub(f.lastScan) < f.window {
+		return
+	}
+	f.lastScan = now
+	for key, at := range f.seen {
+		if now.Sub(at) >= f.window {
+			delete(f.seen, key)
+		}
+	}
+}
@@ -0,0 +1,125 @@
+package contentfilter
+
+import (
+	"context"
+	"fmt"
+)
+
+// Action — решение фильтра. Значения упорядочены по строгости.
+type Action int
+
+const (
+	Allow Action = iota
+	Hold
+	ShadowHide
+	Reject
+)
+
+func (a Action) String() string {
+	switch a {
+	case Allow:
+		return "allow"
+	case Hold:
+		return "hold"
+	case ShadowHide:
+		return "shadow_hide"
+	case Reject:
+		return "reject"
+	}
+	return fmt.Sprintf("Action(%d)", int(a))
+}
+
+// ParseAction разбирает действие из конфигурации.
+func ParseAction(s string) (Action, error) {
+	for _, a := range []Action{Allow, Hold, ShadowHide, Reject} {
+		if a.String() == s {
+			return a, nil
+		}
+	}
+	return Allow, fmt.Errorf("unknown filter action %q", s)
+}
+
+func (a *Action) UnmarshalText(text []byte) error {
+	parsed, err := ParseAction(string(text))
+	if err != nil {
+		return err
+	}
+	*a = parsed
+	return nil
+}
+
+type Kind string
+
+const (
+	KindPost    Kind = "post"
+	KindComment Kind = "comment"
+)
+
+// Content — проверяемый пост или комментарий.
+type Content struct {
+	Kind   Kind
+	Author string
+	PostID string
+	Title  string
+	Text   string
+}
+
+// Verdict — итог проверки: действие и фильтр, который его вынес.
+type Verdict struct {
+	Action Action
+	Filter string
+	Reason string
+}
+
+type Filter interface {
+	Name() string
+	Check(ctx context.Context, c Content) (Verdict, error)
+}
+
+// Recorder — фильтр, которому нужно знать о сохранённых записях.
+type Recorder interface {
+	Record(ctx context.Context, c Content)
+}
+
+// Pipeline последовательно применяет фильтры и возвращает самый строгий
+// вердикт. Reject прерывает цепочку.
+type Pipeline struct {
+	filters []Filter
+}
+
+func NewPipeline(filters ...Filter) *Pipeline {
+	return &Pipeline{filters: filters}
+}
+
+func (p *Pipeline) Check(ctx context.Context, c Content) (Verdict, error) {
+	result := Verdict{Action: Allow}
+	if p == nil {
+		return result, nil
+	}
+	for _, f := range p.filters {
+		v, err := f.Check(ctx, c)
+		if err != nil {
+			return Verdict{}, fmt.Errorf("content filter %s: %w", f.Name(), err)
+		}
+		if v.Action > result.Action {
+			v.Filter = f.Name()
+			result = v
+		}
+		if result.Action == Reject {
+			break
+		}
+	}
+	return result, nil
+}
+
+// Record сообщает фильтрам, что запись прошла проверку и сохранена.
+func (p *Pipeline) Record(ctx context.Context, c Content) {
+	if p == nil {
+		return
+	}
+	for _, f := range p.filters {
+		if r, ok := f.(Recorder); ok {
+			r.Record(ctx, c)
+		}
+	}
+}
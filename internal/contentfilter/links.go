@@ -0,0 +1,28 @@
+package contentfilter
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+)
+
+var linkPattern = regexp.MustCompile(`(?i)\b(?:https?://|www\.)\S+`)
+
+// LinkLimit срабатывает, если ссылок в тексте больше Max.
+type LinkLimit struct {
+	max    int
+	action Action
+}
+
+func NewLinkLimit(max int, action Action) *LinkLimit {
+	return &LinkLimit{max: max, action: action}
+}
+
+func (f *LinkLimit) Name() string { return "link_limit" }
+
+func (f *LinkLimit) Check(ctx context.Context, c Content) (Verdict, error) {
+	if n := len(linkPattern.FindAllString(c.Text, -1)); n > f.max {
+		return Verdict{Action: f.action, Reason: fmt.Sprintf("too many links (%d > %d)", n, f.max)}, nil
+	}
+	return Verdict{Action: Allow}, nil
+}
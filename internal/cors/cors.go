@@ -0,0 +1,68 @@
+// Package cors разрешает браузерным клиентам с других источников обращаться к
+// API: отвечает на preflight-запросы и проверяет Origin при подключении по
+// WebSocket.
+package cors
+
+import (
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const maxAge = 10 * time.Minute
+
+// exposedHeaders — заголовки ответа, которые браузер отдаёт скрипту.
+var exposedHeaders = []string{"X-Request-ID", "X-Session-ID", "Retry-After"}
+
+// Allowed сообщает, входит ли origin в список origins; "*" разрешает любой.
+func Allowed(origins []string, origin string) bool {
+	return slices.Contains(origins, "*") || slices.Contains(origins, origin)
+}
+
+// Middleware добавляет CORS-заголовки к ответам на запросы с разрешённых
+// источников и сам отвечает на preflight. Пустой список отключает CORS.
+func Middleware(origins []string, next http.Handler) http.Handler {
+	if len(origins) == 0 {
+		return next
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		origin := r.Header.Get("Origin")
+		w.Header().Add("Vary", "Origin")
+		if origin == "" || !Allowed(origins, origin) {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		h := w.Header()
+		h.Set("Access-Control-Allow-Origin", origin)
+		h.Set("Access-Control-Expose-Headers", strings.Join(exposedHeaders, ", "))
+		if r.Method != http.MethodOptions || r.Header.Get("Access-Control-Request-Method") == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+		h.Add("Vary", "Access-Control-Request-Method")
+		h.Add("Vary", "Access-Control-Request-Headers")
+		h.Set("Access-Control-Allow-Methods", "GET, POST, OPTIONS")
+		if requested := r.Header.Get("Access-Control-Request-Headers"); requested != "" {
+			h.Set("Access-Control-Allow-Headers", requested)
+		}
+		h.Set("Access-Control-Max-Age", strconv.Itoa(int(maxAge.Seconds())))
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+// CheckOrigin проверяет Origin при подключении по WebSocket: разрешены клиенты
+// без Origin, тот же хост и источники из origins.
+func CheckOrigin(origins []string) func(r *http.Request) bool {
+	return func(r *http.Request) bool {
+		origin := r.Header.Get("Origin")
+		if origin == "" || Allowed(origins, origin) {
+			return true
+		}
+		u, err := url.Parse(origin)
+		return err == nil && strings.EqualFold(u.Host, r.Host)
+	}
+}
@@ -0,0 +1,70 @@
+package cors_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"hivemind/internal/cors"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMiddleware(t *testing.T) {
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	h := cors.Middleware([]string{"https://app.example.com"}, next)
+
+	t.Run("preflight from allowed origin", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodOptions, "/query", nil)
+		req.Header.Set("Origin", "https://app.example.com")
+		req.Header.Set("Access-Control-Request-Method", "POST")
+		req.Header.Set("Access-Control-Request-Headers", "content-type")
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusNoContent, rec.Code)
+		assert.Equal(t, "https://app.example.com", rec.Header().Get("Access-Control-Allow-Origin"))
+		assert.Equal(t, "content-type", rec.Header().Get("Access-Control-Allow-Headers"))
+		assert.Contains(t, rec.Header().Get("Access-Control-Allow-Methods"), "POST")
+	})
+
+	t.Run("request from allowed origin", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/query", nil)
+		req.Header.Set("Origin", "https://app.example.com")
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "https://app.example.com", rec.Header().Get("Access-Control-Allow-Origin"))
+		assert.Contains(t, rec.Header().Get("Access-Control-Expose-Headers"), "X-Request-ID")
+		assert.Contains(t, rec.Header().Get("Access-Control-Expose-Headers"), "X-Session-ID")
+	})
+
+	t.Run("other origin gets no CORS headers", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/query", nil)
+		req.Header.Set("Origin", "https://evil.example.com")
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Empty(t, rec.Header().Get("Access-Control-Allow-Origin"))
+	})
+}
+
+func TestCheckOrigin(t *testing.T) {
+	check := cors.CheckOrigin([]string{"https://app.example.com"})
+	for origin, want := range map[string]bool{
+		"":                         true,
+		"https://app.example.com":  true,
+		"http://hivemind.local":    true,
+		"https://evil.example.com": false,
+	} {
+		req := httptest.NewRequest(http.MethodGet, "http://hivemind.local/query", nil)
+		if origin != "" {
+			req.Header.Set("Origin", origin)
+		}
+		assert.Equal(t, want, check(req), origin)
+	}
+}
@@ -0,0 +1,87 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"hivemind/graph/model"
+)
+
+func (p *PostgresStorage) CreateAuditEntry(ctx context.Context, e *model.AuditEntry) error {
+	ctx, cancel := p.withTimeout(ctx)
+	defer cancel()
+
+	var id int64
+	err := p.db.QueryRowContext(ctx,
+		`INSERT INTO audit_log (actor, action, target_type, target_id, before, after, created_at) VALUES ($1, $2, $3, $4, $5, $6, $7) RETURNING id`,
+		e.Actor, e.Action, e.TargetType, e.TargetID, e.Before, e.After, e.CreatedAt).Scan(&id)
+	if err != nil {
+		return err
+	}
+	e.ID = strconv.FormatInt(id, 10)
+	return nil
+}
+
+func (p *PostgresStorage) GetAuditEntries(ctx context.Context, f model.AuditLogFilter, limit int, afterID string) ([]*model.AuditEntry, error) {
+	return read(ctx, p, func(ctx context.Context) ([]*model.AuditEntry, error) {
+		var (
+			conds []string
+			args  []any
+		)
+		where := func(cond string, arg any) {
+			args = append(args, arg)
+			conds = append(conds, fmt.Sprintf(cond, len(args)))
+		}
+		if afterID != "" {
+			id, err := strconv.ParseInt(afterID, 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid audit entry id: %w", err)
+			}
+			where("id > $%d", id)
+		}
+		if f.Actor != nil {
+			where("actor = $%d", *f.Actor)
+		}
+		if f.Action != nil {
+			where("action = $%d", *f.Action)
+		}
+		if f.TargetID != nil {
+			where("target_id = $%d", *f.TargetID)
+		}
+		if f.Since != nil {
+			where("created_at >= $%d", *f.Since)
+		}
+		if f.Until != nil {
+			where("created_at < $%d", *f.Until)
+		}
+
+		query := `SELECT id, actor, action, target_type, target_id, before, after, created_at FROM audit_log`
+		if len(conds) > 0 {
+			query += ` WHERE ` + strings.Join(conds, ` AND `)
+		}
+		args = append(args, limit)
+		query += fmt.Sprintf(` ORDER BY id ASC LIMIT $%d`, len(args))
+
+		rows, err := p.db.QueryContext(ctx, query, args...)
+		if err != nil {
+			return nil, err
+		}
+		defer rows.Close()
+
+		entries := []*model.AuditEntry{}
+		for rows.Next() {
+			var (
+				id int64
+				e  model.AuditEntry
+			)
+			if err := rows.Scan(&id, &e.Actor, &e.Action, &e.TargetType, &e.TargetID, &e.Before, &e.After, &e.CreatedAt); err != nil {
+				return nil, err
+			}
+			e.ID = strconv.FormatInt(id, 10)
+			entries = append(entries, &e)
+		}
+		return entries, rows.Err()
+	})
+}
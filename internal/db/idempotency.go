@@ -0,0 +1,72 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"hivemind/internal/storage"
+)
+
+// claimAttempts ограничивает повторы, когда ключ освобождают между вставкой
+// и чтением.
+const claimAttempts = 3
+
+func (p *PostgresStorage) ClaimIdempotencyKey(ctx context.Context, key *storage.IdempotencyKey) (*storage.IdempotencyKey, error) {
+	ctx, cancel := p.withTimeout(ctx)
+	defer cancel()
+
+	now := time.Now()
+	// Истёкшие ключи удаляются понемногу при каждой новой мутации, чтобы
+	// таблица не росла без отдельной фоновой задачи.
+	_, err := p.db.ExecContext(ctx,
+		`DELETE FROM idempotency_keys WHERE key IN (SELECT key FROM idempotency_keys WHERE expires_at <= $1 LIMIT 100)`, now)
+	if err != nil {
+		return nil, err
+	}
+
+	for attempt := 0; ; attempt++ {
+		var claimed storage.IdempotencyKey
+		err := p.db.QueryRowContext(ctx,
+			`INSERT INTO idempotency_keys (key, fingerprint, result_id, completed, expires_at) VALUES ($1, $2, $3, $4, $5)
+			ON CONFLICT (key) DO UPDATE SET fingerprint = EXCLUDED.fingerprint, result_id = EXCLUDED.result_id, completed = EXCLUDED.completed, expires_at = EXCLUDED.expires_at
+			WHERE idempotency_keys.expires_at <= $6
+			RETURNING key, fingerprint, result_id, completed, expires_at`,
+			key.Key, key.Fingerprint, key.ResultID, key.Completed, key.ExpiresAt, now).
+			Scan(&claimed.Key, &claimed.Fingerprint, &claimed.ResultID, &claimed.Completed, &claimed.ExpiresAt)
+		if err == nil {
+			return &claimed, nil
+		}
+		if !errors.Is(err, sql.ErrNoRows) {
+			return nil, err
+		}
+
+		// Ключ занят действующей записью — возвращаем её.
+		err = p.db.QueryRowContext(ctx,
+			`SELECT key, fingerprint, result_id, completed, expires_at FROM idempotency_keys WHERE key = $1`, key.Key).
+			Scan(&claimed.Key, &claimed.Fingerprint, &claimed.ResultID, &claimed.Completed, &claimed.ExpiresAt)
+		if err == nil {
+			return &claimed, nil
+		}
+		if !errors.Is(err, sql.ErrNoRows) || attempt+1 >= claimAttempts {
+			return nil, err
+		}
+	}
+}
+
+func (p *PostgresStorage) CompleteIdempotencyKey(ctx context.Context, key, resultID string, expiresAt time.Time) error {
+	ctx, cancel := p.withTimeout(ctx)
+	defer cancel()
+
+	_, err := p.db.ExecContext(ctx, `UPDATE idempotency_keys SET completed = true, expires_at = $3 WHERE key = $1 AND result_id = $2`, key, resultID, expiresAt)
+	return err
+}
+
+func (p *PostgresStorage) ReleaseIdempotencyKey(ctx context.Context, key, resultID string) error {
+	ctx, cancel := p.withTimeout(ctx)
+	defer cancel()
+
+	_, err := p.db.ExecContext(ctx, `DELETE FROM idempotency_keys WHERE key = $1 AND result_id = $2`, key, resultID)
+	return err
+}
@@ -0,0 +1,55 @@
+package db
+
+import (
+	"context"
+)
+
+// requiredColumns перечисляет столбцы, которые добавляли миграции из
+// db/migrations/schema.sql и без которых запросы хранилища не работают.
+// При добавлении миграции сюда добавляется её последний столбец.
+var requiredColumns = []struct{ table, column string }{
+	{"posts", "removed_at"},
+	{"comments", "removed_at"},
+	{"reports", "status"},
+	{"report_status_history", "changed_at"},
+	{"audit_log", "created_at"},
+	{"rate_limit_buckets", "expires_at"},
+	{"posts", "visibility"},
+	{"comments", "visibility"},
+	{"posts", "format"},
+	{"comments", "format"},
+	{"comments", "edited_at"},
+	{"idempotency_keys", "completed"},
+}
+
+// PendingMigrations возвращает столбцы схемы, которых нет в базе, в виде
+// "таблица.столбец". Пустой результат значит, что миграции применены.
+func (p *PostgresStorage) PendingMigrations(ctx context.Context) ([]string, error) {
+	return read(ctx, p, func(ctx context.Context) ([]string, error) {
+		rows, err := p.db.QueryContext(ctx, `SELECT table_name, column_name FROM information_schema.columns WHERE table_schema = current_schema()`)
+		if err != nil {
+			return nil, err
+		}
+		defer rows.Close()
+
+		existing := make(map[string]struct{})
+		for rows.Next() {
+			var table, column string
+			if err := rows.Scan(&table, &column); err != nil {
+				return nil, err
+			}
+			existing[table+"."+column] = struct{}{}
+		}
+		if err := rows.Err(); err != nil {
+			return nil, err
+		}
+
+		pending := []string{}
+		for _, c := range requiredColumns {
+			if _, ok := existing[c.table+"."+c.column]; !ok {
+				pending = append(pending, c.table+"."+c.column)
+			}
+		}
+		return pending, nil
+	})
+}
@@ -4,111 +4,390 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"log/slog"
+	"time"
 
 	"hivemind/graph/model"
+	"hivemind/internal/storage"
 
 	_ "github.com/lib/pq"
 )
 
 type PostgresStorage struct {
-	db *sql.DB
+	db       *tracedDB
+	replicas *replicaSet
+	opts     options
 }
 
-func NewPostgresRepository(url string) (*PostgresStorage, error) {
-	db, err := sql.Open("postgres", url)
+type options struct {
+	maxOpenConns    int
+	maxIdleConns    int
+	connMaxLifetime time.Duration
+	connMaxIdleTime time.Duration
+	queryTimeout    time.Duration
+	connectTimeout  time.Duration
+	readRetries     int
+
+	replicaURLs          []string
+	replicaCheckInterval time.Duration
+	stickyWindow         time.Duration
+	session              func(ctx context.Context) string
+}
+
+type Option func(*options)
+
+// WithPool задаёт размер пула и время жизни соединений; нулевое значение
+// оставляет ограничение database/sql по умолчанию.
+func WithPool(maxOpen, maxIdle int, maxLifetime, maxIdleTime time.Duration) Option {
+	return func(o *options) {
+		o.maxOpenConns = maxOpen
+		o.maxIdleConns = maxIdle
+		o.connMaxLifetime = maxLifetime
+		o.connMaxIdleTime = maxIdleTime
+	}
+}
+
+// WithQueryTimeout ограничивает время каждого обращения к базе.
+func WithQueryTimeout(d time.Duration) Option {
+	return func(o *options) {
+		o.queryTimeout = d
+	}
+}
+
+// WithConnectTimeout задаёт, сколько ждать базу при запуске, если она ещё не
+// принимает соединения.
+func WithConnectTimeout(d time.Duration) Option {
+	return func(o *options) {
+		o.connectTimeout = d
+	}
+}
+
+// WithReadRetries задаёт число повторов чтения при временных ошибках.
+func WithReadRetries(n int) Option {
+	return func(o *options) {
+		o.readRetries = n
+	}
+}
+
+func NewPostgresRepository(url string, opts ...Option) (*PostgresStorage, error) {
+	var o options
+	for _, opt := range opts {
+		opt(&o)
+	}
+	db, err := openPool(url, o)
 	if err != nil {
 		return nil, err
 	}
-	if err := db.Ping(); err != nil {
+	if err := connect(db, o.connectTimeout); err != nil {
+		db.Close()
+		return nil, err
+	}
+	replicas, err := openReplicas(o)
+	if err != nil {
+		db.Close()
 		return nil, err
 	}
-	return &PostgresStorage{db: db}, nil
+	return &PostgresStorage{db: &tracedDB{DB: db}, replicas: replicas, opts: o}, nil
 }
 
-func (p *PostgresStorage) CreatePost(ctx context.Context, post *model.Post) error {
+func openPool(url string, o options) (*sql.DB, error) {
+	db, err := sql.Open("postgres", url)
+	if err != nil {
+		return nil, err
+	}
+	db.SetMaxOpenConns(o.maxOpenConns)
+	if o.maxIdleConns > 0 {
+		db.SetMaxIdleConns(o.maxIdleConns)
+	}
+	db.SetConnMaxLifetime(o.connMaxLifetime)
+	db.SetConnMaxIdleTime(o.connMaxIdleTime)
+	return db, nil
+}
+
+// connect ждёт, пока база начнёт принимать соединения, повторяя попытки с
+// растущей задержкой: под docker-compose сервис часто стартует раньше базы.
+func connect(db *sql.DB, timeout time.Duration) error {
+	deadline := time.Now().Add(timeout)
+	for attempt := 0; ; attempt++ {
+		err := db.Ping()
+		if err == nil {
+			return nil
+		}
+		delay := backoff(attempt, 100*time.Millisecond)
+		if time.Until(deadline) < delay {
+			return err
+		}
+		slog.Warn("database is not available yet, retrying", "attempt", attempt+1, "retry_in", delay, "error", err)
+		time.Sleep(delay)
+	}
+}
+
+func (p *PostgresStorage) Ping(ctx context.Context) error {
+	return p.db.PingContext(ctx)
+}
+
+// DB возвращает пул соединений для сбора его статистики.
+func (p *PostgresStorage) DB() *sql.DB {
+	return p.db.DB
+}
+
+// Close закрывает пулы соединений, дождавшись завершения текущих запросов.
+func (p *PostgresStorage) Close() error {
+	return errors.Join(p.replicas.close(), p.db.Close())
+}
+
+func (p *PostgresStorage) CreatePost(ctx context.Context, post *model.Post, visibility storage.Visibility) error {
+	ctx, cancel := p.withTimeout(ctx)
+	defer cancel()
+	ctx = p.wrote(ctx)
+
 	_, err := p.db.ExecContext(ctx,
-		`INSERT INTO posts (id, title, content, author, comments_enabled, created_at) VALUES ($1, $2, $3, $4, $5, $6)`,
-		post.ID, post.Title, post.Content, post.Author, post.CommentsEnabled, post.CreatedAt)
+		`INSERT INTO posts (id, title, content, format, author, comments_enabled, created_at, visibility) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`,
+		post.ID, post.Title, post.Content, post.Format, post.Author, post.CommentsEnabled, post.CreatedAt, visibility)
 	return err
 }
 
 func (p *PostgresStorage) GetPosts(ctx context.Context) ([]*model.Post, error) {
-	rows, err := p.db.QueryContext(ctx, `SELECT id, title, content, author, comments_enabled, created_at FROM posts ORDER BY created_at DESC`)
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
+	return readReplica(ctx, p, func(ctx context.Context, q *tracedDB) ([]*model.Post, error) {
+		rows, err := q.QueryContext(ctx, `SELECT id, title, content, format, author, comments_enabled, created_at FROM posts WHERE removed_at IS NULL AND visibility = 'public' ORDER BY created_at DESC`)
+		if err != nil {
+			return nil, err
+		}
+		defer rows.Close()
 
-	var posts []*model.Post
-	for rows.Next() {
-		var post model.Post
-		if err := rows.Scan(&post.ID, &post.Title, &post.Content, &post.Author, &post.CommentsEnabled, &post.CreatedAt); err != nil {
+		var posts []*model.Post
+		for rows.Next() {
+			var post model.Post
+			if err := rows.Scan(&post.ID, &post.Title, &post.Content, &post.Format, &post.Author, &post.CommentsEnabled, &post.CreatedAt); err != nil {
+				return nil, err
+			}
+			posts = append(posts, &post)
+		}
+		if err := rows.Err(); err != nil {
 			return nil, err
 		}
-		posts = append(posts, &post)
-	}
-	return posts, nil
+		return posts, nil
+	})
 }
 
 func (p *PostgresStorage) GetPostByID(ctx context.Context, id string) (*model.Post, error) {
-	row := p.db.QueryRowContext(ctx, `SELECT id, title, content, author, comments_enabled, created_at FROM posts WHERE id = $1`, id)
-	var post model.Post
-	if err := row.Scan(&post.ID, &post.Title, &post.Content, &post.Author, &post.CommentsEnabled, &post.CreatedAt); err != nil {
-		if err == sql.ErrNoRows {
-			return nil, errors.New("post not found")
+	return readReplica(ctx, p, func(ctx context.Context, q *tracedDB) (*model.Post, error) {
+		row := q.QueryRowContext(ctx, `SELECT id, title, content, format, author, comments_enabled, created_at FROM posts WHERE id = $1 AND removed_at IS NULL`, id)
+		var post model.Post
+		if err := row.Scan(&post.ID, &post.Title, &post.Content, &post.Format, &post.Author, &post.CommentsEnabled, &post.CreatedAt); err != nil {
+			if err == sql.ErrNoRows {
+				return nil, errors.New("post not found")
+			}
+			return nil, err
 		}
-		return nil, err
+		return &post, nil
+	})
+}
+
+func (p *PostgresStorage) GetPostWithVisibility(ctx context.Context, id string) (*model.Post, storage.Visibility, error) {
+	var visibility storage.Visibility
+	post, err := read(ctx, p, func(ctx context.Context) (*model.Post, error) {
+		row := p.db.QueryRowContext(ctx, `SELECT id, title, content, format, author, comments_enabled, created_at, visibility FROM posts WHERE id = $1 AND removed_at IS NULL`, id)
+		var post model.Post
+		if err := row.Scan(&post.ID, &post.Title, &post.Content, &post.Format, &post.Author, &post.CommentsEnabled, &post.CreatedAt, &visibility); err != nil {
+			if err == sql.ErrNoRows {
+				return nil, errors.New("post not found")
+			}
+			return nil, err
+		}
+		return &post, nil
+	})
+	if err != nil {
+		return nil, "", err
 	}
-	return &post, nil
+	return post, visibility, nil
 }
 
 func (p *PostgresStorage) ToggleComments(ctx context.Context, postID string, enabled bool, author string) (*model.Post, error) {
-	_, err := p.db.ExecContext(ctx, `UPDATE posts SET comments_enabled = $1 WHERE id = $2`, enabled, postID)
+	ctx, cancel := p.withTimeout(ctx)
+	defer cancel()
+	ctx = p.wrote(ctx)
+
+	_, err := p.db.ExecContext(ctx, `UPDATE posts SET comments_enabled = $1 WHERE id = $2 AND removed_at IS NULL`, enabled, postID)
 	if err != nil {
 		return nil, err
 	}
 	return p.GetPostByID(ctx, postID)
 }
 
-func (p *PostgresStorage) CreateComment(ctx context.Context, c *model.Comment) error {
-	_, err := p.db.ExecContext(ctx,
-		`INSERT INTO comments (id, post_id, parent_id, author, content, created_at) VALUES ($1, $2, $3, $4, $5, $6)`,
-		c.ID, c.PostID, c.ParentID, c.Author, c.Content, c.CreatedAt)
-	return err
+func (p *PostgresStorage) RemovePost(ctx context.Context, id string) error {
+	ctx, cancel := p.withTimeout(ctx)
+	defer cancel()
+	ctx = p.wrote(ctx)
+
+	res, err := p.db.ExecContext(ctx, `UPDATE posts SET removed_at = now() WHERE id = $1 AND removed_at IS NULL`, id)
+	if err != nil {
+		return err
+	}
+	return expectAffected(res, "post not found")
 }
 
-func (p *PostgresStorage) GetCommentsByPostID(ctx context.Context, postID string, limit, offset int) ([]*model.Comment, error) {
-	rows, err := p.db.QueryContext(ctx, `SELECT id, post_id, parent_id, author, content, created_at FROM comments WHERE post_id = $1 AND parent_id IS NULL ORDER BY created_at ASC LIMIT $2 OFFSET $3`, postID, limit, offset)
+func (p *PostgresStorage) PublishPost(ctx context.Context, id string) error {
+	ctx, cancel := p.withTimeout(ctx)
+	defer cancel()
+	ctx = p.wrote(ctx)
+
+	res, err := p.db.ExecContext(ctx, `UPDATE posts SET visibility = 'public' WHERE id = $1 AND visibility = 'held' AND removed_at IS NULL`, id)
 	if err != nil {
-		return nil, err
+		return err
 	}
-	defer rows.Close()
+	return expectAffected(res, "held post not found")
+}
+
+func (p *PostgresStorage) CreateComment(ctx context.Context, c *model.Comment, visibility storage.Visibility) error {
+	ctx, cancel := p.withTimeout(ctx)
+	defer cancel()
+	ctx = p.wrote(ctx)
 
-	var comments []*model.Comment
-	for rows.Next() {
+	_, err := p.db.ExecContext(ctx,
+		`INSERT INTO comments (id, post_id, parent_id, author, content, format, created_at, visibility) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`,
+		c.ID, c.PostID, c.ParentID, c.Author, c.Content, c.Format, c.CreatedAt, visibility)
+	return err
+}
+
+func (p *PostgresStorage) GetCommentByID(ctx context.Context, id string) (*model.Comment, error) {
+	return read(ctx, p, func(ctx context.Context) (*model.Comment, error) {
+		row := p.db.QueryRowContext(ctx, `SELECT id, post_id, parent_id, author, content, format, created_at, edited_at FROM comments WHERE id = $1 AND removed_at IS NULL`, id)
 		var c model.Comment
-		if err := rows.Scan(&c.ID, &c.PostID, &c.ParentID, &c.Author, &c.Content, &c.CreatedAt); err != nil {
+		if err := row.Scan(&c.ID, &c.PostID, &c.ParentID, &c.Author, &c.Content, &c.Format, &c.CreatedAt, &c.EditedAt); err != nil {
+			if err == sql.ErrNoRows {
+				return nil, errors.New("comment not found")
+			}
 			return nil, err
 		}
-		comments = append(comments, &c)
-	}
-	return comments, nil
+		return &c, nil
+	})
+}
+
+func (p *PostgresStorage) GetCommentsByPostID(ctx context.Context, postID string, limit, offset int) ([]*model.Comment, error) {
+	return readReplica(ctx, p, func(ctx context.Context, q *tracedDB) ([]*model.Comment, error) {
+		rows, err := q.QueryContext(ctx, `SELECT id, post_id, parent_id, author, content, format, created_at, edited_at FROM comments WHERE post_id = $1 AND parent_id IS NULL AND removed_at IS NULL AND visibility = 'public' ORDER BY created_at ASC LIMIT $2 OFFSET $3`, postID, limit, offset)
+		if err != nil {
+			return nil, err
+		}
+		defer rows.Close()
+
+		var comments []*model.Comment
+		for rows.Next() {
+			var c model.Comment
+			if err := rows.Scan(&c.ID, &c.PostID, &c.ParentID, &c.Author, &c.Content, &c.Format, &c.CreatedAt, &c.EditedAt); err != nil {
+				return nil, err
+			}
+			comments = append(comments, &c)
+		}
+		if err := rows.Err(); err != nil {
+			return nil, err
+		}
+		return comments, nil
+	})
 }
 
 func (p *PostgresStorage) GetReplies(ctx context.Context, parentID string, limit, offset int) ([]*model.Comment, error) {
-	rows, err := p.db.QueryContext(ctx, `SELECT id, post_id, parent_id, author, content, created_at FROM comments WHERE parent_id = $1 ORDER BY created_at ASC LIMIT $2 OFFSET $3`, parentID, limit, offset)
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
+	return readReplica(ctx, p, func(ctx context.Context, q *tracedDB) ([]*model.Comment, error) {
+		rows, err := q.QueryContext(ctx, `SELECT id, post_id, parent_id, author, content, format, created_at, edited_at FROM comments WHERE parent_id = $1 AND removed_at IS NULL AND visibility = 'public' ORDER BY created_at ASC LIMIT $2 OFFSET $3`, parentID, limit, offset)
+		if err != nil {
+			return nil, err
+		}
+		defer rows.Close()
 
-	var replies []*model.Comment
-	for rows.Next() {
-		var c model.Comment
-		if err := rows.Scan(&c.ID, &c.PostID, &c.ParentID, &c.Author, &c.Content, &c.CreatedAt); err != nil {
+		var replies []*model.Comment
+		for rows.Next() {
+			var c model.Comment
+			if err := rows.Scan(&c.ID, &c.PostID, &c.ParentID, &c.Author, &c.Content, &c.Format, &c.CreatedAt, &c.EditedAt); err != nil {
+				return nil, err
+			}
+			replies = append(replies, &c)
+		}
+		if err := rows.Err(); err != nil {
+			return nil, err
+		}
+		return replies, nil
+	})
+}
+
+func (p *PostgresStorage) GetCommentsSince(ctx context.Context, postID string, since time.Time, afterID string, limit int) ([]*model.Comment, error) {
+	// Без afterID сравнивается только время, включая since, как и для любого
+	// id после пустой строки: саму пустую строку нельзя привести к uuid.
+	query := `SELECT id, post_id, parent_id, author, content, format, created_at, edited_at FROM comments WHERE post_id = $1 AND created_at >= $2 AND removed_at IS NULL AND visibility = 'public' ORDER BY created_at, id LIMIT $3`
+	args := []any{postID, since, limit}
+	if afterID != "" {
+		query = `SELECT id, post_id, parent_id, author, content, format, created_at, edited_at FROM comments WHERE post_id = $1 AND (created_at, id) > ($2, $4) AND removed_at IS NULL AND visibility = 'public' ORDER BY created_at, id LIMIT $3`
+		args = append(args, afterID)
+	}
+	return read(ctx, p, func(ctx context.Context) ([]*model.Comment, error) {
+		rows, err := p.db.QueryContext(ctx, query, args...)
+		if err != nil {
 			return nil, err
 		}
-		replies = append(replies, &c)
+		defer rows.Close()
+
+		var comments []*model.Comment
+		for rows.Next() {
+			var c model.Comment
+			if err := rows.Scan(&c.ID, &c.PostID, &c.ParentID, &c.Author, &c.Content, &c.Format, &c.CreatedAt, &c.EditedAt); err != nil {
+				return nil, err
+			}
+			comments = append(comments, &c)
+		}
+		return comments, rows.Err()
+	})
+}
+
+func (p *PostgresStorage) UpdateComment(ctx context.Context, id, content string, editedAt time.Time) (*model.Comment, storage.Visibility, error) {
+	ctx, cancel := p.withTimeout(ctx)
+	defer cancel()
+	ctx = p.wrote(ctx)
+
+	row := p.db.QueryRowContext(ctx, `UPDATE comments SET content = $2, edited_at = $3 WHERE id = $1 AND removed_at IS NULL RETURNING id, post_id, parent_id, author, content, format, created_at, edited_at, visibility`, id, content, editedAt)
+	var (
+		c          model.Comment
+		visibility storage.Visibility
+	)
+	if err := row.Scan(&c.ID, &c.PostID, &c.ParentID, &c.Author, &c.Content, &c.Format, &c.CreatedAt, &c.EditedAt, &visibility); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, "", errors.New("comment not found")
+		}
+		return nil, "", err
+	}
+	return &c, visibility, nil
+}
+
+func (p *PostgresStorage) RemoveComment(ctx context.Context, id string) error {
+	ctx, cancel := p.withTimeout(ctx)
+	defer cancel()
+	ctx = p.wrote(ctx)
+
+	res, err := p.db.ExecContext(ctx, `UPDATE comments SET removed_at = now() WHERE id = $1 AND removed_at IS NULL`, id)
+	if err != nil {
+		return err
+	}
+	return expectAffected(res, "comment not found")
+}
+
+func (p *PostgresStorage) PublishComment(ctx context.Context, id string) error {
+	ctx, cancel := p.withTimeout(ctx)
+	defer cancel()
+	ctx = p.wrote(ctx)
+
+	res, err := p.db.ExecContext(ctx, `UPDATE comments SET visibility = 'public' WHERE id = $1 AND visibility = 'held' AND removed_at IS NULL`, id)
+	if err != nil {
+		return err
+	}
+	return expectAffected(res, "held comment not found")
+}
+
+func expectAffected(res sql.Result, notFound string) error {
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return errors.New(notFound)
 	}
-	return replies, nil
+	return nil
 }
@@ -0,0 +1,127 @@
+package db_test
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"hivemind/graph/model"
+	"hivemind/internal/db"
+	"hivemind/internal/ratelimit"
+	"hivemind/internal/storage"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestStorage подключается к базе из HIVEMIND_TEST_DATABASE_URL и
+// применяет схему. Без переменной тесты пропускаются.
+func newTestStorage(t *testing.T) *db.PostgresStorage {
+	t.Helper()
+	url := os.Getenv("HIVEMIND_TEST_DATABASE_URL")
+	if url == "" {
+		t.Skip("HIVEMIND_TEST_DATABASE_URL is not set")
+	}
+	p, err := db.NewPostgresRepository(url)
+	require.NoError(t, err)
+	t.Cleanup(func() { p.Close() })
+
+	schema, err := os.ReadFile("../../db/migrations/schema.sql")
+	require.NoError(t, err)
+	_, err = p.DB().Exec(string(schema))
+	require.NoError(t, err)
+	return p
+}
+
+func newUUID() string {
+	b := make([]byte, 16)
+	rand.Read(b)
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:])
+}
+
+// createTestPost создаёт пост, который удаляется вместе с комментариями
+// после теста.
+func createTestPost(t *testing.T, p *db.PostgresStorage, visibility storage.Visibility) *model.Post {
+	t.Helper()
+	post := &model.Post{ID: newUUID(), Title: "title", Content: "content", Format: model.ContentFormatPlain, Author: "alice", CommentsEnabled: true, CreatedAt: time.Now()}
+	require.NoError(t, p.CreatePost(context.Background(), post, visibility))
+	t.Cleanup(func() { p.DB().Exec(`DELETE FROM posts WHERE id = $1`, post.ID) })
+	return post
+}
+
+func TestGetCommentsSince(t *testing.T) {
+	ctx := context.Background()
+	p := newTestStorage(t)
+	post := createTestPost(t, p, storage.VisibilityPublic)
+
+	start := time.Now().Truncate(time.Millisecond)
+	var ids []string
+	for i := range 3 {
+		c := &model.Comment{ID: newUUID(), PostID: post.ID, Author: "bob", Content: "hi", Format: model.ContentFormatPlain, CreatedAt: start.Add(time.Duration(i) * time.Second)}
+		require.NoError(t, p.CreateComment(ctx, c, storage.VisibilityPublic))
+		ids = append(ids, c.ID)
+	}
+
+	t.Run("since without cursor", func(t *testing.T) {
+		comments, err := p.GetCommentsSince(ctx, post.ID, start.Add(time.Second), "", 10)
+		require.NoError(t, err)
+		require.Len(t, comments, 2)
+		assert.Equal(t, ids[1], comments[0].ID)
+		assert.Equal(t, ids[2], comments[1].ID)
+	})
+
+	t.Run("after cursor", func(t *testing.T) {
+		comments, err := p.GetCommentsSince(ctx, post.ID, start.Add(time.Second), ids[1], 10)
+		require.NoError(t, err)
+		require.Len(t, comments, 1)
+		assert.Equal(t, ids[2], comments[0].ID)
+	})
+}
+
+func TestPublishOnlyHeld(t *testing.T) {
+	ctx := context.Background()
+	p := newTestStorage(t)
+
+	held := createTestPost(t, p, storage.VisibilityHeld)
+	require.NoError(t, p.PublishPost(ctx, held.ID))
+	_, visibility, err := p.GetPostWithVisibility(ctx, held.ID)
+	require.NoError(t, err)
+	assert.Equal(t, storage.VisibilityPublic, visibility)
+
+	hidden := createTestPost(t, p, storage.VisibilityShadowHidden)
+	require.EqualError(t, p.PublishPost(ctx, hidden.ID), "held post not found")
+	_, visibility, err = p.GetPostWithVisibility(ctx, hidden.ID)
+	require.NoError(t, err)
+	assert.Equal(t, storage.VisibilityShadowHidden, visibility)
+
+	comment := &model.Comment{ID: newUUID(), PostID: held.ID, Author: "bob", Content: "hi", Format: model.ContentFormatPlain, CreatedAt: time.Now()}
+	require.NoError(t, p.CreateComment(ctx, comment, storage.VisibilityShadowHidden))
+	require.EqualError(t, p.PublishComment(ctx, comment.ID), "held comment not found")
+}
+
+func TestRateLimitSweepKeepsLongerWindows(t *testing.T) {
+	ctx := context.Background()
+	p := newTestStorage(t)
+	hourly := ratelimit.Limit{Requests: 1, Per: time.Hour}
+	minutely := ratelimit.Limit{Requests: 1, Per: time.Minute}
+	slow, fast := "test:"+newUUID(), "test:"+newUUID()
+	t.Cleanup(func() { p.DB().Exec(`DELETE FROM rate_limit_buckets WHERE key IN ($1, $2)`, slow, fast) })
+
+	// Реплики видели разные правила; очистка на второй не должна сбросить
+	// bucket с часовым окном.
+	first, second := p.RateLimitStore(), p.RateLimitStore()
+	now := time.Now()
+	allowed, _, err := first.Take(ctx, slow, hourly, now)
+	require.NoError(t, err)
+	require.True(t, allowed)
+
+	_, _, err = second.Take(ctx, fast, minutely, now.Add(2*time.Minute))
+	require.NoError(t, err)
+
+	allowed, _, err = first.Take(ctx, slow, hourly, now.Add(3*time.Minute))
+	require.NoError(t, err)
+	assert.False(t, allowed)
+}
@@ -0,0 +1,85 @@
+package db
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"hivemind/internal/ratelimit"
+)
+
+// rateLimitSweepInterval — как часто удаляются восстановившиеся bucket'ы.
+const rateLimitSweepInterval = time.Minute
+
+// RateLimitStore хранит token bucket'ы в Postgres, чтобы лимиты были общими
+// для всех реплик сервиса.
+type RateLimitStore struct {
+	storage *PostgresStorage
+
+	mu        sync.Mutex
+	lastSweep time.Time
+}
+
+func (p *PostgresStorage) RateLimitStore() *RateLimitStore {
+	return &RateLimitStore{storage: p}
+}
+
+func (s *RateLimitStore) Take(ctx context.Context, key string, limit ratelimit.Limit, now time.Time) (bool, time.Duration, error) {
+	ctx, cancel := s.storage.withTimeout(ctx)
+	defer cancel()
+
+	tx, err := s.storage.db.BeginTx(ctx, nil)
+	if err != nil {
+		return false, 0, err
+	}
+	defer tx.Rollback()
+
+	initial := ratelimit.NewBucket(limit, now)
+	_, err = tx.ExecContext(ctx,
+		`INSERT INTO rate_limit_buckets (key, tokens, updated_at, expires_at) VALUES ($1, $2, $3, $4) ON CONFLICT (key) DO NOTHING`,
+		key, initial.Tokens, initial.UpdatedAt, initial.UpdatedAt.Add(limit.Per))
+	if err != nil {
+		return false, 0, err
+	}
+
+	var bucket ratelimit.Bucket
+	err = tx.QueryRowContext(ctx,
+		`SELECT tokens, updated_at FROM rate_limit_buckets WHERE key = $1 FOR UPDATE`, key).
+		Scan(&bucket.Tokens, &bucket.UpdatedAt)
+	if err != nil {
+		return false, 0, err
+	}
+
+	allowed, retryAfter := bucket.Take(limit, now)
+	// Через limit.Per после изменения bucket снова полон. Срок хранится в
+	// строке, потому что окна у правил разные, а очистку может выполнить
+	// реплика, не встречавшая этого правила.
+	_, err = tx.ExecContext(ctx,
+		`UPDATE rate_limit_buckets SET tokens = $1, updated_at = $2, expires_at = $3 WHERE key = $4`,
+		bucket.Tokens, bucket.UpdatedAt, bucket.UpdatedAt.Add(limit.Per), key)
+	if err != nil {
+		return false, 0, err
+	}
+	if err := tx.Commit(); err != nil {
+		return false, 0, err
+	}
+	s.sweep(ctx, now)
+	return allowed, retryAfter, nil
+}
+
+// sweep удаляет истёкшие bucket'ы: они уже полны и неотличимы от новых.
+// Ошибка удаления не мешает ответу, строки удалятся при следующей попытке.
+func (s *RateLimitStore) sweep(ctx context.Context, now time.Time) {
+	s.mu.Lock()
+	if now.Sub(s.lastSweep) < rateLimitSweepInterval {
+		s.mu.Unlock()
+		return
+	}
+	s.lastSweep = now
+	s.mu.Unlock()
+
+	if _, err := s.storage.db.ExecContext(ctx, `DELETE FROM rate_limit_buckets WHERE expires_at <= $1`, now); err != nil {
+		slog.WarnContext(ctx, "failed to sweep rate limit buckets", "error", err)
+	}
+}
@@ -0,0 +1,253 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"log/slog"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"hivemind/internal/storage"
+)
+
+const defaultReplicaCheckInterval = 5 * time.Second
+
+// WithReplicas подключает реплики только для чтения. Ленты постов и страницы
+// комментариев читаются с них, остальные запросы идут в основную базу.
+func WithReplicas(urls ...string) Option {
+	return func(o *options) {
+		o.replicaURLs = urls
+	}
+}
+
+// WithReplicaCheckInterval задаёт, как часто проверять доступность реплик.
+func WithReplicaCheckInterval(d time.Duration) Option {
+	return func(o *options) {
+		o.replicaCheckInterval = d
+	}
+}
+
+// WithStickyPrimary включает чтение своих записей: после записи клиент,
+// определённый функцией session, в течение window читает из основной базы,
+// пока реплики догоняют её.
+func WithStickyPrimary(window time.Duration, session func(ctx context.Context) string) Option {
+	return func(o *options) {
+		o.stickyWindow = window
+		o.session = session
+	}
+}
+
+type replica struct {
+	db      *tracedDB
+	index   int
+	healthy atomic.Bool
+}
+
+// replicaSet распределяет чтения между исправными репликами по кругу.
+type replicaSet struct {
+	replicas []*replica
+	next     atomic.Uint64
+	interval time.Duration
+	timeout  time.Duration
+
+	window  time.Duration
+	session func(ctx context.Context) string
+	mu      sync.Mutex
+	sticky  map[string]time.Time
+
+	stop chan struct{}
+	done chan struct{}
+}
+
+func openReplicas(o options) (*replicaSet, error) {
+	if len(o.replicaURLs) == 0 {
+		return nil, nil
+	}
+	s := &replicaSet{
+		interval: o.replicaCheckInterval,
+		timeout:  o.queryTimeout,
+		window:   o.stickyWindow,
+		session:  o.session,
+		sticky:   make(map[string]time.Time),
+		stop:     make(chan struct{}),
+		done:     make(chan struct{}),
+	}
+	if s.interval <= 0 {
+		s.interval = defaultReplicaCheckInterval
+	}
+	if s.timeout <= 0 || s.timeout > s.interval {
+		s.timeout = s.interval
+	}
+	for i, url := range o.replicaURLs {
+		db, err := openPool(url, o)
+		if err != nil {
+			s.closeAll()
+			return nil, err
+		}
+		s.replicas = append(s.replicas, &replica{db: &tracedDB{DB: db}, index: i})
+	}
+	// Недоступная при запуске реплика не мешает старту: чтения уйдут в
+	// основную базу, а реплика включится после успешной проверки.
+	s.check()
+	go s.run()
+	return s, nil
+}
+
+func (s *replicaSet) run() {
+	defer close(s.done)
+	t := time.NewTicker(s.interval)
+	defer t.Stop()
+	for {
+		select {
+		case <-t.C:
+			s.check()
+			s.sweep(time.Now())
+		case <-s.stop:
+			return
+		}
+	}
+}
+
+func (s *replicaSet) check() {
+	for _, r := range s.replicas {
+		ctx, cancel := context.WithTimeout(context.Background(), s.timeout)
+		err := r.db.PingContext(ctx)
+		cancel()
+		if err != nil {
+			r.markDown(err)
+			continue
+		}
+		if !r.healthy.Swap(true) {
+			slog.Info("database replica is available", "replica", r.index)
+		}
+	}
+}
+
+func (r *replica) markDown(err error) {
+	if r.healthy.Swap(false) {
+		slog.Warn("database replica is unavailable, reading from primary", "replica", r.index, "error", err)
+	}
+}
+
+// pick возвращает реплику для чтения или nil, если читать нужно из основной
+// базы: внутри записи, по storage.WithPrimary, в окне чтения своих записей
+// или когда исправных реплик нет.
+func (s *replicaSet) pick(ctx context.Context) *replica {
+	if s == nil || storage.PrimaryRequested(ctx) || s.isSticky(ctx) {
+		return nil
+	}
+	n := uint64(len(s.replicas))
+	start := s.next.Add(1)
+	for i := range n {
+		if r := s.replicas[(start+i)%n]; r.healthy.Load() {
+			return r
+		}
+	}
+	return nil
+}
+
+func (s *replicaSet) isSticky(ctx context.Context) bool {
+	if s.window <= 0 || s.session == nil {
+		return false
+	}
+	key := s.session(ctx)
+	if key == "" {
+		return false
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	until, ok := s.sticky[key]
+	return ok && time.Now().Before(until)
+}
+
+func (s *replicaSet) stick(ctx context.Context) {
+	if s == nil || s.window <= 0 || s.session == nil {
+		return
+	}
+	key := s.session(ctx)
+	if key == "" {
+		return
+	}
+	s.mu.Lock()
+	s.sticky[key] = time.Now().Add(s.window)
+	s.mu.Unlock()
+}
+
+func (s *replicaSet) sweep(now time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for key, until := range s.sticky {
+		if !now.Before(until) {
+			delete(s.sticky, key)
+		}
+	}
+}
+
+func (s *replicaSet) close() error {
+	if s == nil {
+		return nil
+	}
+	close(s.stop)
+	<-s.done
+	return s.closeAll()
+}
+
+func (s *replicaSet) closeAll() error {
+	var firstErr error
+	for _, r := range s.replicas {
+		if err := r.db.Close(); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
+
+// wrote отмечает запись клиента: его чтения в течение окна идут в основную
+// базу, а чтения внутри самой записи — всегда в неё.
+func (p *PostgresStorage) wrote(ctx context.Context) context.Context {
+	p.replicas.stick(ctx)
+	return storage.WithPrimary(ctx)
+}
+
+// readReplica выполняет чтение на реплике, а если реплика отвечает временной
+// ошибкой, помечает её неисправной и сразу повторяет чтение в основной базе.
+func readReplica[T any](ctx context.Context, p *PostgresStorage, fn func(ctx context.Context, q *tracedDB) (T, error)) (T, error) {
+	return read(ctx, p, func(ctx context.Context) (T, error) {
+		r := p.replicas.pick(ctx)
+		if r == nil {
+			return fn(ctx, p.db)
+		}
+		v, err := fn(ctx, r.db)
+		if err == nil || ctx.Err() != nil || !transient(err) {
+			return v, err
+		}
+		r.markDown(err)
+		return fn(ctx, p.db)
+	})
+}
+
+// Replicas возвращает число исправных и всех подключённых реплик.
+func (p *PostgresStorage) Replicas() (healthy, total int) {
+	if p.replicas == nil {
+		return 0, 0
+	}
+	for _, r := range p.replicas.replicas {
+		if r.healthy.Load() {
+			healthy++
+		}
+	}
+	return healthy, len(p.replicas.replicas)
+}
+
+// ReplicaDBs возвращает пулы соединений реплик для сбора их статистики.
+func (p *PostgresStorage) ReplicaDBs() []*sql.DB {
+	if p.replicas == nil {
+		return nil
+	}
+	dbs := make([]*sql.DB, len(p.replicas.replicas))
+	for i, r := range p.replicas.replicas {
+		dbs[i] = r.db.DB
+	}
+	return dbs
+}
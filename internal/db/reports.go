@@ -0,0 +1,205 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"hivemind/graph/model"
+	"hivemind/internal/storage"
+
+	"github.com/lib/pq"
+)
+
+const reportColumns = `id, target_id, target_type, target_author, reason, details, reporter, status, created_at`
+
+func (p *PostgresStorage) CreateReport(ctx context.Context, r *model.Report) error {
+	ctx, cancel := p.withTimeout(ctx)
+	defer cancel()
+
+	tx, err := p.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(ctx,
+		`INSERT INTO reports (`+reportColumns+`) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)`,
+		r.ID, r.TargetID, r.TargetType, r.TargetAuthor, r.Reason, r.Details, r.Reporter, r.Status, r.CreatedAt)
+	if err != nil {
+		return err
+	}
+	for _, change := range r.History {
+		if err := insertStatusChange(ctx, tx, r.ID, change); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+func (p *PostgresStorage) GetReportByID(ctx context.Context, id string) (*model.Report, error) {
+	return read(ctx, p, func(ctx context.Context) (*model.Report, error) {
+		row := p.db.QueryRowContext(ctx, `SELECT `+reportColumns+` FROM reports WHERE id = $1`, id)
+		report, err := scanReport(row)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return nil, errors.New("report not found")
+			}
+			return nil, err
+		}
+		if err := p.loadReportHistory(ctx, []*model.Report{report}); err != nil {
+			return nil, err
+		}
+		return report, nil
+	})
+}
+
+func (p *PostgresStorage) GetReports(ctx context.Context, status model.ReportStatus, limit int, afterID string) ([]*model.Report, error) {
+	return read(ctx, p, func(ctx context.Context) ([]*model.Report, error) {
+		var (
+			rows *sql.Rows
+			err  error
+		)
+		if afterID == "" {
+			rows, err = p.db.QueryContext(ctx,
+				`SELECT `+reportColumns+` FROM reports WHERE status = $1 ORDER BY created_at ASC, id ASC LIMIT $2`,
+				status, limit)
+		} else {
+			rows, err = p.db.QueryContext(ctx,
+				`SELECT `+reportColumns+` FROM reports
+				WHERE status = $1 AND (created_at, id) > (SELECT created_at, id FROM reports WHERE id = $3)
+				ORDER BY created_at ASC, id ASC LIMIT $2`,
+				status, limit, afterID)
+		}
+		if err != nil {
+			return nil, err
+		}
+		defer rows.Close()
+
+		reports := []*model.Report{}
+		for rows.Next() {
+			report, err := scanReport(rows)
+			if err != nil {
+				return nil, err
+			}
+			reports = append(reports, report)
+		}
+		if err := rows.Err(); err != nil {
+			return nil, err
+		}
+		if err := p.loadReportHistory(ctx, reports); err != nil {
+			return nil, err
+		}
+		return reports, nil
+	})
+}
+
+func (p *PostgresStorage) UpdateReportStatus(ctx context.Context, id string, change *model.ReportStatusChange) (*model.Report, error) {
+	ctx, cancel := p.withTimeout(ctx)
+	defer cancel()
+
+	tx, err := p.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	res, err := tx.ExecContext(ctx, `UPDATE reports SET status = $1 WHERE id = $2 AND status = $3`, change.Status, id, model.ReportStatusOpen)
+	if err != nil {
+		return nil, err
+	}
+	if err := expectAffected(res, "report not found"); err != nil {
+		var exists bool
+		if err := tx.QueryRowContext(ctx, `SELECT EXISTS (SELECT 1 FROM reports WHERE id = $1)`, id).Scan(&exists); err != nil {
+			return nil, err
+		}
+		if exists {
+			return nil, storage.ErrReportResolved
+		}
+		return nil, err
+	}
+	if err := insertStatusChange(ctx, tx, id, change); err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return p.GetReportByID(ctx, id)
+}
+
+func (p *PostgresStorage) ReopenReport(ctx context.Context, id string, from model.ReportStatus, change *model.ReportStatusChange) error {
+	ctx, cancel := p.withTimeout(ctx)
+	defer cancel()
+
+	tx, err := p.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	res, err := tx.ExecContext(ctx, `UPDATE reports SET status = $1 WHERE id = $2 AND status = $3`, model.ReportStatusOpen, id, from)
+	if err != nil {
+		return err
+	}
+	if err := expectAffected(res, "report not found"); err != nil {
+		return err
+	}
+	if err := insertStatusChange(ctx, tx, id, change); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+func (p *PostgresStorage) loadReportHistory(ctx context.Context, reports []*model.Report) error {
+	if len(reports) == 0 {
+		return nil
+	}
+	byID := make(map[string]*model.Report, len(reports))
+	ids := make([]string, 0, len(reports))
+	for _, r := range reports {
+		r.History = []*model.ReportStatusChange{}
+		byID[r.ID] = r
+		ids = append(ids, r.ID)
+	}
+
+	rows, err := p.db.QueryContext(ctx,
+		`SELECT report_id, status, actor, note, changed_at FROM report_status_history WHERE report_id = ANY($1) ORDER BY changed_at ASC, id ASC`,
+		pq.Array(ids))
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			reportID string
+			change   model.ReportStatusChange
+		)
+		if err := rows.Scan(&reportID, &change.Status, &change.Actor, &change.Note, &change.ChangedAt); err != nil {
+			return err
+		}
+		if r, ok := byID[reportID]; ok {
+			r.History = append(r.History, &change)
+		}
+	}
+	return rows.Err()
+}
+
+func insertStatusChange(ctx context.Context, tx *tracedTx, reportID string, change *model.ReportStatusChange) error {
+	_, err := tx.ExecContext(ctx,
+		`INSERT INTO report_status_history (report_id, status, actor, note, changed_at) VALUES ($1, $2, $3, $4, $5)`,
+		reportID, change.Status, change.Actor, change.Note, change.ChangedAt)
+	return err
+}
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanReport(row rowScanner) (*model.Report, error) {
+	var r model.Report
+	if err := row.Scan(&r.ID, &r.TargetID, &r.TargetType, &r.TargetAuthor, &r.Reason, &r.Details, &r.Reporter, &r.Status, &r.CreatedAt); err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
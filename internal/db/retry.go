@@ -0,0 +1,99 @@
+package db
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"log/slog"
+	"math/rand/v2"
+	"net"
+	"syscall"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+const (
+	retryBaseDelay = 50 * time.Millisecond
+	retryMaxDelay  = 5 * time.Second
+)
+
+// withTimeout ограничивает время одного обращения к базе, чтобы медленный
+// запрос не удерживал соединение из пула бесконечно.
+func (p *PostgresStorage) withTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	if p.opts.queryTimeout <= 0 {
+		return context.WithCancel(ctx)
+	}
+	return context.WithTimeout(ctx, p.opts.queryTimeout)
+}
+
+// read выполняет идемпотентное чтение fn с ограничением по времени на каждую
+// попытку и повторяет его при временных ошибках с экспоненциальной задержкой.
+func read[T any](ctx context.Context, p *PostgresStorage, fn func(ctx context.Context) (T, error)) (T, error) {
+	for attempt := 0; ; attempt++ {
+		v, err := attemptRead(ctx, p, fn)
+		if err == nil || attempt >= p.opts.readRetries || !transient(err) {
+			return v, err
+		}
+		slog.WarnContext(ctx, "retrying read after transient error", "attempt", attempt+1, "error", err)
+		if err := sleep(ctx, backoff(attempt, retryBaseDelay)); err != nil {
+			return v, err
+		}
+	}
+}
+
+func attemptRead[T any](ctx context.Context, p *PostgresStorage, fn func(ctx context.Context) (T, error)) (T, error) {
+	ctx, cancel := p.withTimeout(ctx)
+	defer cancel()
+	return fn(ctx)
+}
+
+// transient сообщает, что ошибка вызвана потерей соединения или конфликтом и
+// повтор запроса может пройти успешно.
+func transient(err error) bool {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) {
+		switch pqErr.Code.Class() {
+		case "08": // connection_exception
+			return true
+		}
+		switch pqErr.Code.Name() {
+		case "serialization_failure", "deadlock_detected", "admin_shutdown", "crash_shutdown",
+			"cannot_connect_now", "too_many_connections":
+			return true
+		}
+		return false
+	}
+	var netErr net.Error
+	return errors.Is(err, driver.ErrBadConn) ||
+		errors.Is(err, io.EOF) ||
+		errors.Is(err, io.ErrUnexpectedEOF) ||
+		errors.Is(err, syscall.ECONNRESET) ||
+		errors.Is(err, syscall.ECONNREFUSED) ||
+		errors.As(err, &netErr)
+}
+
+// backoff возвращает задержку перед попыткой attempt+1: base·2^attempt со
+// случайным разбросом, но не больше retryMaxDelay.
+func backoff(attempt int, base time.Duration) time.Duration {
+	d := base << min(attempt, 16)
+	if d <= 0 || d > retryMaxDelay {
+		d = retryMaxDelay
+	}
+	return d/2 + rand.N(d/2+1)
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-t.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
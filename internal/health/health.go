@@ -0,0 +1,128 @@
+// Package health отдаёт состояние сервиса для оркестратора: liveness —
+// процесс жив и обслуживает HTTP, readiness — зависимости доступны и реплика
+// готова принимать трафик.
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	StatusOK   = "ok"
+	StatusFail = "fail"
+)
+
+// Check проверяет одну зависимость и может вернуть подробности для ответа.
+type Check func(ctx context.Context) (details any, err error)
+
+type CheckResult struct {
+	Status  string `json:"status"`
+	Error   string `json:"error,omitempty"`
+	Details any    `json:"details,omitempty"`
+}
+
+type Report struct {
+	Status string                 `json:"status"`
+	Checks map[string]CheckResult `json:"checks,omitempty"`
+}
+
+type Checker struct {
+	timeout  time.Duration
+	draining atomic.Bool
+
+	mu     sync.Mutex
+	checks map[string]Check
+}
+
+// New создаёт проверку готовности; каждая проверка ограничена timeout.
+func New(timeout time.Duration) *Checker {
+	return &Checker{timeout: timeout, checks: make(map[string]Check)}
+}
+
+func (c *Checker) Add(name string, check Check) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.checks[name] = check
+}
+
+// Drain переводит реплику в неготовое состояние на время остановки, чтобы
+// балансировщик перестал направлять на неё трафик.
+func (c *Checker) Drain() {
+	c.draining.Store(true)
+}
+
+// Run выполняет все проверки параллельно.
+func (c *Checker) Run(ctx context.Context) Report {
+	c.mu.Lock()
+	checks := make(map[string]Check, len(c.checks))
+	for name, check := range c.checks {
+		checks[name] = check
+	}
+	c.mu.Unlock()
+
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	report := Report{Status: StatusOK, Checks: make(map[string]CheckResult, len(checks)+1)}
+	var (
+		mu sync.Mutex
+		wg sync.WaitGroup
+	)
+	for name, check := range checks {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			details, err := check(ctx)
+			result := CheckResult{Status: StatusOK, Details: details}
+			if err != nil {
+				result.Status = StatusFail
+				result.Error = err.Error()
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			report.Checks[name] = result
+			if err != nil {
+				report.Status = StatusFail
+			}
+		}()
+	}
+	wg.Wait()
+
+	if c.draining.Load() {
+		report.Status = StatusFail
+		report.Checks["shutdown"] = CheckResult{Status: StatusFail, Error: "server is shutting down"}
+	}
+	return report
+}
+
+// ReadyHandler отвечает 200, если все проверки прошли, и 503 иначе.
+func (c *Checker) ReadyHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		report := c.Run(r.Context())
+		code := http.StatusOK
+		if report.Status != StatusOK {
+			code = http.StatusServiceUnavailable
+		}
+		write(w, code, report)
+	})
+}
+
+// LiveHandler отвечает 200, пока процесс обслуживает HTTP; зависимости не
+// проверяются, чтобы их сбой не приводил к перезапуску.
+func LiveHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		write(w, http.StatusOK, Report{Status: StatusOK})
+	})
+}
+
+func write(w http.ResponseWriter, code int, report Report) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(code)
+	json.NewEncoder(w).Encode(report)
+}
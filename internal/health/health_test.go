@@ -0,0 +1,74 @@
+package health_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"hivemind/internal/health"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func get(t *testing.T, h http.Handler) (int, health.Report) {
+	t.Helper()
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+	assert.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+	var report health.Report
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&report))
+	return rec.Code, report
+}
+
+func TestReadiness(t *testing.T) {
+	t.Run("all checks pass", func(t *testing.T) {
+		c := health.New(time.Second)
+		c.Add("storage", func(ctx context.Context) (any, error) { return nil, nil })
+		c.Add("migrations", func(ctx context.Context) (any, error) { return map[string]any{"pending": []string{}}, nil })
+
+		code, report := get(t, c.ReadyHandler())
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, health.StatusOK, report.Status)
+		assert.Equal(t, map[string]any{"pending": []any{}}, report.Checks["migrations"].Details)
+	})
+
+	t.Run("failed check", func(t *testing.T) {
+		c := health.New(time.Second)
+		c.Add("storage", func(ctx context.Context) (any, error) { return nil, errors.New("connection refused") })
+		c.Add("subscriptions", func(ctx context.Context) (any, error) { return nil, nil })
+
+		code, report := get(t, c.ReadyHandler())
+		assert.Equal(t, http.StatusServiceUnavailable, code)
+		assert.Equal(t, health.StatusFail, report.Status)
+		assert.Equal(t, health.CheckResult{Status: health.StatusFail, Error: "connection refused"}, report.Checks["storage"])
+		assert.Equal(t, health.StatusOK, report.Checks["subscriptions"].Status)
+	})
+
+	t.Run("slow check times out", func(t *testing.T) {
+		c := health.New(20 * time.Millisecond)
+		c.Add("storage", func(ctx context.Context) (any, error) {
+			<-ctx.Done()
+			return nil, ctx.Err()
+		})
+
+		code, report := get(t, c.ReadyHandler())
+		assert.Equal(t, http.StatusServiceUnavailable, code)
+		assert.Equal(t, context.DeadlineExceeded.Error(), report.Checks["storage"].Error)
+	})
+
+	t.Run("draining", func(t *testing.T) {
+		c := health.New(time.Second)
+		c.Drain()
+
+		code, report := get(t, c.ReadyHandler())
+		assert.Equal(t, http.StatusServiceUnavailable, code)
+		assert.Equal(t, health.StatusFail, report.Checks["shutdown"].Status)
+		code, _ = get(t, health.LiveHandler())
+		assert.Equal(t, http.StatusOK, code, "liveness does not depend on draining")
+	})
+}
@@ -0,0 +1,98 @@
+package httpcache
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+const directive = "cacheControl"
+
+// Extension вычисляет время жизни ответа по директивам @cacheControl и
+// передаёт его Middleware. Ответ с ошибками не кешируется.
+type Extension struct{}
+
+var (
+	_ graphql.HandlerExtension    = Extension{}
+	_ graphql.ResponseInterceptor = Extension{}
+)
+
+func (Extension) ExtensionName() string {
+	return "HTTPCache"
+}
+
+func (Extension) Validate(graphql.ExecutableSchema) error {
+	return nil
+}
+
+func (Extension) InterceptResponse(ctx context.Context, next graphql.ResponseHandler) *graphql.Response {
+	resp := next(ctx)
+	p, ok := ctx.Value(policyKey{}).(*policy)
+	if !ok || resp == nil || !graphql.HasOperationContext(ctx) {
+		return resp
+	}
+	if len(resp.Errors) > 0 {
+		p.maxAge = 0
+		return resp
+	}
+	p.maxAge = MaxAge(graphql.GetOperationContext(ctx))
+	return resp
+}
+
+// MaxAge возвращает, сколько секунд можно кешировать результат операции:
+// наименьшую подсказку среди полей верхнего уровня, уменьшенную подсказкой
+// самой операции. Поле без подсказки, мутация или подписка дают 0.
+func MaxAge(opCtx *graphql.OperationContext) int {
+	op := opCtx.Operation
+	if op == nil || op.Operation != ast.Query {
+		return 0
+	}
+	maxAge := -1
+	for _, field := range graphql.CollectFields(opCtx, op.SelectionSet, []string{"Query"}) {
+		if field.Name == "__typename" {
+			continue
+		}
+		if field.Definition == nil {
+			return 0
+		}
+		age, ok := hint(field.Definition.Directives.ForName(directive), nil)
+		if !ok {
+			return 0
+		}
+		if maxAge < 0 || age < maxAge {
+			maxAge = age
+		}
+	}
+	if age, ok := hint(op.Directives.ForName(directive), opCtx.Variables); ok && age < maxAge {
+		maxAge = age
+	}
+	return max(maxAge, 0)
+}
+
+func hint(d *ast.Directive, vars map[string]any) (int, bool) {
+	if d == nil {
+		return 0, false
+	}
+	arg := d.Arguments.ForName("maxAge")
+	if arg == nil || arg.Value == nil {
+		return 0, false
+	}
+	value, err := arg.Value.Value(vars)
+	if err != nil {
+		return 0, false
+	}
+	switch v := value.(type) {
+	case int64:
+		return int(v), true
+	case int:
+		return v, true
+	case float64:
+		return int(v), true
+	case json.Number:
+		n, err := v.Int64()
+		return int(n), err == nil
+	}
+	return 0, false
+}
@@ -0,0 +1,92 @@
+// Package httpcache разрешает CDN и обратным прокси кешировать ответы на
+// публичные запросы.
+//
+// Время жизни ответа задаёт директива @cacheControl(maxAge:) на полях схемы и
+// на самой операции. Заголовки Cache-Control и ETag получают только
+// GET-запросы: POST прокси не кеширует, а с persisted queries запрос по хешу
+// укладывается в короткий URL.
+package httpcache
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+type policyKey struct{}
+
+// policy — время жизни ответа, которое Extension вычисляет по операции.
+type policy struct {
+	maxAge int
+}
+
+// Middleware буферизует ответы на GET-запросы, выставляет по политике
+// операции Cache-Control и ETag и отвечает 304, если If-None-Match совпадает.
+// WebSocket и SSE пропускаются без изменений.
+func Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.Header.Get("Upgrade") != "" ||
+			strings.Contains(r.Header.Get("Accept"), "text/event-stream") {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		p := &policy{}
+		buf := &bufferedWriter{ResponseWriter: w, status: http.StatusOK}
+		next.ServeHTTP(buf, r.WithContext(context.WithValue(r.Context(), policyKey{}, p)))
+
+		h := w.Header()
+		if buf.status != http.StatusOK || p.maxAge <= 0 {
+			h.Set("Cache-Control", "no-store")
+			w.WriteHeader(buf.status)
+			w.Write(buf.body.Bytes())
+			return
+		}
+		etag := etagOf(buf.body.Bytes())
+		h.Set("Cache-Control", "public, max-age="+strconv.Itoa(p.maxAge))
+		h.Set("ETag", etag)
+		if matches(r.Header.Get("If-None-Match"), etag) {
+			h.Del("Content-Type")
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		h.Set("Content-Length", strconv.Itoa(buf.body.Len()))
+		w.WriteHeader(http.StatusOK)
+		w.Write(buf.body.Bytes())
+	})
+}
+
+type bufferedWriter struct {
+	http.ResponseWriter
+	status int
+	body   bytes.Buffer
+}
+
+func (b *bufferedWriter) WriteHeader(status int) {
+	b.status = status
+}
+
+func (b *bufferedWriter) Write(p []byte) (int, error) {
+	return b.body.Write(p)
+}
+
+func etagOf(body []byte) string {
+	sum := sha256.Sum256(body)
+	return `"` + hex.EncodeToString(sum[:16]) + `"`
+}
+
+// matches проверяет заголовок If-None-Match, который может перечислять
+// несколько ETag или быть "*". Слабые ETag сравниваются как сильные.
+func matches(header, etag string) bool {
+	for _, candidate := range strings.Split(header, ",") {
+		candidate = strings.TrimPrefix(strings.TrimSpace(candidate), "W/")
+		if candidate == "*" || candidate == etag {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,113 @@
+package httpcache_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"hivemind/graph/generated"
+	"hivemind/graph/model"
+	"hivemind/graph/resolver"
+	"hivemind/internal/httpcache"
+	"hivemind/internal/memory"
+	"hivemind/internal/storage"
+
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+	"github.com/99designs/gqlgen/graphql/handler/lru"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newServer(t *testing.T) http.Handler {
+	store := memory.NewMemoryStorage()
+	post := &model.Post{ID: "1", Title: "Hello", Author: "alice", CreatedAt: time.Now()}
+	require.NoError(t, store.CreatePost(context.Background(), post, storage.VisibilityPublic))
+	res := resolver.NewResolver(store)
+	srv := handler.New(generated.NewExecutableSchema(generated.Config{Resolvers: res}))
+	srv.AddTransport(transport.GET{})
+	srv.AddTransport(transport.POST{})
+	srv.Use(extension.AutomaticPersistedQuery{Cache: lru.New[string](10)})
+	srv.Use(httpcache.Extension{})
+	return httpcache.Middleware(srv)
+}
+
+func get(h http.Handler, params url.Values, header http.Header) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodGet, "/query?"+params.Encode(), nil)
+	for k, v := range header {
+		req.Header[k] = v
+	}
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestMiddleware(t *testing.T) {
+	h := newServer(t)
+
+	t.Run("public query gets cache headers", func(t *testing.T) {
+		rec := get(h, url.Values{"query": {`{ posts { id } post(id: "1") { id } }`}}, nil)
+		require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+		assert.Equal(t, "public, max-age=5", rec.Header().Get("Cache-Control"))
+		etag := rec.Header().Get("ETag")
+		require.NotEmpty(t, etag)
+
+		rec = get(h, url.Values{"query": {`{ posts { id } post(id: "1") { id } }`}}, http.Header{"If-None-Match": {etag}})
+		assert.Equal(t, http.StatusNotModified, rec.Code)
+		assert.Empty(t, rec.Body.String())
+	})
+
+	t.Run("operation directive lowers max age", func(t *testing.T) {
+		rec := get(h, url.Values{"query": {`query @cacheControl(maxAge: 2) { post(id: "1") { id } }`}}, nil)
+		require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+		assert.Equal(t, "public, max-age=2", rec.Header().Get("Cache-Control"))
+
+		rec = get(h, url.Values{"query": {`query @cacheControl(maxAge: 60) { post(id: "1") { id } }`}}, nil)
+		assert.Equal(t, "public, max-age=10", rec.Header().Get("Cache-Control"))
+	})
+
+	t.Run("fields without hint are not cached", func(t *testing.T) {
+		rec := get(h, url.Values{"query": {`{ posts { id } presence(postId: "1") { viewers } }`}}, nil)
+		assert.Equal(t, "no-store", rec.Header().Get("Cache-Control"))
+		assert.Empty(t, rec.Header().Get("ETag"))
+	})
+
+	t.Run("errors are not cached", func(t *testing.T) {
+		rec := get(h, url.Values{"query": {`{ posts { unknown } }`}}, nil)
+		assert.Equal(t, "no-store", rec.Header().Get("Cache-Control"))
+	})
+
+	t.Run("persisted query by hash over GET", func(t *testing.T) {
+		const query = `{ posts { id title } }`
+		sum := sha256.Sum256([]byte(query))
+		extensions := `{"persistedQuery":{"version":1,"sha256Hash":"` + hex.EncodeToString(sum[:]) + `"}}`
+
+		rec := get(h, url.Values{"extensions": {extensions}}, nil)
+		assert.Contains(t, rec.Body.String(), "PersistedQueryNotFound")
+		assert.Equal(t, "no-store", rec.Header().Get("Cache-Control"))
+
+		rec = get(h, url.Values{"query": {query}, "extensions": {extensions}}, nil)
+		require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+		rec = get(h, url.Values{"extensions": {extensions}}, nil)
+		require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+		assert.Equal(t, `{"data":{"posts":[{"id":"1","title":"Hello"}]}}`, strings.TrimSpace(rec.Body.String()))
+		assert.Equal(t, "public, max-age=5", rec.Header().Get("Cache-Control"))
+	})
+
+	t.Run("POST is passed through", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/query", strings.NewReader(`{"query":"{ posts { id } }"}`))
+		req.Header.Set("Content-Type", "application/json")
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, req)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Empty(t, rec.Header().Get("Cache-Control"))
+	})
+}
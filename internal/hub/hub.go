@@ -0,0 +1,282 @@
+// Package hub раздаёт события подписчикам по ключу (например, ID поста).
+//
+// Подписчики разложены по шардам; список подписчиков ключа хранится как
+// неизменяемый срез и заменяется целиком при подписке и отписке, поэтому
+// публикация не берёт блокировок на запись и не мешает публикациям в другие
+// ключи.
+package hub
+
+import (
+	"fmt"
+	"hash/fnv"
+	"sync"
+	"sync/atomic"
+)
+
+const numShards = 64
+
+// Policy определяет, что делать с новым событием, когда очередь подписчика
+// заполнена.
+type Policy string
+
+const (
+	// DropOldest вытесняет самое старое событие из очереди.
+	DropOldest Policy = "drop-oldest"
+	// Disconnect отключает подписчика.
+	Disconnect Policy = "disconnect"
+	// Coalesce отбрасывает очередь и сообщает подписчику, сколько событий он
+	// пропустил.
+	Coalesce Policy = "coalesce"
+)
+
+func ParsePolicy(s string) (Policy, error) {
+	switch p := Policy(s); p {
+	case DropOldest, Disconnect, Coalesce:
+		return p, nil
+	}
+	return "", fmt.Errorf("unknown subscription overflow policy %q", s)
+}
+
+// Notifier узнаёт о потерях конкретного подписчика.
+type Notifier interface {
+	// Missed вызывается, когда политика Coalesce отбросила n событий.
+	Missed(n int)
+	// Disconnected вызывается перед закрытием канала по политике Disconnect.
+	Disconnected()
+}
+
+// Stats — счётчики доставки событий.
+type Stats struct {
+	Subscribers  int64  `json:"subscribers"`
+	Delivered    uint64 `json:"delivered"`
+	Dropped      uint64 `json:"dropped"`
+	Coalesced    uint64 `json:"coalesced"`
+	Disconnected uint64 `json:"disconnected"`
+}
+
+type Hub[T any] struct {
+	shards    [numShards]shard[T]
+	queueSize int
+	policy    Policy
+	closed    atomic.Bool
+
+	subscribers  atomic.Int64
+	delivered    atomic.Uint64
+	dropped      atomic.Uint64
+	coalesced    atomic.Uint64
+	disconnected atomic.Uint64
+}
+
+type shard[T any] struct {
+	mu   sync.RWMutex
+	keys map[string]*atomic.Pointer[[]*Subscription[T]]
+}
+
+type Subscription[T any] struct {
+	key      string
+	notifier Notifier
+
+	mu     sync.Mutex
+	ch     chan T
+	closed bool
+}
+
+// C возвращает канал событий. Канал закрывается при отписке.
+func (s *Subscription[T]) C() <-chan T {
+	return s.ch
+}
+
+func New[T any](queueSize int, policy Policy) *Hub[T] {
+	h := &Hub[T]{queueSize: queueSize, policy: policy}
+	for i := range h.shards {
+		h.shards[i].keys = make(map[string]*atomic.Pointer[[]*Subscription[T]])
+	}
+	return h
+}
+
+func (h *Hub[T]) shard(key string) *shard[T] {
+	f := fnv.New32a()
+	f.Write([]byte(key))
+	return &h.shards[f.Sum32()%numShards]
+}
+
+// Subscribe добавляет подписчика на события ключа. notifier может быть nil.
+// После Close возвращается подписка с уже закрытым каналом.
+func (h *Hub[T]) Subscribe(key string, notifier Notifier) *Subscription[T] {
+	sub := &Subscription[T]{key: key, notifier: notifier, ch: make(chan T, h.queueSize)}
+	sh := h.shard(key)
+	sh.mu.Lock()
+	defer sh.mu.Unlock()
+	if h.closed.Load() {
+		sub.closed = true
+		close(sub.ch)
+		return sub
+	}
+	list, ok := sh.keys[key]
+	if !ok {
+		list = new(atomic.Pointer[[]*Subscription[T]])
+		sh.keys[key] = list
+	}
+	var next []*Subscription[T]
+	if cur := list.Load(); cur != nil {
+		next = make([]*Subscription[T], 0, len(*cur)+1)
+		next = append(next, *cur...)
+	}
+	next = append(next, sub)
+	list.Store(&next)
+	h.subscribers.Add(1)
+	return sub
+}
+
+// Unsubscribe удаляет подписчика и закрывает его канал. Повторный вызов
+// ничего не делает.
+func (h *Hub[T]) Unsubscribe(sub *Subscription[T]) {
+	sh := h.shard(sub.key)
+	sh.mu.Lock()
+	if list, ok := sh.keys[sub.key]; ok {
+		cur := *list.Load()
+		next := make([]*Subscription[T], 0, len(cur))
+		for _, s := range cur {
+			if s != sub {
+				next = append(next, s)
+			}
+		}
+		if len(next) == 0 {
+			delete(sh.keys, sub.key)
+		} else if len(next) != len(cur) {
+			list.Store(&next)
+		}
+	}
+	sh.mu.Unlock()
+
+	sub.mu.Lock()
+	defer sub.mu.Unlock()
+	if !sub.closed {
+		sub.closed = true
+		close(sub.ch)
+		h.subscribers.Add(-1)
+	}
+}
+
+// Close отписывает всех подписчиков, закрывая их каналы, и отклоняет новые
+// подписки.
+func (h *Hub[T]) Close() {
+	h.closed.Store(true)
+	for i := range h.shards {
+		sh := &h.shards[i]
+		var subs []*Subscription[T]
+		sh.mu.RLock()
+		for _, list := range sh.keys {
+			subs = append(subs, *list.Load()...)
+		}
+		sh.mu.RUnlock()
+		for _, sub := range subs {
+			h.Unsubscribe(sub)
+		}
+	}
+}
+
+// Closed сообщает, был ли вызван Close.
+func (h *Hub[T]) Closed() bool {
+	return h.closed.Load()
+}
+
+// Publish кладёт событие в очереди всех подписчиков ключа, не дожидаясь их.
+func (h *Hub[T]) Publish(key string, v T) {
+	sh := h.shard(key)
+	sh.mu.RLock()
+	list, ok := sh.keys[key]
+	sh.mu.RUnlock()
+	if !ok {
+		return
+	}
+	subs := list.Load()
+	if subs == nil {
+		return
+	}
+	for _, sub := range *subs {
+		if !h.push(sub, v) {
+			h.Unsubscribe(sub)
+		}
+	}
+}
+
+// push возвращает false, если подписчика нужно отключить.
+func (h *Hub[T]) push(sub *Subscription[T], v T) bool {
+	sub.mu.Lock()
+	defer sub.mu.Unlock()
+	if sub.closed {
+		return true
+	}
+	select {
+	case sub.ch <- v:
+		h.delivered.Add(1)
+		return true
+	default:
+	}
+
+	var dropped int
+	switch h.policy {
+	case Disconnect:
+		h.dropped.Add(1)
+		h.disconnected.Add(1)
+		if sub.notifier != nil {
+			sub.notifier.Disconnected()
+		}
+		return false
+	case Coalesce:
+		for drained := false; !drained; {
+			select {
+			case <-sub.ch:
+				dropped++
+			default:
+				drained = true
+			}
+		}
+		if sub.notifier != nil && dropped > 0 {
+			sub.notifier.Missed(dropped)
+		}
+		h.coalesced.Add(1)
+	default:
+		select {
+		case <-sub.ch:
+			dropped++
+		default:
+		}
+	}
+	h.dropped.Add(uint64(dropped))
+
+	select {
+	case sub.ch <- v:
+		h.delivered.Add(1)
+	default:
+		h.dropped.Add(1)
+	}
+	return true
+}
+
+// Keys возвращает число подписчиков каждого ключа, у которого они есть.
+func (h *Hub[T]) Keys() map[string]int {
+	counts := make(map[string]int)
+	for i := range h.shards {
+		sh := &h.shards[i]
+		sh.mu.RLock()
+		for key, list := range sh.keys {
+			if subs := list.Load(); subs != nil && len(*subs) > 0 {
+				counts[key] = len(*subs)
+			}
+		}
+		sh.mu.RUnlock()
+	}
+	return counts
+}
+
+func (h *Hub[T]) Stats() Stats {
+	return Stats{
+		Subscribers:  h.subscribers.Load(),
+		Delivered:    h.delivered.Load(),
+		Dropped:      h.dropped.Load(),
+		Coalesced:    h.coalesced.Load(),
+		Disconnected: h.disconnected.Load(),
+	}
+}
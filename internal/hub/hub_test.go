@@ -0,0 +1,209 @@
+package hub_test
+
+import (
+	"fmt"
+	"strconv"
+	"sync"
+	"testing"
+
+	"hivemind/internal/hub"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type notifier struct {
+	missed       int
+	disconnected bool
+}
+
+func (n *notifier) Missed(count int) { n.missed += count }
+func (n *notifier) Disconnected()    { n.disconnected = true }
+
+func drain(ch <-chan int) []int {
+	var got []int
+	for {
+		select {
+		case v, ok := <-ch:
+			if !ok {
+				return got
+			}
+			got = append(got, v)
+		default:
+			return got
+		}
+	}
+}
+
+func TestPublish(t *testing.T) {
+	h := hub.New[int](4, hub.DropOldest)
+	a := h.Subscribe("post1", nil)
+	b := h.Subscribe("post1", nil)
+	other := h.Subscribe("post2", nil)
+
+	h.Publish("post1", 1)
+	h.Publish("missing", 2)
+
+	assert.Equal(t, []int{1}, drain(a.C()))
+	assert.Equal(t, []int{1}, drain(b.C()))
+	assert.Empty(t, drain(other.C()))
+	assert.Equal(t, int64(3), h.Stats().Subscribers)
+	assert.Equal(t, map[string]int{"post1": 2, "post2": 1}, h.Keys())
+}
+
+func TestUnsubscribe(t *testing.T) {
+	h := hub.New[int](4, hub.DropOldest)
+	sub := h.Subscribe("post1", nil)
+	h.Unsubscribe(sub)
+	h.Unsubscribe(sub)
+
+	_, ok := <-sub.C()
+	assert.False(t, ok, "channel must be closed")
+	h.Publish("post1", 1)
+	assert.Equal(t, int64(0), h.Stats().Subscribers)
+}
+
+func TestClose(t *testing.T) {
+	h := hub.New[int](4, hub.DropOldest)
+	a := h.Subscribe("post1", nil)
+	b := h.Subscribe("post2", nil)
+	h.Publish("post1", 1)
+	h.Close()
+
+	assert.Equal(t, []int{1}, drain(a.C()), "queued events are still delivered")
+	_, ok := <-a.C()
+	assert.False(t, ok, "channel must be closed")
+	_, ok = <-b.C()
+	assert.False(t, ok, "channel must be closed")
+
+	late := h.Subscribe("post1", nil)
+	_, ok = <-late.C()
+	assert.False(t, ok, "subscriptions after close must be closed")
+	assert.True(t, h.Closed())
+	assert.Equal(t, int64(0), h.Stats().Subscribers)
+}
+
+func TestOverflow(t *testing.T) {
+	t.Run("drop oldest", func(t *testing.T) {
+		h := hub.New[int](2, hub.DropOldest)
+		sub := h.Subscribe("post1", nil)
+		for i := 1; i <= 3; i++ {
+			h.Publish("post1", i)
+		}
+		assert.Equal(t, []int{2, 3}, drain(sub.C()))
+		assert.Equal(t, uint64(1), h.Stats().Dropped)
+	})
+
+	t.Run("coalesce", func(t *testing.T) {
+		h := hub.New[int](2, hub.Coalesce)
+		n := &notifier{}
+		sub := h.Subscribe("post1", n)
+		for i := 1; i <= 3; i++ {
+			h.Publish("post1", i)
+		}
+		assert.Equal(t, []int{3}, drain(sub.C()))
+		assert.Equal(t, 2, n.missed)
+		assert.Equal(t, uint64(1), h.Stats().Coalesced)
+	})
+
+	t.Run("disconnect", func(t *testing.T) {
+		h := hub.New[int](1, hub.Disconnect)
+		n := &notifier{}
+		sub := h.Subscribe("post1", n)
+		h.Publish("post1", 1)
+		h.Publish("post1", 2)
+
+		assert.True(t, n.disconnected)
+		assert.Equal(t, []int{1}, drain(sub.C()))
+		_, ok := <-sub.C()
+		assert.False(t, ok, "channel must be closed")
+		assert.Equal(t, uint64(1), h.Stats().Disconnected)
+	})
+}
+
+func TestConcurrentSubscribePublish(t *testing.T) {
+	h := hub.New[int](8, hub.DropOldest)
+	var wg sync.WaitGroup
+	for i := 0; i < 16; i++ {
+		wg.Add(2)
+		key := "post" + strconv.Itoa(i%4)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				sub := h.Subscribe(key, nil)
+				h.Unsubscribe(sub)
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				h.Publish(key, j)
+			}
+		}()
+	}
+	wg.Wait()
+	require.Equal(t, int64(0), h.Stats().Subscribers)
+}
+
+func BenchmarkPublish(b *testing.B) {
+	for _, n := range []int{1, 100, 10000} {
+		b.Run(fmt.Sprintf("subscribers=%d", n), func(b *testing.B) {
+			h := hub.New[int](1, hub.DropOldest)
+			for i := 0; i < n; i++ {
+				h.Subscribe("post", nil)
+			}
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				h.Publish("post", i)
+			}
+		})
+	}
+}
+
+// BenchmarkPublishParallel публикует в разные посты одновременно, пока на
+// каждом из них есть зрители.
+func BenchmarkPublishParallel(b *testing.B) {
+	const posts = 256
+	h := hub.New[int](1, hub.DropOldest)
+	for i := 0; i < posts; i++ {
+		for j := 0; j < 50; j++ {
+			h.Subscribe("post"+strconv.Itoa(i), nil)
+		}
+	}
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		i := 0
+		for pb.Next() {
+			h.Publish("post"+strconv.Itoa(i%posts), i)
+			i++
+		}
+	})
+}
+
+// BenchmarkSubscribeWhilePublishing измеряет подписку и отписку на горячем
+// посте, в который параллельно идут публикации.
+func BenchmarkSubscribeWhilePublishing(b *testing.B) {
+	h := hub.New[int](1, hub.DropOldest)
+	for i := 0; i < 1000; i++ {
+		h.Subscribe("hot", nil)
+	}
+	done := make(chan struct{})
+	go func() {
+		for i := 0; ; i++ {
+			select {
+			case <-done:
+				return
+			default:
+				h.Publish("hot", i)
+			}
+		}
+	}()
+	defer close(done)
+
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			h.Unsubscribe(h.Subscribe("hot", nil))
+		}
+	})
+}
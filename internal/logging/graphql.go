@@ -0,0 +1,174 @@
+package logging
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"hivemind/internal/ratelimit"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// Extension — расширение gqlgen, записывающее в лог каждый запрос и мутацию,
+// а также начало и конец каждой подписки. User определяет пользователя, если
+// он не указан в аргументах операции, например по connection_init.
+type Extension struct {
+	Logger *slog.Logger
+	User   func(ctx context.Context) string
+}
+
+var _ interface {
+	graphql.HandlerExtension
+	graphql.OperationInterceptor
+	graphql.ResponseInterceptor
+} = Extension{}
+
+func (Extension) ExtensionName() string {
+	return "Logging"
+}
+
+func (Extension) Validate(schema graphql.ExecutableSchema) error {
+	return nil
+}
+
+func (e Extension) InterceptResponse(ctx context.Context, next graphql.ResponseHandler) *graphql.Response {
+	resp := next(ctx)
+	var (
+		opCtx *graphql.OperationContext
+		start = time.Now()
+	)
+	if graphql.HasOperationContext(ctx) {
+		opCtx = graphql.GetOperationContext(ctx)
+		if opCtx.Operation != nil && opCtx.Operation.Operation == ast.Subscription {
+			return resp
+		}
+		start = opCtx.Stats.OperationStart
+	}
+
+	var errs gqlerror.List
+	if resp != nil {
+		errs = resp.Errors
+	}
+	level := slog.LevelInfo
+	if len(errs) > 0 {
+		level = slog.LevelWarn
+	}
+	attrs := append(e.operationAttrs(ctx, opCtx),
+		slog.Duration("duration", time.Since(start)),
+		slog.Any("error_codes", errorCodes(errs)))
+	e.Logger.LogAttrs(ctx, level, "graphql operation", attrs...)
+	return resp
+}
+
+func (e Extension) InterceptOperation(ctx context.Context, next graphql.OperationHandler) graphql.ResponseHandler {
+	opCtx := graphql.GetOperationContext(ctx)
+	if opCtx.Operation == nil || opCtx.Operation.Operation != ast.Subscription {
+		return next(ctx)
+	}
+	attrs := e.operationAttrs(ctx, opCtx)
+	e.Logger.LogAttrs(ctx, slog.LevelInfo, "subscription started", attrs...)
+
+	start := time.Now()
+	var (
+		once  sync.Once
+		codes []string
+	)
+	responses := next(ctx)
+	return func(ctx context.Context) *graphql.Response {
+		resp := responses(ctx)
+		if resp != nil {
+			codes = append(codes, errorCodes(resp.Errors)...)
+			return resp
+		}
+		once.Do(func() {
+			e.Logger.LogAttrs(ctx, slog.LevelInfo, "subscription ended", append(attrs,
+				slog.Duration("duration", time.Since(start)),
+				slog.Any("error_codes", codes))...)
+		})
+		return nil
+	}
+}
+
+// InitFunc оборачивает InitFunc транспорта WebSocket, записывая подключение
+// клиента; отключение записывает CloseFunc.
+func (e Extension) InitFunc(next transport.WebsocketInitFunc) transport.WebsocketInitFunc {
+	return func(ctx context.Context, payload transport.InitPayload) (context.Context, *transport.InitPayload, error) {
+		ctx, ack, err := next(ctx, payload)
+		if err != nil {
+			e.Logger.WarnContext(ctx, "websocket connection rejected", "error", err)
+			return ctx, ack, err
+		}
+		e.Logger.InfoContext(ctx, "websocket connected", "user", e.user(ctx), "client_ip", ratelimit.ClientIP(ctx))
+		return ctx, ack, nil
+	}
+}
+
+func (e Extension) CloseFunc(ctx context.Context, closeCode int) {
+	e.Logger.InfoContext(ctx, "websocket disconnected", "user", e.user(ctx), "close_code", closeCode)
+}
+
+func (e Extension) operationAttrs(ctx context.Context, opCtx *graphql.OperationContext) []slog.Attr {
+	var (
+		opType, name, user string
+		fields             []string
+	)
+	if opCtx != nil {
+		name = opCtx.OperationName
+		if op := opCtx.Operation; op != nil {
+			opType = string(op.Operation)
+			if op.Name != "" {
+				name = op.Name
+			}
+			for _, sel := range op.SelectionSet {
+				field, ok := sel.(*ast.Field)
+				if !ok {
+					continue
+				}
+				fields = append(fields, field.Name)
+				if user == "" {
+					user = ratelimit.User(field, opCtx.Variables)
+				}
+			}
+		}
+	}
+	if user == "" {
+		user = e.user(ctx)
+	}
+	return []slog.Attr{
+		slog.String("operation", name),
+		slog.String("type", opType),
+		slog.Any("fields", fields),
+		slog.String("user", user),
+		slog.String("client_ip", ratelimit.ClientIP(ctx)),
+	}
+}
+
+func (e Extension) user(ctx context.Context) string {
+	if e.User == nil {
+		return ""
+	}
+	return e.User(ctx)
+}
+
+// errorCodes возвращает коды ошибок из extensions.code без повторов.
+func errorCodes(errs gqlerror.List) []string {
+	var codes []string
+	seen := make(map[string]struct{})
+	for _, err := range errs {
+		code, _ := err.Extensions["code"].(string)
+		if code == "" {
+			continue
+		}
+		if _, ok := seen[code]; ok {
+			continue
+		}
+		seen[code] = struct{}{}
+		codes = append(codes, code)
+	}
+	return codes
+}
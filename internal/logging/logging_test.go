@@ -0,0 +1,179 @@
+package logging_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"hivemind/graph/generated"
+	"hivemind/graph/model"
+	"hivemind/graph/resolver"
+	"hivemind/internal/logging"
+	"hivemind/internal/storage/mocks"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+func records(t *testing.T, buf *bytes.Buffer) []map[string]any {
+	t.Helper()
+	var out []map[string]any
+	for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+		if line == "" {
+			continue
+		}
+		var rec map[string]any
+		require.NoError(t, json.Unmarshal([]byte(line), &rec))
+		out = append(out, rec)
+	}
+	return out
+}
+
+func TestNew(t *testing.T) {
+	t.Run("rejects unknown level", func(t *testing.T) {
+		_, err := logging.New(&bytes.Buffer{}, "loud")
+		assert.Error(t, err)
+	})
+
+	t.Run("filters by level", func(t *testing.T) {
+		var buf bytes.Buffer
+		logger, err := logging.New(&buf, "warn")
+		require.NoError(t, err)
+		logger.Info("hidden")
+		logger.Warn("shown")
+
+		recs := records(t, &buf)
+		require.Len(t, recs, 1)
+		assert.Equal(t, "shown", recs[0]["msg"])
+	})
+}
+
+func TestMiddleware(t *testing.T) {
+	var buf bytes.Buffer
+	logger, err := logging.New(&buf, "info")
+	require.NoError(t, err)
+	h := logging.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		logger.InfoContext(r.Context(), "handled")
+	}))
+
+	t.Run("propagates incoming id", func(t *testing.T) {
+		buf.Reset()
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.Header.Set(logging.RequestIDHeader, "abc-123")
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, req)
+
+		assert.Equal(t, "abc-123", rec.Header().Get(logging.RequestIDHeader))
+		assert.Equal(t, "abc-123", records(t, &buf)[0]["request_id"])
+	})
+
+	t.Run("generates id when missing or invalid", func(t *testing.T) {
+		for _, header := range []string{"", "bad id\n", strings.Repeat("x", 200)} {
+			buf.Reset()
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			req.Header.Set(logging.RequestIDHeader, header)
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, req)
+
+			id := rec.Header().Get(logging.RequestIDHeader)
+			assert.Len(t, id, 32)
+			assert.Equal(t, id, records(t, &buf)[0]["request_id"])
+		}
+	})
+}
+
+func TestExtension(t *testing.T) {
+	var buf bytes.Buffer
+	logger, err := logging.New(&buf, "info")
+	require.NoError(t, err)
+
+	mockStorage := mocks.NewStorageMock(t)
+	mockStorage.GetPostByIDMock.Return(&model.Post{ID: "p1", CommentsEnabled: true}, nil)
+	srv := handler.New(generated.NewExecutableSchema(generated.Config{Resolvers: resolver.NewResolver(mockStorage)}))
+	srv.AddTransport(transport.POST{})
+	srv.Use(logging.Extension{Logger: logger})
+	h := logging.Middleware(srv)
+
+	send := func(query string) {
+		buf.Reset()
+		body, _ := json.Marshal(map[string]string{"query": query})
+		req := httptest.NewRequest(http.MethodPost, "/query", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set(logging.RequestIDHeader, "req-1")
+		h.ServeHTTP(httptest.NewRecorder(), req)
+	}
+
+	t.Run("operation with user from arguments", func(t *testing.T) {
+		send(`mutation Typing { setTyping(postId: "p1", author: "alice") }`)
+
+		recs := records(t, &buf)
+		require.Len(t, recs, 1)
+		rec := recs[0]
+		assert.Equal(t, "graphql operation", rec["msg"])
+		assert.Equal(t, "INFO", rec["level"])
+		assert.Equal(t, "req-1", rec["request_id"])
+		assert.Equal(t, "Typing", rec["operation"])
+		assert.Equal(t, "mutation", rec["type"])
+		assert.Equal(t, []any{"setTyping"}, rec["fields"])
+		assert.Equal(t, "alice", rec["user"])
+		assert.Contains(t, rec, "duration")
+		assert.Nil(t, rec["error_codes"])
+	})
+
+	t.Run("error codes", func(t *testing.T) {
+		send(`{ unknownField }`)
+
+		recs := records(t, &buf)
+		require.Len(t, recs, 1)
+		assert.Equal(t, "WARN", recs[0]["level"])
+		assert.Equal(t, []any{"GRAPHQL_VALIDATION_FAILED"}, recs[0]["error_codes"])
+	})
+}
+
+func TestSubscriptionEvents(t *testing.T) {
+	var buf bytes.Buffer
+	logger, err := logging.New(&buf, "info")
+	require.NoError(t, err)
+	ext := logging.Extension{Logger: logger, User: func(context.Context) string { return "bob" }}
+
+	ctx := graphql.WithOperationContext(context.Background(), &graphql.OperationContext{
+		Operation: &ast.OperationDefinition{
+			Operation:    ast.Subscription,
+			Name:         "Watch",
+			SelectionSet: ast.SelectionSet{&ast.Field{Name: "commentAdded", Definition: &ast.FieldDefinition{Name: "commentAdded"}}},
+		},
+	})
+	sent := false
+	responses := ext.InterceptOperation(ctx, func(ctx context.Context) graphql.ResponseHandler {
+		return func(ctx context.Context) *graphql.Response {
+			if sent {
+				return nil
+			}
+			sent = true
+			return &graphql.Response{}
+		}
+	})
+
+	recs := records(t, &buf)
+	require.Len(t, recs, 1)
+	assert.Equal(t, "subscription started", recs[0]["msg"])
+	assert.Equal(t, "bob", recs[0]["user"])
+	assert.Equal(t, []any{"commentAdded"}, recs[0]["fields"])
+
+	require.NotNil(t, responses(ctx))
+	require.Nil(t, responses(ctx))
+	recs = records(t, &buf)
+	require.Len(t, recs, 2)
+	assert.Equal(t, "subscription ended", recs[1]["msg"])
+	assert.Equal(t, slog.LevelInfo.String(), recs[1]["level"])
+	assert.Contains(t, recs[1], "duration")
+}
@@ -3,37 +3,97 @@ package memory
 import (
 	"context"
 	"errors"
+	"slices"
+	"strconv"
+	"strings"
 	"sync"
+	"time"
 
 	"hivemind/graph/model"
+	"hivemind/internal/storage"
 )
 
 type MemoryStorage struct {
 	mu       sync.RWMutex
 	posts    map[string]*model.Post
 	comments map[string]*model.Comment
+	// postComments и replies хранят опубликованные комментарии верхнего
+	// уровня по посту и ответы по родителю в порядке добавления.
+	postComments map[string][]*model.Comment
+	replies      map[string][]*model.Comment
+	reports      map[string]*model.Report
+	reportOrder  []string
+	auditLog     []*model.AuditEntry
+	// hidden хранит видимость постов и комментариев, не попавших в общие списки.
+	hidden map[string]storage.Visibility
+	// removed хранит удалённые посты и комментарии. Как и в Postgres, они
+	// остаются в хранилище: ответы удалённого комментария по-прежнему
+	// читаются, а сами удалённые записи — нет.
+	removed map[string]struct{}
+	// idempotency хранит ключи повторяемых мутаций; истёкшие удаляются раз в
+	// idempotencySweepInterval.
+	idempotency map[string]*storage.IdempotencyKey
+	lastSweep   time.Time
 }
 
+const idempotencySweepInterval = time.Minute
+
 func NewMemoryStorage() *MemoryStorage {
 	return &MemoryStorage{
 		posts:    make(map[string]*model.Post),
 		comments: make(map[string]*model.Comment),
+
+		postComments: make(map[string][]*model.Comment),
+		replies:      make(map[string][]*model.Comment),
+		reports:      make(map[string]*model.Report),
+		hidden:       make(map[string]storage.Visibility),
+		removed:      make(map[string]struct{}),
+
+		idempotency: make(map[string]*storage.IdempotencyKey),
 	}
 }
 
-func (m *MemoryStorage) CreatePost(ctx context.Context, post *model.Post) error {
+func (m *MemoryStorage) Ping(ctx context.Context) error {
+	return nil
+}
+
+func (m *MemoryStorage) CreatePost(ctx context.Context, post *model.Post, visibility storage.Visibility) error {
 	m.mu.Lock()
 	defer m.mu.Unlock()
 	m.posts[post.ID] = post
+	if visibility != storage.VisibilityPublic {
+		m.hidden[post.ID] = visibility
+	}
 	return nil
 }
 
+// post и comment возвращают запись, если она не удалена.
+func (m *MemoryStorage) post(id string) (*model.Post, bool) {
+	if _, removed := m.removed[id]; removed {
+		return nil, false
+	}
+	post, ok := m.posts[id]
+	return post, ok
+}
+
+func (m *MemoryStorage) comment(id string) (*model.Comment, bool) {
+	if _, removed := m.removed[id]; removed {
+		return nil, false
+	}
+	comment, ok := m.comments[id]
+	return comment, ok
+}
+
 func (m *MemoryStorage) GetPosts(ctx context.Context) ([]*model.Post, error) {
 	m.mu.RLock()
 	defer m.mu.RUnlock()
 	posts := []*model.Post{}
 	for _, p := range m.posts {
-		posts = append(posts, p)
+		_, hidden := m.hidden[p.ID]
+		_, removed := m.removed[p.ID]
+		if !hidden && !removed {
+			posts = append(posts, p)
+		}
 	}
 	return posts, nil
 }
@@ -41,17 +101,30 @@ func (m *MemoryStorage) GetPosts(ctx context.Context) ([]*model.Post, error) {
 func (m *MemoryStorage) GetPostByID(ctx context.Context, id string) (*model.Post, error) {
 	m.mu.RLock()
 	defer m.mu.RUnlock()
-	post, ok := m.posts[id]
+	post, ok := m.post(id)
 	if !ok {
 		return nil, errors.New("post not found")
 	}
 	return post, nil
 }
 
+func (m *MemoryStorage) GetPostWithVisibility(ctx context.Context, id string) (*model.Post, storage.Visibility, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	post, ok := m.post(id)
+	if !ok {
+		return nil, "", errors.New("post not found")
+	}
+	if visibility, hidden := m.hidden[id]; hidden {
+		return post, visibility, nil
+	}
+	return post, storage.VisibilityPublic, nil
+}
+
 func (m *MemoryStorage) ToggleComments(ctx context.Context, postID string, enabled bool, author string) (*model.Post, error) {
 	m.mu.Lock()
 	defer m.mu.Unlock()
-	post, ok := m.posts[postID]
+	post, ok := m.post(postID)
 	if !ok {
 		return nil, errors.New("post not found")
 	}
@@ -59,58 +132,325 @@ func (m *MemoryStorage) ToggleComments(ctx context.Context, postID string, enabl
 	return post, nil
 }
 
-func (m *MemoryStorage) CreateComment(ctx context.Context, comment *model.Comment) error {
+func (m *MemoryStorage) RemovePost(ctx context.Context, id string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.post(id); !ok {
+		return errors.New("post not found")
+	}
+	m.removed[id] = struct{}{}
+	return nil
+}
+
+func (m *MemoryStorage) PublishPost(ctx context.Context, id string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.post(id); !ok || m.hidden[id] != storage.VisibilityHeld {
+		return errors.New("held post not found")
+	}
+	delete(m.hidden, id)
+	return nil
+}
+
+func (m *MemoryStorage) CreateComment(ctx context.Context, comment *model.Comment, visibility storage.Visibility) error {
 	m.mu.Lock()
 	defer m.mu.Unlock()
 	if _, ok := m.posts[comment.PostID]; !ok {
 		return errors.New("post not found")
 	}
-	m.comments[comment.ID] = comment
 	if comment.ParentID != nil {
-		parent, ok := m.comments[*comment.ParentID]
-		if !ok {
+		if _, ok := m.comments[*comment.ParentID]; !ok {
 			return errors.New("parent comment not found")
 		}
-		parent.Replies = append(parent.Replies, comment)
-	} else {
-		post := m.posts[comment.PostID]
-		post.Comments = append(post.Comments, comment)
 	}
+	m.comments[comment.ID] = comment
+	if visibility != storage.VisibilityPublic {
+		m.hidden[comment.ID] = visibility
+		return nil
+	}
+	m.attachComment(comment)
 	return nil
 }
 
-func (m *MemoryStorage) GetCommentsByPostID(ctx context.Context, postID string, limit, offset int) ([]*model.Comment, error) {
+func (m *MemoryStorage) PublishComment(ctx context.Context, id string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	comment, ok := m.comment(id)
+	if !ok || m.hidden[id] != storage.VisibilityHeld {
+		return errors.New("held comment not found")
+	}
+	delete(m.hidden, id)
+	m.attachComment(comment)
+	return nil
+}
+
+// attachComment добавляет комментарий в ветку родителя, откуда его читают списки.
+func (m *MemoryStorage) attachComment(comment *model.Comment) {
+	if comment.ParentID != nil {
+		if _, ok := m.comments[*comment.ParentID]; ok {
+			m.replies[*comment.ParentID] = append(m.replies[*comment.ParentID], comment)
+		}
+		return
+	}
+	if _, ok := m.posts[comment.PostID]; ok {
+		m.postComments[comment.PostID] = append(m.postComments[comment.PostID], comment)
+	}
+}
+
+func (m *MemoryStorage) GetCommentByID(ctx context.Context, id string) (*model.Comment, error) {
 	m.mu.RLock()
 	defer m.mu.RUnlock()
-	post, ok := m.posts[postID]
+	comment, ok := m.comment(id)
 	if !ok {
+		return nil, errors.New("comment not found")
+	}
+	return comment, nil
+}
+
+func (m *MemoryStorage) GetCommentsByPostID(ctx context.Context, postID string, limit, offset int) ([]*model.Comment, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	if _, ok := m.posts[postID]; !ok {
 		return nil, errors.New("post not found")
 	}
-	comments := post.Comments
+	return page(m.postComments[postID], limit, offset), nil
+}
+
+func (m *MemoryStorage) GetReplies(ctx context.Context, parentID string, limit, offset int) ([]*model.Comment, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	if _, ok := m.comments[parentID]; !ok {
+		return nil, errors.New("parent comment not found")
+	}
+	return page(m.replies[parentID], limit, offset), nil
+}
+
+// page копирует страницу, чтобы последующие записи не меняли отданный срез.
+func page(comments []*model.Comment, limit, offset int) []*model.Comment {
 	if offset > len(comments) {
-		return []*model.Comment{}, nil
+		return []*model.Comment{}
+	}
+	end := min(offset+limit, len(comments))
+	return slices.Clone(comments[offset:end])
+}
+
+func (m *MemoryStorage) GetCommentsSince(ctx context.Context, postID string, since time.Time, afterID string, limit int) ([]*model.Comment, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	var comments []*model.Comment
+	for _, c := range m.comments {
+		if c.PostID != postID {
+			continue
+		}
+		if _, hidden := m.hidden[c.ID]; hidden {
+			continue
+		}
+		if _, removed := m.removed[c.ID]; removed {
+			continue
+		}
+		if c.CreatedAt.Before(since) || c.CreatedAt.Equal(since) && c.ID <= afterID {
+			continue
+		}
+		comments = append(comments, c)
 	}
-	end := offset + limit
-	if end > len(comments) {
-		end = len(comments)
+	slices.SortFunc(comments, func(a, b *model.Comment) int {
+		if c := a.CreatedAt.Compare(b.CreatedAt); c != 0 {
+			return c
+		}
+		return strings.Compare(a.ID, b.ID)
+	})
+	if len(comments) > limit {
+		comments = comments[:limit]
 	}
-	return comments[offset:end], nil
+	return comments, nil
 }
 
-func (m *MemoryStorage) GetReplies(ctx context.Context, parentID string, limit, offset int) ([]*model.Comment, error) {
+func (m *MemoryStorage) UpdateComment(ctx context.Context, id, content string, editedAt time.Time) (*model.Comment, storage.Visibility, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	comment, ok := m.comment(id)
+	if !ok {
+		return nil, "", errors.New("comment not found")
+	}
+	comment.Content = content
+	comment.EditedAt = &editedAt
+	if visibility, hidden := m.hidden[id]; hidden {
+		return comment, visibility, nil
+	}
+	return comment, storage.VisibilityPublic, nil
+}
+
+func (m *MemoryStorage) RemoveComment(ctx context.Context, id string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	comment, ok := m.comment(id)
+	if !ok {
+		return errors.New("comment not found")
+	}
+	m.removed[id] = struct{}{}
+	if comment.ParentID != nil {
+		m.replies[*comment.ParentID] = withoutComment(m.replies[*comment.ParentID], id)
+	} else {
+		m.postComments[comment.PostID] = withoutComment(m.postComments[comment.PostID], id)
+	}
+	return nil
+}
+
+func withoutComment(comments []*model.Comment, id string) []*model.Comment {
+	result := make([]*model.Comment, 0, len(comments))
+	for _, c := range comments {
+		if c.ID != id {
+			result = append(result, c)
+		}
+	}
+	return result
+}
+
+func (m *MemoryStorage) CreateReport(ctx context.Context, report *model.Report) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.reports[report.ID] = report
+	m.reportOrder = append(m.reportOrder, report.ID)
+	return nil
+}
+
+func (m *MemoryStorage) GetReportByID(ctx context.Context, id string) (*model.Report, error) {
 	m.mu.RLock()
 	defer m.mu.RUnlock()
-	parent, ok := m.comments[parentID]
+	report, ok := m.reports[id]
 	if !ok {
-		return nil, errors.New("parent comment not found")
+		return nil, errors.New("report not found")
+	}
+	return report, nil
+}
+
+func (m *MemoryStorage) GetReports(ctx context.Context, status model.ReportStatus, limit int, afterID string) ([]*model.Report, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	reports := []*model.Report{}
+	started := afterID == ""
+	for _, id := range m.reportOrder {
+		if !started {
+			started = id == afterID
+			continue
+		}
+		if report := m.reports[id]; report.Status == status {
+			reports = append(reports, report)
+			if len(reports) == limit {
+				break
+			}
+		}
 	}
-	replies := parent.Replies
-	if offset > len(replies) {
-		return []*model.Comment{}, nil
+	return reports, nil
+}
+
+func (m *MemoryStorage) UpdateReportStatus(ctx context.Context, id string, change *model.ReportStatusChange) (*model.Report, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	report, ok := m.reports[id]
+	if !ok {
+		return nil, errors.New("report not found")
 	}
-	end := offset + limit
-	if end > len(replies) {
-		end = len(replies)
+	if report.Status != model.ReportStatusOpen {
+		return nil, storage.ErrReportResolved
 	}
-	return replies[offset:end], nil
+	report.Status = change.Status
+	report.History = append(report.History, change)
+	return report, nil
+}
+
+func (m *MemoryStorage) ReopenReport(ctx context.Context, id string, from model.ReportStatus, change *model.ReportStatusChange) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	report, ok := m.reports[id]
+	if !ok || report.Status != from {
+		return errors.New("report not found")
+	}
+	report.Status = model.ReportStatusOpen
+	report.History = append(report.History, change)
+	return nil
+}
+
+func (m *MemoryStorage) CreateAuditEntry(ctx context.Context, entry *model.AuditEntry) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	entry.ID = strconv.Itoa(len(m.auditLog) + 1)
+	m.auditLog = append(m.auditLog, entry)
+	return nil
+}
+
+func (m *MemoryStorage) GetAuditEntries(ctx context.Context, filter model.AuditLogFilter, limit int, afterID string) ([]*model.AuditEntry, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	start := 0
+	if afterID != "" {
+		n, err := strconv.Atoi(afterID)
+		if err != nil {
+			return nil, errors.New("invalid audit entry id")
+		}
+		start = n
+	}
+	entries := []*model.AuditEntry{}
+	for i := start; i < len(m.auditLog) && len(entries) < limit; i++ {
+		if matchesAuditFilter(m.auditLog[i], filter) {
+			entries = append(entries, m.auditLog[i])
+		}
+	}
+	return entries, nil
+}
+
+func matchesAuditFilter(e *model.AuditEntry, f model.AuditLogFilter) bool {
+	switch {
+	case f.Actor != nil && e.Actor != *f.Actor:
+		return false
+	case f.Action != nil && e.Action != *f.Action:
+		return false
+	case f.TargetID != nil && e.TargetID != *f.TargetID:
+		return false
+	case f.Since != nil && e.CreatedAt.Before(*f.Since):
+		return false
+	case f.Until != nil && !e.CreatedAt.Before(*f.Until):
+		return false
+	}
+	return true
+}
+
+func (m *MemoryStorage) ClaimIdempotencyKey(ctx context.Context, key *storage.IdempotencyKey) (*storage.IdempotencyKey, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	now := time.Now()
+	if now.Sub(m.lastSweep) >= idempotencySweepInterval {
+		m.lastSweep = now
+		for k, existing := range m.idempotency {
+			if !now.Before(existing.ExpiresAt) {
+				delete(m.idempotency, k)
+			}
+		}
+	}
+	if existing, ok := m.idempotency[key.Key]; ok && now.Before(existing.ExpiresAt) {
+		claimed := *existing
+		return &claimed, nil
+	}
+	claimed := *key
+	m.idempotency[key.Key] = &claimed
+	return key, nil
+}
+
+func (m *MemoryStorage) CompleteIdempotencyKey(ctx context.Context, key, resultID string, expiresAt time.Time) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if existing, ok := m.idempotency[key]; ok && existing.ResultID == resultID {
+		existing.Completed = true
+		existing.ExpiresAt = expiresAt
+	}
+	return nil
+}
+
+func (m *MemoryStorage) ReleaseIdempotencyKey(ctx context.Context, key, resultID string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if existing, ok := m.idempotency[key]; ok && existing.ResultID == resultID {
+		delete(m.idempotency, key)
+	}
+	return nil
 }
@@ -0,0 +1,60 @@
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+// Extension — расширение gqlgen, считающее запросы и их длительность. Метки
+// берутся из корневых полей схемы, а не из имени операции, которое задаёт
+// клиент, поэтому число рядов ограничено. Подписки учитываются отдельно.
+type Extension struct {
+	m *Metrics
+}
+
+var _ interface {
+	graphql.HandlerExtension
+	graphql.ResponseInterceptor
+} = Extension{}
+
+func (m *Metrics) Extension() Extension {
+	return Extension{m: m}
+}
+
+func (Extension) ExtensionName() string {
+	return "Metrics"
+}
+
+func (Extension) Validate(schema graphql.ExecutableSchema) error {
+	return nil
+}
+
+func (e Extension) InterceptResponse(ctx context.Context, next graphql.ResponseHandler) *graphql.Response {
+	resp := next(ctx)
+	if !graphql.HasOperationContext(ctx) {
+		return resp
+	}
+	opCtx := graphql.GetOperationContext(ctx)
+	op := opCtx.Operation
+	if op == nil || op.Operation == ast.Subscription {
+		return resp
+	}
+
+	elapsed := time.Since(opCtx.Stats.OperationStart).Seconds()
+	result := "ok"
+	if resp == nil || len(resp.Errors) > 0 {
+		result = "error"
+	}
+	root := []string{"Query"}
+	if op.Operation == ast.Mutation {
+		root = []string{"Mutation"}
+	}
+	for _, field := range graphql.CollectFields(opCtx, op.SelectionSet, root) {
+		e.m.requests.WithLabelValues(string(op.Operation), field.Name, result).Inc()
+		e.m.requestDuration.WithLabelValues(string(op.Operation), field.Name).Observe(elapsed)
+	}
+	return resp
+}
@@ -0,0 +1,82 @@
+// Package metrics собирает метрики сервиса в формате Prometheus: запросы
+// GraphQL, вызовы хранилища, пул соединений с базой и доставку подписок.
+package metrics
+
+import (
+	"database/sql"
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "hivemind"
+
+type Metrics struct {
+	registry *prometheus.Registry
+
+	requests        *prometheus.CounterVec
+	requestDuration *prometheus.HistogramVec
+	storageCalls    *prometheus.CounterVec
+	storageDuration *prometheus.HistogramVec
+}
+
+func New() *Metrics {
+	m := &Metrics{
+		registry: prometheus.NewRegistry(),
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "graphql_requests_total",
+			Help:      "GraphQL queries and mutations by root field and result.",
+		}, []string{"type", "field", "status"}),
+		requestDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "graphql_request_duration_seconds",
+			Help:      "GraphQL query and mutation latency by root field.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"type", "field"}),
+		storageCalls: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "storage_calls_total",
+			Help:      "Storage calls by method and result.",
+		}, []string{"method", "status"}),
+		storageDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "storage_call_duration_seconds",
+			Help:      "Storage call latency by method.",
+			Buckets:   []float64{.0005, .001, .0025, .005, .01, .025, .05, .1, .25, .5, 1, 2.5},
+		}, []string{"method"}),
+	}
+	m.registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		m.requests,
+		m.requestDuration,
+		m.storageCalls,
+		m.storageDuration,
+	)
+	return m
+}
+
+// RegisterDB добавляет статистику пула соединений sql.DB.
+func (m *Metrics) RegisterDB(db *sql.DB, name string) {
+	m.registry.MustRegister(collectors.NewDBStatsCollector(db, name))
+}
+
+// RegisterSubscriptions добавляет метрики хабов подписок.
+func (m *Metrics) RegisterSubscriptions(source SubscriptionSource) {
+	m.registry.MustRegister(newSubscriptionCollector(source))
+}
+
+// Handler отдаёт метрики в текстовом формате Prometheus.
+func (m *Metrics) Handler() http.Handler {
+	return promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{Registry: m.registry})
+}
+
+func status(err error) string {
+	if err != nil {
+		return "error"
+	}
+	return "ok"
+}
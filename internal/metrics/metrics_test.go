@@ -0,0 +1,101 @@
+package metrics_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"hivemind/graph/generated"
+	"hivemind/graph/model"
+	"hivemind/graph/resolver"
+	"hivemind/internal/hub"
+	"hivemind/internal/metrics"
+	"hivemind/internal/storage"
+	"hivemind/internal/storage/mocks"
+
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func scrape(t *testing.T, m *metrics.Metrics) string {
+	t.Helper()
+	rec := httptest.NewRecorder()
+	m.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	body, err := io.ReadAll(rec.Body)
+	require.NoError(t, err)
+	return string(body)
+}
+
+func TestGraphQLMetrics(t *testing.T) {
+	m := metrics.New()
+	mockStorage := mocks.NewStorageMock(t)
+	mockStorage.GetPostsMock.Return([]*model.Post{}, nil)
+	mockStorage.GetPostWithVisibilityMock.Return(nil, "", errors.New("post not found"))
+
+	srv := handler.New(generated.NewExecutableSchema(generated.Config{Resolvers: resolver.NewResolver(m.InstrumentStorage(mockStorage))}))
+	srv.AddTransport(transport.POST{})
+	srv.Use(m.Extension())
+	for _, query := range []string{`{ posts { id } }`, `{ posts { id } }`, `query Named { post(id: "missing") { id } }`} {
+		req := httptest.NewRequest(http.MethodPost, "/query", strings.NewReader(`{"query":`+jsonString(query)+`}`))
+		req.Header.Set("Content-Type", "application/json")
+		srv.ServeHTTP(httptest.NewRecorder(), req)
+	}
+
+	body := scrape(t, m)
+	assert.Contains(t, body, `hivemind_graphql_requests_total{field="posts",status="ok",type="query"} 2`)
+	assert.Contains(t, body, `hivemind_graphql_requests_total{field="post",status="error",type="query"} 1`)
+	assert.Contains(t, body, `hivemind_graphql_request_duration_seconds_count{field="posts",type="query"} 2`)
+	assert.Contains(t, body, `hivemind_storage_calls_total{method="GetPosts",status="ok"} 2`)
+	assert.Contains(t, body, `hivemind_storage_calls_total{method="GetPostWithVisibility",status="error"} 1`)
+	assert.Contains(t, body, `hivemind_storage_call_duration_seconds_count{method="GetPostWithVisibility"} 1`)
+}
+
+type fakeSource struct{}
+
+func (fakeSource) TopicStats() map[string]hub.Stats {
+	return map[string]hub.Stats{"commentAdded": {Subscribers: 3, Delivered: 10, Dropped: 2}}
+}
+
+func (fakeSource) PostSubscribers() map[string]int {
+	return map[string]int{"post1": 2, "post2": 1}
+}
+
+func TestSubscriptionMetrics(t *testing.T) {
+	m := metrics.New()
+	m.RegisterSubscriptions(fakeSource{})
+
+	body := scrape(t, m)
+	assert.Contains(t, body, `hivemind_subscription_active{topic="commentAdded"} 3`)
+	assert.Contains(t, body, `hivemind_subscription_events_dropped_total{topic="commentAdded"} 2`)
+	assert.Contains(t, body, `hivemind_subscription_post_active{post_id="post1"} 2`)
+	assert.Contains(t, body, `hivemind_subscription_post_active{post_id="post2"} 1`)
+}
+
+func TestResolverSubscriptionSource(t *testing.T) {
+	mockStorage := mocks.NewStorageMock(t)
+	mockStorage.GetPostWithVisibilityMock.Return(&model.Post{ID: "post1"}, storage.VisibilityPublic, nil)
+	res := resolver.NewResolver(mockStorage)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	_, err := res.CommentAdded(ctx, "post1", nil, nil)
+	require.NoError(t, err)
+	_, err = res.CommentUpdated(ctx, "post1")
+	require.NoError(t, err)
+
+	m := metrics.New()
+	m.RegisterSubscriptions(res)
+	body := scrape(t, m)
+	assert.Contains(t, body, `hivemind_subscription_post_active{post_id="post1"} 2`)
+	assert.Contains(t, body, `hivemind_subscription_active{topic="commentAdded"} 1`)
+}
+
+func jsonString(s string) string {
+	return `"` + strings.ReplaceAll(s, `"`, `\"`) + `"`
+}
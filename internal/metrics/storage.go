@@ -0,0 +1,156 @@
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"hivemind/graph/model"
+	"hivemind/internal/storage"
+)
+
+// Storage оборачивает хранилище и измеряет длительность и ошибки каждого метода.
+type Storage struct {
+	next storage.Storage
+	m    *Metrics
+}
+
+var _ storage.Storage = (*Storage)(nil)
+
+func (m *Metrics) InstrumentStorage(next storage.Storage) *Storage {
+	return &Storage{next: next, m: m}
+}
+
+func (s *Storage) observe(method string, start time.Time, err *error) {
+	s.m.storageDuration.WithLabelValues(method).Observe(time.Since(start).Seconds())
+	s.m.storageCalls.WithLabelValues(method, status(*err)).Inc()
+}
+
+func (s *Storage) Ping(ctx context.Context) (err error) {
+	defer s.observe("Ping", time.Now(), &err)
+	return s.next.Ping(ctx)
+}
+
+func (s *Storage) CreatePost(ctx context.Context, post *model.Post, visibility storage.Visibility) (err error) {
+	defer s.observe("CreatePost", time.Now(), &err)
+	return s.next.CreatePost(ctx, post, visibility)
+}
+
+func (s *Storage) GetPosts(ctx context.Context) (_ []*model.Post, err error) {
+	defer s.observe("GetPosts", time.Now(), &err)
+	return s.next.GetPosts(ctx)
+}
+
+func (s *Storage) GetPostByID(ctx context.Context, id string) (_ *model.Post, err error) {
+	defer s.observe("GetPostByID", time.Now(), &err)
+	return s.next.GetPostByID(ctx, id)
+}
+
+func (s *Storage) GetPostWithVisibility(ctx context.Context, id string) (_ *model.Post, _ storage.Visibility, err error) {
+	defer s.observe("GetPostWithVisibility", time.Now(), &err)
+	return s.next.GetPostWithVisibility(ctx, id)
+}
+
+func (s *Storage) ToggleComments(ctx context.Context, postID string, enabled bool, author string) (_ *model.Post, err error) {
+	defer s.observe("ToggleComments", time.Now(), &err)
+	return s.next.ToggleComments(ctx, postID, enabled, author)
+}
+
+func (s *Storage) RemovePost(ctx context.Context, id string) (err error) {
+	defer s.observe("RemovePost", time.Now(), &err)
+	return s.next.RemovePost(ctx, id)
+}
+
+func (s *Storage) PublishPost(ctx context.Context, id string) (err error) {
+	defer s.observe("PublishPost", time.Now(), &err)
+	return s.next.PublishPost(ctx, id)
+}
+
+func (s *Storage) CreateComment(ctx context.Context, comment *model.Comment, visibility storage.Visibility) (err error) {
+	defer s.observe("CreateComment", time.Now(), &err)
+	return s.next.CreateComment(ctx, comment, visibility)
+}
+
+func (s *Storage) GetCommentByID(ctx context.Context, id string) (_ *model.Comment, err error) {
+	defer s.observe("GetCommentByID", time.Now(), &err)
+	return s.next.GetCommentByID(ctx, id)
+}
+
+func (s *Storage) GetCommentsByPostID(ctx context.Context, postID string, limit, offset int) (_ []*model.Comment, err error) {
+	defer s.observe("GetCommentsByPostID", time.Now(), &err)
+	return s.next.GetCommentsByPostID(ctx, postID, limit, offset)
+}
+
+func (s *Storage) GetReplies(ctx context.Context, parentID string, limit, offset int) (_ []*model.Comment, err error) {
+	defer s.observe("GetReplies", time.Now(), &err)
+	return s.next.GetReplies(ctx, parentID, limit, offset)
+}
+
+func (s *Storage) GetCommentsSince(ctx context.Context, postID string, since time.Time, afterID string, limit int) (_ []*model.Comment, err error) {
+	defer s.observe("GetCommentsSince", time.Now(), &err)
+	return s.next.GetCommentsSince(ctx, postID, since, afterID, limit)
+}
+
+func (s *Storage) UpdateComment(ctx context.Context, id, content string, editedAt time.Time) (_ *model.Comment, _ storage.Visibility, err error) {
+	defer s.observe("UpdateComment", time.Now(), &err)
+	return s.next.UpdateComment(ctx, id, content, editedAt)
+}
+
+func (s *Storage) RemoveComment(ctx context.Context, id string) (err error) {
+	defer s.observe("RemoveComment", time.Now(), &err)
+	return s.next.RemoveComment(ctx, id)
+}
+
+func (s *Storage) PublishComment(ctx context.Context, id string) (err error) {
+	defer s.observe("PublishComment", time.Now(), &err)
+	return s.next.PublishComment(ctx, id)
+}
+
+func (s *Storage) CreateReport(ctx context.Context, report *model.Report) (err error) {
+	defer s.observe("CreateReport", time.Now(), &err)
+	return s.next.CreateReport(ctx, report)
+}
+
+func (s *Storage) GetReportByID(ctx context.Context, id string) (_ *model.Report, err error) {
+	defer s.observe("GetReportByID", time.Now(), &err)
+	return s.next.GetReportByID(ctx, id)
+}
+
+func (s *Storage) GetReports(ctx context.Context, status model.ReportStatus, limit int, afterID string) (_ []*model.Report, err error) {
+	defer s.observe("GetReports", time.Now(), &err)
+	return s.next.GetReports(ctx, status, limit, afterID)
+}
+
+func (s *Storage) UpdateReportStatus(ctx context.Context, id string, change *model.ReportStatusChange) (_ *model.Report, err error) {
+	defer s.observe("UpdateReportStatus", time.Now(), &err)
+	return s.next.UpdateReportStatus(ctx, id, change)
+}
+
+func (s *Storage) ReopenReport(ctx context.Context, id string, from model.ReportStatus, change *model.ReportStatusChange) (err error) {
+	defer s.observe("ReopenReport", time.Now(), &err)
+	return s.next.ReopenReport(ctx, id, from, change)
+}
+
+func (s *Storage) CreateAuditEntry(ctx context.Context, entry *model.AuditEntry) (err error) {
+	defer s.observe("CreateAuditEntry", time.Now(), &err)
+	return s.next.CreateAuditEntry(ctx, entry)
+}
+
+func (s *Storage) GetAuditEntries(ctx context.Context, filter model.AuditLogFilter, limit int, afterID string) (_ []*model.AuditEntry, err error) {
+	defer s.observe("GetAuditEntries", time.Now(), &err)
+	return s.next.GetAuditEntries(ctx, filter, limit, afterID)
+}
+
+func (s *Storage) ClaimIdempotencyKey(ctx context.Context, key *storage.IdempotencyKey) (_ *storage.IdempotencyKey, err error) {
+	defer s.observe("ClaimIdempotencyKey", time.Now(), &err)
+	return s.next.ClaimIdempotencyKey(ctx, key)
+}
+
+func (s *Storage) CompleteIdempotencyKey(ctx context.Context, key, resultID string, expiresAt time.Time) (err error) {
+	defer s.observe("CompleteIdempotencyKey", time.Now(), &err)
+	return s.next.CompleteIdempotencyKey(ctx, key, resultID, expiresAt)
+}
+
+func (s *Storage) ReleaseIdempotencyKey(ctx context.Context, key, resultID string) (err error) {
+	defer s.observe("ReleaseIdempotencyKey", time.Now(), &err)
+	return s.next.ReleaseIdempotencyKey(ctx, key, resultID)
+}
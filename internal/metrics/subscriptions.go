@@ -0,0 +1,61 @@
+package metrics
+
+import (
+	"hivemind/internal/hub"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// SubscriptionSource отдаёт состояние хабов подписок на момент сбора метрик.
+type SubscriptionSource interface {
+	TopicStats() map[string]hub.Stats
+	PostSubscribers() map[string]int
+}
+
+type subscriptionCollector struct {
+	source SubscriptionSource
+
+	active       *prometheus.Desc
+	perPost      *prometheus.Desc
+	delivered    *prometheus.Desc
+	dropped      *prometheus.Desc
+	coalesced    *prometheus.Desc
+	disconnected *prometheus.Desc
+}
+
+func newSubscriptionCollector(source SubscriptionSource) *subscriptionCollector {
+	desc := func(name, help string, labels ...string) *prometheus.Desc {
+		return prometheus.NewDesc(prometheus.BuildFQName(namespace, "subscription", name), help, labels, nil)
+	}
+	return &subscriptionCollector{
+		source:       source,
+		active:       desc("active", "Active subscriptions by topic.", "topic"),
+		perPost:      desc("post_active", "Active subscriptions to events of a post.", "post_id"),
+		delivered:    desc("events_delivered_total", "Events queued to subscribers.", "topic"),
+		dropped:      desc("events_dropped_total", "Events dropped because a subscriber queue was full.", "topic"),
+		coalesced:    desc("coalesced_total", "Subscriber queues flushed by the coalesce policy.", "topic"),
+		disconnected: desc("disconnected_total", "Subscribers disconnected for being too slow.", "topic"),
+	}
+}
+
+func (c *subscriptionCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.active
+	ch <- c.perPost
+	ch <- c.delivered
+	ch <- c.dropped
+	ch <- c.coalesced
+	ch <- c.disconnected
+}
+
+func (c *subscriptionCollector) Collect(ch chan<- prometheus.Metric) {
+	for topic, s := range c.source.TopicStats() {
+		ch <- prometheus.MustNewConstMetric(c.active, prometheus.GaugeValue, float64(s.Subscribers), topic)
+		ch <- prometheus.MustNewConstMetric(c.delivered, prometheus.CounterValue, float64(s.Delivered), topic)
+		ch <- prometheus.MustNewConstMetric(c.dropped, prometheus.CounterValue, float64(s.Dropped), topic)
+		ch <- prometheus.MustNewConstMetric(c.coalesced, prometheus.CounterValue, float64(s.Coalesced), topic)
+		ch <- prometheus.MustNewConstMetric(c.disconnected, prometheus.CounterValue, float64(s.Disconnected), topic)
+	}
+	for postID, n := range c.source.PostSubscribers() {
+		ch <- prometheus.MustNewConstMetric(c.perPost, prometheus.GaugeValue, float64(n), postID)
+	}
+}
@@ -0,0 +1,285 @@
+// Package presence отслеживает, сколько людей смотрят пост и кто из них
+// сейчас пишет комментарий.
+//
+// Состояние живёт только в памяти. Каждая реплика рассылает через брокер
+// число своих зрителей и события набора текста, а общую картину собирает
+// сама; данные реплики, переставшей присылать обновления, забываются.
+package presence
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"slices"
+	"sync"
+	"time"
+
+	"hivemind/internal/pubsub"
+)
+
+const (
+	topic = "hivemind_presence"
+
+	// replicaTTL — сколько помнить зрителей другой реплики без обновлений.
+	replicaTTL = 30 * time.Second
+	// refreshInterval — как часто реплика подтверждает число своих зрителей.
+	refreshInterval = replicaTTL / 3
+)
+
+// Snapshot — присутствие на посте с точки зрения этой реплики.
+type Snapshot struct {
+	PostID  string
+	Viewers int
+	Typing  []string
+}
+
+type message struct {
+	Origin  string `json:"origin"`
+	PostID  string `json:"postId"`
+	Viewers *int   `json:"viewers,omitempty"`
+	User    string `json:"user,omitempty"`
+}
+
+type replicaViewers struct {
+	count  int
+	seenAt time.Time
+}
+
+type post struct {
+	local   int
+	remote  map[string]replicaViewers
+	typing  map[string]time.Time
+	changed bool
+}
+
+type Tracker struct {
+	origin    string
+	broker    pubsub.Broker
+	typingTTL time.Duration
+	onChange  func(Snapshot)
+
+	mu         sync.Mutex
+	posts      map[string]*post
+	refreshing bool
+}
+
+// New создаёт трекер. onChange вызывается при каждом изменении присутствия
+// на посте и не должен блокироваться.
+func New(origin string, broker pubsub.Broker, typingTTL time.Duration, onChange func(Snapshot)) *Tracker {
+	t := &Tracker{
+		origin:    origin,
+		broker:    broker,
+		typingTTL: typingTTL,
+		onChange:  onChange,
+		posts:     make(map[string]*post),
+	}
+	if err := broker.Subscribe(topic, t.handle); err != nil {
+		slog.Error("failed to subscribe to presence", "topic", topic, "error", err)
+	}
+	return t
+}
+
+// Join отмечает нового зрителя поста на этой реплике.
+func (t *Tracker) Join(postID string) {
+	t.updateLocal(postID, 1)
+}
+
+// Leave снимает отметку, поставленную Join.
+func (t *Tracker) Leave(postID string) {
+	t.updateLocal(postID, -1)
+}
+
+// Typing отмечает, что пользователь пишет комментарий; отметка исчезает
+// через typingTTL, если её не обновить.
+func (t *Tracker) Typing(postID, user string) {
+	t.setTyping(postID, user)
+	t.send(message{Origin: t.origin, PostID: postID, User: user})
+}
+
+func (t *Tracker) Snapshot(postID string) Snapshot {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.snapshot(postID)
+}
+
+func (t *Tracker) updateLocal(postID string, delta int) {
+	t.mu.Lock()
+	p := t.post(postID)
+	p.local += delta
+	p.changed = true
+	viewers := p.local
+	if viewers > 0 && !t.refreshing {
+		t.refreshing = true
+		go t.refresh()
+	}
+	snapshots := t.collect()
+	t.mu.Unlock()
+
+	t.send(message{Origin: t.origin, PostID: postID, Viewers: &viewers})
+	t.notify(snapshots)
+}
+
+func (t *Tracker) setTyping(postID, user string) {
+	t.mu.Lock()
+	p := t.post(postID)
+	if _, ok := p.typing[user]; !ok {
+		p.changed = true
+	}
+	p.typing[user] = time.Now().Add(t.typingTTL)
+	snapshots := t.collect()
+	t.mu.Unlock()
+
+	time.AfterFunc(t.typingTTL, func() { t.expire(postID) })
+	t.notify(snapshots)
+}
+
+func (t *Tracker) handle(payload []byte) {
+	var msg message
+	if err := json.Unmarshal(payload, &msg); err != nil {
+		slog.Error("failed to decode presence message", "error", err)
+		return
+	}
+	if msg.Origin == t.origin {
+		return
+	}
+	if msg.User != "" {
+		t.setTyping(msg.PostID, msg.User)
+		return
+	}
+	if msg.Viewers == nil {
+		return
+	}
+
+	t.mu.Lock()
+	p := t.post(msg.PostID)
+	if prev, ok := p.remote[msg.Origin]; !ok || prev.count != *msg.Viewers {
+		p.changed = true
+	}
+	if *msg.Viewers > 0 {
+		p.remote[msg.Origin] = replicaViewers{count: *msg.Viewers, seenAt: time.Now()}
+	} else {
+		delete(p.remote, msg.Origin)
+	}
+	snapshots := t.collect()
+	t.mu.Unlock()
+
+	time.AfterFunc(replicaTTL, func() { t.expire(msg.PostID) })
+	t.notify(snapshots)
+}
+
+// expire убирает истёкшие отметки набора текста и зрителей пропавших реплик.
+func (t *Tracker) expire(postID string) {
+	t.mu.Lock()
+	p, ok := t.posts[postID]
+	if !ok {
+		t.mu.Unlock()
+		return
+	}
+	now := time.Now()
+	for user, until := range p.typing {
+		if !until.After(now) {
+			delete(p.typing, user)
+			p.changed = true
+		}
+	}
+	for origin, v := range p.remote {
+		if now.Sub(v.seenAt) >= replicaTTL {
+			delete(p.remote, origin)
+			p.changed = true
+		}
+	}
+	snapshots := t.collect()
+	t.mu.Unlock()
+	t.notify(snapshots)
+}
+
+// refresh периодически подтверждает другим репликам число своих зрителей,
+// пока они есть.
+func (t *Tracker) refresh() {
+	ticker := time.NewTicker(refreshInterval)
+	defer ticker.Stop()
+	for range ticker.C {
+		t.mu.Lock()
+		var msgs []message
+		for id, p := range t.posts {
+			if p.local > 0 {
+				viewers := p.local
+				msgs = append(msgs, message{Origin: t.origin, PostID: id, Viewers: &viewers})
+			}
+		}
+		if len(msgs) == 0 {
+			t.refreshing = false
+			t.mu.Unlock()
+			return
+		}
+		t.mu.Unlock()
+		for _, msg := range msgs {
+			t.send(msg)
+		}
+	}
+}
+
+func (t *Tracker) send(msg message) {
+	payload, err := json.Marshal(msg)
+	if err != nil {
+		slog.Error("failed to encode presence message", "error", err)
+		return
+	}
+	if err := t.broker.Publish(context.Background(), topic, payload); err != nil {
+		slog.Error("failed to publish presence", "post_id", msg.PostID, "error", err)
+	}
+}
+
+// post возвращает состояние поста, создавая его при необходимости. Вызывается под t.mu.
+func (t *Tracker) post(postID string) *post {
+	p, ok := t.posts[postID]
+	if !ok {
+		p = &post{remote: make(map[string]replicaViewers), typing: make(map[string]time.Time)}
+		t.posts[postID] = p
+	}
+	return p
+}
+
+// collect собирает снимки изменившихся постов и забывает опустевшие.
+// Вызывается под t.mu.
+func (t *Tracker) collect() []Snapshot {
+	var snapshots []Snapshot
+	for id, p := range t.posts {
+		if !p.changed {
+			continue
+		}
+		p.changed = false
+		snapshots = append(snapshots, t.snapshot(id))
+		if p.local == 0 && len(p.remote) == 0 && len(p.typing) == 0 {
+			delete(t.posts, id)
+		}
+	}
+	return snapshots
+}
+
+// snapshot вызывается под t.mu.
+func (t *Tracker) snapshot(postID string) Snapshot {
+	s := Snapshot{PostID: postID, Typing: []string{}}
+	p, ok := t.posts[postID]
+	if !ok {
+		return s
+	}
+	s.Viewers = p.local
+	for _, v := range p.remote {
+		s.Viewers += v.count
+	}
+	for user := range p.typing {
+		s.Typing = append(s.Typing, user)
+	}
+	slices.Sort(s.Typing)
+	return s
+}
+
+func (t *Tracker) notify(snapshots []Snapshot) {
+	if t.onChange == nil {
+		return
+	}
+	for _, s := range snapshots {
+		t.onChange(s)
+	}
+}
@@ -0,0 +1,68 @@
+package presence_test
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"hivemind/internal/presence"
+	"hivemind/internal/pubsub"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type recorder struct {
+	mu   sync.Mutex
+	last map[string]presence.Snapshot
+}
+
+func (r *recorder) record(s presence.Snapshot) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.last[s.PostID] = s
+}
+
+func (r *recorder) get(postID string) presence.Snapshot {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.last[postID]
+}
+
+func TestTracker(t *testing.T) {
+	t.Run("viewers are summed across replicas", func(t *testing.T) {
+		broker := pubsub.NewInProcess()
+		rec := &recorder{last: make(map[string]presence.Snapshot)}
+		first := presence.New("first", broker, time.Minute, nil)
+		second := presence.New("second", broker, time.Minute, rec.record)
+
+		first.Join("post1")
+		first.Join("post1")
+		second.Join("post1")
+		assert.Equal(t, 3, second.Snapshot("post1").Viewers)
+		assert.Equal(t, 3, rec.get("post1").Viewers)
+
+		first.Leave("post1")
+		first.Leave("post1")
+		assert.Equal(t, 1, second.Snapshot("post1").Viewers)
+		assert.Equal(t, 1, first.Snapshot("post1").Viewers)
+		assert.Equal(t, 0, second.Snapshot("post2").Viewers)
+	})
+
+	t.Run("typing is shared and expires", func(t *testing.T) {
+		broker := pubsub.NewInProcess()
+		rec := &recorder{last: make(map[string]presence.Snapshot)}
+		first := presence.New("first", broker, 50*time.Millisecond, nil)
+		second := presence.New("second", broker, 50*time.Millisecond, rec.record)
+
+		first.Typing("post1", "bob")
+		first.Typing("post1", "alice")
+		assert.Equal(t, []string{"alice", "bob"}, second.Snapshot("post1").Typing)
+		assert.Equal(t, []string{"alice", "bob"}, rec.get("post1").Typing)
+
+		require.Eventually(t, func() bool {
+			return len(rec.get("post1").Typing) == 0
+		}, time.Second, 10*time.Millisecond)
+		assert.Empty(t, first.Snapshot("post1").Typing)
+	})
+}
@@ -0,0 +1,38 @@
+package pubsub
+
+import (
+	"context"
+	"sync"
+)
+
+// InProcess доставляет сообщения синхронно внутри одного процесса.
+// Подходит для запуска в одну реплику.
+type InProcess struct {
+	mu       sync.RWMutex
+	handlers map[string][]Handler
+}
+
+func NewInProcess() *InProcess {
+	return &InProcess{handlers: make(map[string][]Handler)}
+}
+
+func (b *InProcess) Publish(ctx context.Context, topic string, payload []byte) error {
+	b.mu.RLock()
+	handlers := b.handlers[topic]
+	b.mu.RUnlock()
+	for _, h := range handlers {
+		h(payload)
+	}
+	return nil
+}
+
+func (b *InProcess) Subscribe(topic string, handler Handler) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.handlers[topic] = append(b.handlers[topic], handler)
+	return nil
+}
+
+func (b *InProcess) Close() error {
+	return nil
+}
@@ -0,0 +1,23 @@
+package pubsub_test
+
+import (
+	"context"
+	"testing"
+
+	"hivemind/internal/pubsub"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInProcess(t *testing.T) {
+	b := pubsub.NewInProcess()
+	var got []string
+	require.NoError(t, b.Subscribe("comments", func(p []byte) { got = append(got, "a:"+string(p)) }))
+	require.NoError(t, b.Subscribe("comments", func(p []byte) { got = append(got, "b:"+string(p)) }))
+	require.NoError(t, b.Subscribe("posts", func(p []byte) { got = append(got, "posts:"+string(p)) }))
+
+	require.NoError(t, b.Publish(context.Background(), "comments", []byte("hi")))
+	assert.Equal(t, []string{"a:hi", "b:hi"}, got)
+	require.NoError(t, b.Close())
+}
@@ -0,0 +1,119 @@
+package pubsub
+
+import (
+	"context"
+	"database/sql"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+const (
+	// maxNotifyPayload — ограничение Postgres на размер payload у NOTIFY.
+	maxNotifyPayload   = 8000
+	listenerPingPeriod = 90 * time.Second
+)
+
+// Postgres рассылает сообщения между репликами через LISTEN/NOTIFY.
+// Тема соответствует каналу Postgres, поэтому должна быть допустимым
+// идентификатором.
+type Postgres struct {
+	db       *sql.DB
+	listener *pq.Listener
+
+	mu       sync.RWMutex
+	handlers map[string][]Handler
+
+	done chan struct{}
+	wg   sync.WaitGroup
+}
+
+func NewPostgres(dsn string) (*Postgres, error) {
+	db, err := sql.Open("postgres", dsn)
+	if err != nil {
+		return nil, err
+	}
+	db.SetMaxOpenConns(2)
+	if err := db.Ping(); err != nil {
+		db.Close()
+		return nil, err
+	}
+
+	listener := pq.NewListener(dsn, time.Second, time.Minute, func(ev pq.ListenerEventType, err error) {
+		switch ev {
+		case pq.ListenerEventDisconnected:
+			slog.Warn("pubsub listener disconnected", "error", err)
+		case pq.ListenerEventReconnected:
+			slog.Warn("pubsub listener reconnected, notifications sent meanwhile were lost")
+		case pq.ListenerEventConnectionAttemptFailed:
+			slog.Warn("pubsub listener connection attempt failed", "error", err)
+		}
+	})
+
+	b := &Postgres{
+		db:       db,
+		listener: listener,
+		handlers: make(map[string][]Handler),
+		done:     make(chan struct{}),
+	}
+	b.wg.Add(1)
+	go b.run()
+	return b, nil
+}
+
+func (b *Postgres) Publish(ctx context.Context, topic string, payload []byte) error {
+	if len(payload) >= maxNotifyPayload {
+		return ErrPayloadTooLarge
+	}
+	_, err := b.db.ExecContext(ctx, `SELECT pg_notify($1, $2)`, topic, string(payload))
+	return err
+}
+
+func (b *Postgres) Subscribe(topic string, handler Handler) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if _, ok := b.handlers[topic]; !ok {
+		if err := b.listener.Listen(topic); err != nil {
+			return err
+		}
+	}
+	b.handlers[topic] = append(b.handlers[topic], handler)
+	return nil
+}
+
+func (b *Postgres) Close() error {
+	close(b.done)
+	b.wg.Wait()
+	err := b.listener.Close()
+	if dbErr := b.db.Close(); err == nil {
+		err = dbErr
+	}
+	return err
+}
+
+func (b *Postgres) run() {
+	defer b.wg.Done()
+	ticker := time.NewTicker(listenerPingPeriod)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-b.done:
+			return
+		case n := <-b.listener.Notify:
+			// nil приходит после переподключения.
+			if n == nil {
+				continue
+			}
+			b.mu.RLock()
+			handlers := b.handlers[n.Channel]
+			b.mu.RUnlock()
+			for _, h := range handlers {
+				h([]byte(n.Extra))
+			}
+		case <-ticker.C:
+			go b.listener.Ping()
+		}
+	}
+}
@@ -0,0 +1,20 @@
+package pubsub
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrPayloadTooLarge возвращается, если брокер не может передать сообщение
+// такого размера. Издатель может отправить вместо него ссылку на данные.
+var ErrPayloadTooLarge = errors.New("pubsub: payload too large")
+
+type Handler func(payload []byte)
+
+// Broker доставляет сообщения всем обработчикам темы, в том числе на других
+// репликах сервиса. Обработчики одной темы вызываются последовательно.
+type Broker interface {
+	Publish(ctx context.Context, topic string, payload []byte) error
+	Subscribe(topic string, handler Handler) error
+	Close() error
+}
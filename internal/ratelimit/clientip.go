@@ -0,0 +1,46 @@
+package ratelimit
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"strings"
+)
+
+type clientIPKey struct{}
+
+// ClientIPMiddleware кладёт IP клиента в контекст запроса. Заголовку
+// X-Forwarded-For доверяем только за обратным прокси (trustProxy), и только
+// последнему адресу в нём: его дописал сам прокси, а предыдущие присылает
+// клиент и может подменить.
+func ClientIPMiddleware(trustProxy bool, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ip := remoteIP(r.RemoteAddr)
+		if trustProxy {
+			if forwarded := r.Header.Values("X-Forwarded-For"); len(forwarded) > 0 {
+				last := forwarded[len(forwarded)-1]
+				if i := strings.LastIndexByte(last, ','); i >= 0 {
+					last = last[i+1:]
+				}
+				if last = strings.TrimSpace(last); last != "" {
+					ip = last
+				}
+			}
+		}
+		next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), clientIPKey{}, ip)))
+	})
+}
+
+// ClientIP возвращает IP клиента, сохранённый ClientIPMiddleware.
+func ClientIP(ctx context.Context) string {
+	ip, _ := ctx.Value(clientIPKey{}).(string)
+	return ip
+}
+
+func remoteIP(addr string) string {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return addr
+	}
+	return host
+}
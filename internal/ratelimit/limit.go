@@ -0,0 +1,103 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Limit описывает token bucket: не более Requests запросов за период Per,
+// при этом весь объём можно израсходовать сразу.
+type Limit struct {
+	Requests int
+	Per      time.Duration
+}
+
+func (l Limit) rate() float64 {
+	return float64(l.Requests) / l.Per.Seconds()
+}
+
+// ParseLimit разбирает лимит вида "10/1m".
+func ParseLimit(s string) (Limit, error) {
+	count, per, ok := strings.Cut(strings.TrimSpace(s), "/")
+	if !ok {
+		return Limit{}, fmt.Errorf("invalid rate limit %q: expected <requests>/<duration>", s)
+	}
+	n, err := strconv.Atoi(count)
+	if err != nil || n <= 0 {
+		return Limit{}, fmt.Errorf("invalid rate limit %q: requests must be a positive integer", s)
+	}
+	d, err := time.ParseDuration(per)
+	if err != nil || d <= 0 {
+		return Limit{}, fmt.Errorf("invalid rate limit %q: period must be a positive duration", s)
+	}
+	return Limit{Requests: n, Per: d}, nil
+}
+
+// Rules задаёт лимиты по имени мутации; ключ "*" применяется к остальным мутациям.
+type Rules map[string]Limit
+
+const defaultRule = "*"
+
+// ParseRules разбирает список вида "createComment=10/1m,createPost=3/1m,*=60/1m".
+func ParseRules(s string) (Rules, error) {
+	rules := Rules{}
+	for _, item := range strings.Split(s, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+		name, spec, ok := strings.Cut(item, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid rate limit rule %q: expected <mutation>=<limit>", item)
+		}
+		limit, err := ParseLimit(spec)
+		if err != nil {
+			return nil, err
+		}
+		rules[strings.TrimSpace(name)] = limit
+	}
+	return rules, nil
+}
+
+func (r Rules) forField(name string) (Limit, bool) {
+	if l, ok := r[name]; ok {
+		return l, true
+	}
+	l, ok := r[defaultRule]
+	return l, ok
+}
+
+// Bucket хранит состояние token bucket.
+type Bucket struct {
+	Tokens    float64
+	UpdatedAt time.Time
+}
+
+// NewBucket возвращает полный bucket для лимита.
+func NewBucket(l Limit, now time.Time) Bucket {
+	return Bucket{Tokens: float64(l.Requests), UpdatedAt: now}
+}
+
+// Take пополняет bucket за прошедшее время и пытается забрать один токен.
+// Если токенов нет, возвращает время до появления следующего.
+func (b *Bucket) Take(l Limit, now time.Time) (bool, time.Duration) {
+	if elapsed := now.Sub(b.UpdatedAt); elapsed > 0 {
+		b.Tokens = math.Min(float64(l.Requests), b.Tokens+elapsed.Seconds()*l.rate())
+		b.UpdatedAt = now
+	}
+	if b.Tokens >= 1 {
+		b.Tokens--
+		return true, 0
+	}
+	wait := (1 - b.Tokens) / l.rate()
+	return false, time.Duration(math.Ceil(wait * float64(time.Second)))
+}
+
+// Store хранит bucket'ы. Реализации должны выполнять Take атомарно для ключа.
+type Store interface {
+	Take(ctx context.Context, key string, limit Limit, now time.Time) (allowed bool, retryAfter time.Duration, err error)
+}
@@ -0,0 +1,116 @@
+package ratelimit
+
+import (
+	"context"
+	"log/slog"
+	"math"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/errcode"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+const ErrRateLimited = "RATE_LIMITED"
+
+// userArguments перечисляет аргументы мутаций, которыми клиент представляется.
+var userArguments = []string{"author", "reporter", "moderator", "admin"}
+
+// Limiter — расширение gqlgen, ограничивающее частоту мутаций по пользователю
+// и по IP клиента до начала их выполнения.
+type Limiter struct {
+	store Store
+	rules Rules
+	now   func() time.Time
+}
+
+var _ interface {
+	graphql.HandlerExtension
+	graphql.OperationContextMutator
+} = &Limiter{}
+
+func New(store Store, rules Rules) *Limiter {
+	return &Limiter{store: store, rules: rules, now: time.Now}
+}
+
+func (l *Limiter) ExtensionName() string {
+	return "RateLimit"
+}
+
+func (l *Limiter) Validate(schema graphql.ExecutableSchema) error {
+	return nil
+}
+
+func (l *Limiter) MutateOperationContext(ctx context.Context, opCtx *graphql.OperationContext) *gqlerror.Error {
+	if opCtx.Operation == nil || opCtx.Operation.Operation != ast.Mutation {
+		return nil
+	}
+	now := l.now()
+	for _, field := range rootFields(opCtx.Operation.SelectionSet) {
+		limit, ok := l.rules.forField(field.Name)
+		if !ok {
+			continue
+		}
+		for _, key := range l.keys(ctx, field, opCtx.Variables) {
+			allowed, retryAfter, err := l.store.Take(ctx, key, limit, now)
+			if err != nil {
+				// Недоступность хранилища лимитов не должна останавливать запись.
+				slog.ErrorContext(ctx, "rate limit store failed", "key", key, "error", err)
+				continue
+			}
+			if !allowed {
+				return rateLimitedError(field.Name, retryAfter)
+			}
+		}
+	}
+	return nil
+}
+
+func (l *Limiter) keys(ctx context.Context, field *ast.Field, vars map[string]any) []string {
+	var keys []string
+	if user := User(field, vars); user != "" {
+		keys = append(keys, "user:"+field.Name+":"+user)
+	}
+	if ip := ClientIP(ctx); ip != "" {
+		keys = append(keys, "ip:"+field.Name+":"+ip)
+	}
+	return keys
+}
+
+// User возвращает пользователя, которым клиент представился в аргументах
+// поля, или пустую строку.
+func User(field *ast.Field, vars map[string]any) string {
+	args := field.ArgumentMap(vars)
+	for _, name := range userArguments {
+		if user, ok := args[name].(string); ok && user != "" {
+			return user
+		}
+	}
+	return ""
+}
+
+func rootFields(set ast.SelectionSet) []*ast.Field {
+	var fields []*ast.Field
+	for _, sel := range set {
+		switch s := sel.(type) {
+		case *ast.Field:
+			fields = append(fields, s)
+		case *ast.InlineFragment:
+			fields = append(fields, rootFields(s.SelectionSet)...)
+		case *ast.FragmentSpread:
+			if s.Definition != nil {
+				fields = append(fields, rootFields(s.Definition.SelectionSet)...)
+			}
+		}
+	}
+	return fields
+}
+
+func rateLimitedError(field string, retryAfter time.Duration) *gqlerror.Error {
+	seconds := int(math.Ceil(retryAfter.Seconds()))
+	err := gqlerror.Errorf("rate limit exceeded for %s, retry after %ds", field, seconds)
+	errcode.Set(err, ErrRateLimited)
+	err.Extensions["retryAfter"] = seconds
+	return err
+}
@@ -0,0 +1,54 @@
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+const sweepInterval = time.Minute
+
+// MemoryStore хранит bucket'ы в памяти процесса. Подходит для одной реплики.
+type MemoryStore struct {
+	mu        sync.Mutex
+	buckets   map[string]*memoryBucket
+	lastSweep time.Time
+}
+
+type memoryBucket struct {
+	Bucket
+	limit Limit
+}
+
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{buckets: make(map[string]*memoryBucket)}
+}
+
+func (s *MemoryStore) Take(ctx context.Context, key string, limit Limit, now time.Time) (bool, time.Duration, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.sweep(now)
+	b, ok := s.buckets[key]
+	if !ok {
+		b = &memoryBucket{Bucket: NewBucket(limit, now)}
+		s.buckets[key] = b
+	}
+	b.limit = limit
+	allowed, retryAfter := b.Take(limit, now)
+	return allowed, retryAfter, nil
+}
+
+// sweep удаляет bucket'ы, которые уже успели полностью восстановиться:
+// их состояние неотличимо от нового bucket'а.
+func (s *MemoryStore) sweep(now time.Time) {
+	if now.Sub(s.lastSweep) < sweepInterval {
+		return
+	}
+	s.lastSweep = now
+	for key, b := range s.buckets {
+		if now.Sub(b.UpdatedAt) >= b.limit.Per {
+			delete(s.buckets, key)
+		}
+	}
+}
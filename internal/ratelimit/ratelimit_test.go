@@ -0,0 +1,126 @@
+package ratelimit_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"hivemind/graph/generated"
+	"hivemind/graph/resolver"
+	"hivemind/internal/memory"
+	"hivemind/internal/ratelimit"
+
+	"github.com/99designs/gqlgen/client"
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRules(t *testing.T) {
+	t.Run("valid rules", func(t *testing.T) {
+		rules, err := ratelimit.ParseRules("createComment=10/1m, *=60/1h")
+		require.NoError(t, err)
+		assert.Equal(t, ratelimit.Limit{Requests: 10, Per: time.Minute}, rules["createComment"])
+		assert.Equal(t, ratelimit.Limit{Requests: 60, Per: time.Hour}, rules["*"])
+	})
+
+	t.Run("invalid rules", func(t *testing.T) {
+		for _, spec := range []string{"createComment", "createComment=10", "createComment=0/1m", "createComment=10/soon"} {
+			_, err := ratelimit.ParseRules(spec)
+			assert.Error(t, err, spec)
+		}
+	})
+}
+
+func TestMemoryStore(t *testing.T) {
+	ctx := context.Background()
+	store := ratelimit.NewMemoryStore()
+	limit := ratelimit.Limit{Requests: 2, Per: time.Minute}
+	now := time.Now()
+
+	for i := 0; i < 2; i++ {
+		allowed, _, err := store.Take(ctx, "user:alice", limit, now)
+		require.NoError(t, err)
+		assert.True(t, allowed)
+	}
+
+	allowed, retryAfter, err := store.Take(ctx, "user:alice", limit, now)
+	require.NoError(t, err)
+	assert.False(t, allowed)
+	assert.Equal(t, 30*time.Second, retryAfter)
+
+	allowed, _, _ = store.Take(ctx, "user:bob", limit, now)
+	assert.True(t, allowed, "buckets must be independent per key")
+
+	allowed, _, _ = store.Take(ctx, "user:alice", limit, now.Add(30*time.Second))
+	assert.True(t, allowed, "bucket must refill over time")
+}
+
+func TestLimiter(t *testing.T) {
+	res := resolver.NewResolver(memory.NewMemoryStorage())
+	srv := handler.New(generated.NewExecutableSchema(generated.Config{Resolvers: res}))
+	srv.AddTransport(transport.POST{})
+	srv.Use(ratelimit.New(ratelimit.NewMemoryStore(), ratelimit.Rules{
+		"createPost": {Requests: 1, Per: time.Minute},
+	}))
+	c := client.New(ratelimit.ClientIPMiddleware(false, srv), func(r *client.Request) {
+		r.HTTP.RemoteAddr = "10.0.0.1:5555"
+	})
+
+	const mutation = `mutation($author: String!) { createPost(title: "t", content: "c", author: $author) { id } }`
+	var resp struct{ CreatePost struct{ ID string } }
+
+	require.NoError(t, c.Post(mutation, &resp, client.Var("author", "alice")))
+
+	err := c.Post(mutation, &resp, client.Var("author", "alice"))
+	require.Error(t, err)
+	assert.True(t, strings.Contains(err.Error(), ratelimit.ErrRateLimited), err.Error())
+
+	err = c.Post(mutation, &resp, client.Var("author", "bob"))
+	require.Error(t, err, "the same client IP must be limited for another user")
+
+	t.Run("queries are not limited", func(t *testing.T) {
+		var posts struct{ Posts []struct{ ID string } }
+		for i := 0; i < 3; i++ {
+			require.NoError(t, c.Post(`query { posts { id } }`, &posts))
+		}
+	})
+}
+
+func TestClientIPMiddleware(t *testing.T) {
+	clientIP := func(trustProxy bool, header http.Header) string {
+		var ip string
+		h := ratelimit.ClientIPMiddleware(trustProxy, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ip = ratelimit.ClientIP(r.Context())
+		}))
+		req := httptest.NewRequest(http.MethodPost, "/query", nil)
+		req.RemoteAddr = "10.0.0.1:5555"
+		req.Header = header
+		h.ServeHTTP(httptest.NewRecorder(), req)
+		return ip
+	}
+
+	t.Run("header is ignored without proxy", func(t *testing.T) {
+		assert.Equal(t, "10.0.0.1", clientIP(false, http.Header{"X-Forwarded-For": {"203.0.113.7"}}))
+	})
+
+	t.Run("address added by proxy is used", func(t *testing.T) {
+		assert.Equal(t, "203.0.113.7", clientIP(true, http.Header{"X-Forwarded-For": {"203.0.113.7"}}))
+	})
+
+	t.Run("spoofed entries are ignored", func(t *testing.T) {
+		header := http.Header{"X-Forwarded-For": {"1.2.3.4, 5.6.7.8, 203.0.113.7"}}
+		assert.Equal(t, "203.0.113.7", clientIP(true, header))
+
+		header = http.Header{"X-Forwarded-For": {"1.2.3.4", "203.0.113.7"}}
+		assert.Equal(t, "203.0.113.7", clientIP(true, header))
+	})
+
+	t.Run("no header", func(t *testing.T) {
+		assert.Equal(t, "10.0.0.1", clientIP(true, http.Header{}))
+	})
+}
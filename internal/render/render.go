@@ -0,0 +1,67 @@
+package render
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"html"
+	"strings"
+
+	"hivemind/graph/model"
+
+	lru "github.com/hashicorp/golang-lru/v2"
+	"github.com/microcosm-cc/bluemonday"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/extension"
+)
+
+// Renderer превращает содержимое постов и комментариев в безопасный HTML.
+// Результат кешируется по хешу формата и текста, то есть по ревизии
+// содержимого: правка текста даёт новый ключ, а старая запись вытесняется LRU.
+type Renderer struct {
+	markdown goldmark.Markdown
+	policy   *bluemonday.Policy
+	cache    *lru.Cache[string, string]
+}
+
+func New(cacheSize int) *Renderer {
+	cache, err := lru.New[string, string](cacheSize)
+	if err != nil {
+		panic(err)
+	}
+	return &Renderer{
+		markdown: goldmark.New(goldmark.WithExtensions(extension.GFM)),
+		policy:   bluemonday.UGCPolicy(),
+		cache:    cache,
+	}
+}
+
+func (r *Renderer) Render(format model.ContentFormat, content string) (string, error) {
+	key := cacheKey(format, content)
+	if out, ok := r.cache.Get(key); ok {
+		return out, nil
+	}
+	var out string
+	switch format {
+	case model.ContentFormatMarkdown:
+		var buf bytes.Buffer
+		if err := r.markdown.Convert([]byte(content), &buf); err != nil {
+			return "", err
+		}
+		out = r.policy.Sanitize(buf.String())
+	default:
+		out = renderPlain(content)
+	}
+	r.cache.Add(key, out)
+	return out, nil
+}
+
+func renderPlain(content string) string {
+	escaped := html.EscapeString(content)
+	return "<p>" + strings.ReplaceAll(escaped, "\n", "<br>\n") + "</p>"
+}
+
+func cacheKey(format model.ContentFormat, content string) string {
+	sum := sha256.Sum256([]byte(content))
+	return string(format) + ":" + hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,49 @@
+package render_test
+
+import (
+	"testing"
+
+	"hivemind/graph/model"
+	"hivemind/internal/render"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRender(t *testing.T) {
+	r := render.New(16)
+
+	t.Run("plain text is escaped", func(t *testing.T) {
+		out, err := r.Render(model.ContentFormatPlain, "<b>hi</b>\nthere")
+		require.NoError(t, err)
+		assert.Equal(t, "<p>&lt;b&gt;hi&lt;/b&gt;<br>\nthere</p>", out)
+	})
+
+	t.Run("markdown is rendered", func(t *testing.T) {
+		out, err := r.Render(model.ContentFormatMarkdown, "**bold** and [link](https://example.com)")
+		require.NoError(t, err)
+		assert.Contains(t, out, "<strong>bold</strong>")
+		assert.Contains(t, out, `href="https://example.com"`)
+		assert.Contains(t, out, `rel="nofollow"`)
+	})
+
+	t.Run("unsafe html is stripped", func(t *testing.T) {
+		out, err := r.Render(model.ContentFormatMarkdown, "<script>alert(1)</script>[x](javascript:alert(1)) <img src=x onerror=alert(1)>")
+		require.NoError(t, err)
+		assert.NotContains(t, out, "<script")
+		assert.NotContains(t, out, "javascript:")
+		assert.NotContains(t, out, "onerror")
+	})
+
+	t.Run("same revision is served from cache", func(t *testing.T) {
+		first, err := r.Render(model.ContentFormatMarkdown, "# title")
+		require.NoError(t, err)
+		second, err := r.Render(model.ContentFormatMarkdown, "# title")
+		require.NoError(t, err)
+		assert.Equal(t, first, second)
+
+		plain, err := r.Render(model.ContentFormatPlain, "# title")
+		require.NoError(t, err)
+		assert.NotEqual(t, first, plain, "format is part of the revision")
+	})
+}
@@ -0,0 +1,69 @@
+// Package session определяет клиента между запросами, чтобы после записи его
+// чтения шли в основную базу. В отличие от IP, идентификатор сессии не
+// общий у клиентов за одним NAT или прокси.
+package session
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"net/http"
+	"strings"
+)
+
+// Header передаёт идентификатор сессии клиентам, которые не хранят cookie.
+const Header = "X-Session-ID"
+
+// CookieName — cookie с идентификатором сессии для браузеров.
+const CookieName = "hivemind_session"
+
+// maxIDLength ограничивает длину принятого от клиента идентификатора.
+const maxIDLength = 128
+
+type idKey struct{}
+
+// ID возвращает идентификатор сессии, сохранённый Middleware.
+func ID(ctx context.Context) string {
+	id, _ := ctx.Value(idKey{}).(string)
+	return id
+}
+
+// Middleware берёт идентификатор сессии из заголовка X-Session-ID или cookie,
+// а если его нет — создаёт новый и выставляет cookie. Идентификатор
+// возвращается в заголовке, чтобы клиент без cookie мог прислать его снова.
+func Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := r.Header.Get(Header)
+		if !validID(id) {
+			id = ""
+			if c, err := r.Cookie(CookieName); err == nil && validID(c.Value) {
+				id = c.Value
+			}
+		}
+		if id == "" {
+			id = newID()
+			http.SetCookie(w, &http.Cookie{
+				Name:     CookieName,
+				Value:    id,
+				Path:     "/",
+				HttpOnly: true,
+				SameSite: http.SameSiteLaxMode,
+			})
+		}
+		w.Header().Set(Header, id)
+		next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), idKey{}, id)))
+	})
+}
+
+func validID(id string) bool {
+	if id == "" || len(id) > maxIDLength {
+		return false
+	}
+	return !strings.ContainsFunc(id, func(r rune) bool { return r <= ' ' || r > '~' || r == ';' || r == '"' })
+}
+
+func newID() string {
+	b := make([]byte, 16)
+	rand.Read(b)
+	return hex.EncodeToString(b)
+}
@@ -0,0 +1,64 @@
+package session_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"hivemind/internal/session"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func serve(r *http.Request) (string, *httptest.ResponseRecorder) {
+	var id string
+	h := session.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id = session.ID(r.Context())
+	}))
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, r)
+	return id, rec
+}
+
+func TestMiddleware(t *testing.T) {
+	t.Run("new session sets a cookie", func(t *testing.T) {
+		id, rec := serve(httptest.NewRequest(http.MethodPost, "/query", nil))
+		require.NotEmpty(t, id)
+		assert.Equal(t, id, rec.Header().Get(session.Header))
+		cookies := rec.Result().Cookies()
+		require.Len(t, cookies, 1)
+		assert.Equal(t, session.CookieName, cookies[0].Name)
+		assert.Equal(t, id, cookies[0].Value)
+	})
+
+	t.Run("cookie is reused", func(t *testing.T) {
+		r := httptest.NewRequest(http.MethodPost, "/query", nil)
+		r.AddCookie(&http.Cookie{Name: session.CookieName, Value: "abc"})
+		id, rec := serve(r)
+		assert.Equal(t, "abc", id)
+		assert.Empty(t, rec.Result().Cookies())
+	})
+
+	t.Run("header takes precedence", func(t *testing.T) {
+		r := httptest.NewRequest(http.MethodPost, "/query", nil)
+		r.Header.Set(session.Header, "from-header")
+		r.AddCookie(&http.Cookie{Name: session.CookieName, Value: "abc"})
+		id, _ := serve(r)
+		assert.Equal(t, "from-header", id)
+	})
+
+	t.Run("clients behind one address get different sessions", func(t *testing.T) {
+		first, _ := serve(httptest.NewRequest(http.MethodPost, "/query", nil))
+		second, _ := serve(httptest.NewRequest(http.MethodPost, "/query", nil))
+		assert.NotEqual(t, first, second)
+	})
+
+	t.Run("invalid header is replaced", func(t *testing.T) {
+		r := httptest.NewRequest(http.MethodPost, "/query", nil)
+		r.Header.Set(session.Header, "bad value")
+		id, _ := serve(r)
+		assert.NotEqual(t, "bad value", id)
+		assert.NotEmpty(t, id)
+	})
+}
@@ -0,0 +1,232 @@
+// Package sse — транспорт gqlgen по протоколу GraphQL over Server-Sent Events
+// (режим отдельных соединений) для клиентов, у которых не работают WebSocket.
+//
+// Каждое событие подписки может нести id. При переподключении браузер
+// присылает его в заголовке Last-Event-ID, и резолвер продолжает поток с
+// этого места.
+package sse
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+type Transport struct {
+	// Heartbeat — интервал комментариев-пингов, не дающих прокси закрыть
+	// простаивающее соединение. Ноль отключает пинги.
+	Heartbeat time.Duration
+}
+
+var _ graphql.Transport = Transport{}
+
+func (t Transport) Supports(r *http.Request) bool {
+	if !strings.Contains(r.Header.Get("Accept"), "text/event-stream") {
+		return false
+	}
+	switch r.Method {
+	case http.MethodGet:
+		return true
+	case http.MethodPost:
+		mediaType, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+		return err == nil && mediaType == "application/json"
+	}
+	return false
+}
+
+func (t Transport) Do(w http.ResponseWriter, r *http.Request, exec graphql.GraphExecutor) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming unsupported", http.StatusInternalServerError)
+		return
+	}
+	start := graphql.Now()
+	params, err := readParams(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, gqlerror.Errorf("%s", err))
+		return
+	}
+	params.Headers = r.Header
+	params.ReadTime = graphql.TraceTiming{Start: start, End: graphql.Now()}
+
+	ids := &EventIDs{}
+	ctx := context.WithValue(r.Context(), eventIDsKey{}, ids)
+	if last := r.Header.Get("Last-Event-ID"); last != "" {
+		ctx = context.WithValue(ctx, lastEventIDKey{}, last)
+	}
+
+	opCtx, opErr := exec.CreateOperationContext(ctx, params)
+	// GET-запрос может отправить чужой сайт через EventSource, поэтому по
+	// GET разрешены только подписки, которые ничего не меняют.
+	if opErr == nil && r.Method == http.MethodGet && opCtx.Operation.Operation != ast.Subscription {
+		w.Header().Set("Allow", http.MethodPost)
+		writeError(w, http.StatusMethodNotAllowed, gqlerror.Errorf("GET requests only allow subscription operations"))
+		return
+	}
+
+	h := w.Header()
+	h.Set("Content-Type", "text/event-stream")
+	h.Set("Cache-Control", "no-cache")
+	h.Set("Connection", "keep-alive")
+	h.Set("X-Accel-Buffering", "no")
+	s := &stream{w: w, f: flusher}
+	s.comment("")
+
+	if t.Heartbeat > 0 {
+		hbCtx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		go s.heartbeat(hbCtx, t.Heartbeat)
+	}
+
+	if opErr != nil {
+		s.next("", exec.DispatchError(graphql.WithOperationContext(ctx, opCtx), opErr))
+	} else {
+		responses, ctx := exec.DispatchOperation(graphql.WithOperationContext(ctx, opCtx), opCtx)
+		for {
+			resp := responses(ctx)
+			if resp == nil {
+				break
+			}
+			id := ""
+			if resp.Data != nil {
+				id = ids.pop()
+			}
+			s.next(id, resp)
+		}
+	}
+	s.event("complete", "", "")
+}
+
+func writeError(w http.ResponseWriter, status int, err *gqlerror.Error) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(&graphql.Response{Errors: gqlerror.List{err}})
+}
+
+func readParams(r *http.Request) (*graphql.RawParams, error) {
+	params := &graphql.RawParams{}
+	if r.Method == http.MethodPost {
+		dec := json.NewDecoder(r.Body)
+		dec.UseNumber()
+		if err := dec.Decode(params); err != nil {
+			return nil, fmt.Errorf("json request body could not be decoded: %w", err)
+		}
+		return params, nil
+	}
+
+	query, err := url.ParseQuery(r.URL.RawQuery)
+	if err != nil {
+		return nil, err
+	}
+	params.Query = query.Get("query")
+	params.OperationName = query.Get("operationName")
+	for name, dst := range map[string]any{"variables": &params.Variables, "extensions": &params.Extensions} {
+		if v := query.Get(name); v != "" {
+			dec := json.NewDecoder(strings.NewReader(v))
+			dec.UseNumber()
+			if err := dec.Decode(dst); err != nil {
+				return nil, fmt.Errorf("%s could not be decoded", name)
+			}
+		}
+	}
+	return params, nil
+}
+
+// stream сериализует запись событий и пингов в одно соединение.
+type stream struct {
+	mu sync.Mutex
+	w  io.Writer
+	f  http.Flusher
+}
+
+func (s *stream) next(id string, resp *graphql.Response) {
+	data, err := json.Marshal(resp)
+	if err != nil {
+		data, _ = json.Marshal(&graphql.Response{Errors: gqlerror.List{gqlerror.Errorf("failed to encode response")}})
+	}
+	s.event("next", id, string(data))
+}
+
+func (s *stream) event(name, id, data string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	fmt.Fprintf(s.w, "event: %s\n", name)
+	if id != "" {
+		fmt.Fprintf(s.w, "id: %s\n", id)
+	}
+	fmt.Fprintf(s.w, "data: %s\n\n", data)
+	s.f.Flush()
+}
+
+func (s *stream) comment(text string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	fmt.Fprintf(s.w, ":%s\n\n", text)
+	s.f.Flush()
+}
+
+func (s *stream) heartbeat(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.comment(" ping")
+		}
+	}
+}
+
+type (
+	eventIDsKey    struct{}
+	lastEventIDKey struct{}
+)
+
+// EventIDs — очередь id событий подписки. Резолвер кладёт id перед отправкой
+// значения в канал, транспорт забирает его при записи ответа с данными.
+type EventIDs struct {
+	mu  sync.Mutex
+	ids []string
+}
+
+// FromContext возвращает очередь id, если подписка идёт через SSE.
+func FromContext(ctx context.Context) *EventIDs {
+	ids, _ := ctx.Value(eventIDsKey{}).(*EventIDs)
+	return ids
+}
+
+// LastEventID возвращает id последнего события, полученного клиентом до
+// переподключения.
+func LastEventID(ctx context.Context) string {
+	id, _ := ctx.Value(lastEventIDKey{}).(string)
+	return id
+}
+
+func (e *EventIDs) Push(id string) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.ids = append(e.ids, id)
+}
+
+func (e *EventIDs) pop() string {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if len(e.ids) == 0 {
+		return ""
+	}
+	id := e.ids[0]
+	e.ids = e.ids[1:]
+	return id
+}
@@ -0,0 +1,194 @@
+package sse_test
+
+import (
+	"bufio"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"hivemind/graph/generated"
+	"hivemind/graph/model"
+	"hivemind/graph/resolver"
+	"hivemind/internal/sse"
+	"hivemind/internal/storage"
+	"hivemind/internal/storage/mocks"
+
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type event struct {
+	name, id, data string
+}
+
+func newServer(t *testing.T, res *resolver.Resolver, heartbeat time.Duration) *httptest.Server {
+	srv := handler.New(generated.NewExecutableSchema(generated.Config{Resolvers: res}))
+	srv.AddTransport(sse.Transport{Heartbeat: heartbeat})
+	ts := httptest.NewServer(srv)
+	t.Cleanup(ts.Close)
+	return ts
+}
+
+// open подключается так же, как браузерный EventSource, и возвращает канал
+// разобранных событий и комментариев-пингов.
+func open(t *testing.T, ts *httptest.Server, query, lastEventID string) <-chan event {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, ts.URL+"?query="+url.QueryEscape(query), nil)
+	require.NoError(t, err)
+	req.Header.Set("Accept", "text/event-stream")
+	if lastEventID != "" {
+		req.Header.Set("Last-Event-ID", lastEventID)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	require.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+
+	events := make(chan event, 16)
+	go func() {
+		defer resp.Body.Close()
+		defer close(events)
+		var ev event
+		scanner := bufio.NewScanner(resp.Body)
+		for scanner.Scan() {
+			line := scanner.Text()
+			switch {
+			case line == "":
+				if ev != (event{}) {
+					events <- ev
+				}
+				ev = event{}
+			case strings.HasPrefix(line, ":"):
+				ev.name = "comment"
+				ev.data = strings.TrimSpace(line[1:])
+			case strings.HasPrefix(line, "event: "):
+				ev.name = line[len("event: "):]
+			case strings.HasPrefix(line, "id: "):
+				ev.id = line[len("id: "):]
+			case strings.HasPrefix(line, "data: "):
+				ev.data = line[len("data: "):]
+			}
+		}
+	}()
+	return events
+}
+
+func nextEvent(t *testing.T, events <-chan event, name string) event {
+	t.Helper()
+	timeout := time.After(2 * time.Second)
+	for {
+		select {
+		case ev, ok := <-events:
+			if !ok {
+				t.Fatalf("stream closed while waiting for %q", name)
+			}
+			if ev.name == name {
+				return ev
+			}
+		case <-timeout:
+			t.Fatalf("timeout waiting for %q", name)
+		}
+	}
+}
+
+func TestSubscriptionOverSSE(t *testing.T) {
+	mockStorage := mocks.NewStorageMock(t)
+	mockStorage.GetPostWithVisibilityMock.Return(&model.Post{ID: "post123"}, storage.VisibilityPublic, nil)
+	res := resolver.NewResolver(mockStorage)
+	ts := newServer(t, res, 0)
+	events := open(t, ts, `subscription { commentAdded(postId: "post123") { id } }`, "")
+	nextEvent(t, events, "comment")
+
+	comment := &model.Comment{ID: "c1", PostID: "post123", Author: "alice", CreatedAt: time.Now()}
+	require.Eventually(t, func() bool { return res.DeliveryStats().Subscribers == 1 }, time.Second, 10*time.Millisecond)
+	res.NotifySubscribers("post123", comment)
+
+	ev := nextEvent(t, events, "next")
+	assert.Equal(t, res.CommentCursor(comment), ev.id)
+	assert.JSONEq(t, `{"data":{"commentAdded":{"id":"c1"}}}`, ev.data)
+}
+
+func TestCompleteOnDrain(t *testing.T) {
+	res := resolver.NewResolver(mocks.NewStorageMock(t))
+	ts := newServer(t, res, 0)
+	events := open(t, ts, `subscription { postCreated { id } }`, "")
+	require.Eventually(t, func() bool { return res.DeliveryStats().Subscribers == 1 }, time.Second, 10*time.Millisecond)
+
+	res.Drain()
+	nextEvent(t, events, "complete")
+}
+
+func TestResumeWithLastEventID(t *testing.T) {
+	seen := &model.Comment{ID: "c1", PostID: "post123", CreatedAt: time.Now()}
+	missed := &model.Comment{ID: "c2", PostID: "post123", CreatedAt: seen.CreatedAt.Add(time.Second)}
+	mockStorage := mocks.NewStorageMock(t)
+	mockStorage.GetPostWithVisibilityMock.Return(&model.Post{ID: "post123"}, storage.VisibilityPublic, nil)
+	mockStorage.GetCommentByIDMock.Expect(minimock.AnyContext, "c1").Return(seen, nil)
+	mockStorage.GetCommentsSinceMock.Expect(minimock.AnyContext, "post123", seen.CreatedAt, "c1", 100).Return([]*model.Comment{missed}, nil)
+
+	res := resolver.NewResolver(mockStorage)
+	ts := newServer(t, res, 0)
+	events := open(t, ts, `subscription { commentAdded(postId: "post123") { id } }`, res.CommentCursor(seen))
+
+	ev := nextEvent(t, events, "next")
+	assert.Equal(t, res.CommentCursor(missed), ev.id)
+	assert.JSONEq(t, `{"data":{"commentAdded":{"id":"c2"}}}`, ev.data)
+}
+
+func TestHeartbeat(t *testing.T) {
+	res := resolver.NewResolver(mocks.NewStorageMock(t))
+	ts := newServer(t, res, 20*time.Millisecond)
+	events := open(t, ts, `subscription { postCreated { id } }`, "")
+
+	for {
+		if ev := nextEvent(t, events, "comment"); ev.data == "ping" {
+			return
+		}
+	}
+}
+
+func TestQueryOverSSE(t *testing.T) {
+	mockStorage := mocks.NewStorageMock(t)
+	mockStorage.GetPostsMock.Return([]*model.Post{}, nil)
+	ts := newServer(t, resolver.NewResolver(mockStorage), 0)
+
+	req, err := http.NewRequest(http.MethodPost, ts.URL, strings.NewReader(`{"query":"{ posts { id } }"}`))
+	require.NoError(t, err)
+	req.Header.Set("Accept", "text/event-stream")
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	var body strings.Builder
+	scanner := bufio.NewScanner(resp.Body)
+	for scanner.Scan() {
+		body.WriteString(scanner.Text() + "\n")
+	}
+	assert.Contains(t, body.String(), "event: next\ndata: {\"data\":{\"posts\":[]}}\n")
+	assert.Contains(t, body.String(), "event: complete\n")
+}
+
+func TestGETAllowsOnlySubscriptions(t *testing.T) {
+	ts := newServer(t, resolver.NewResolver(mocks.NewStorageMock(t)), 0)
+
+	for _, query := range []string{
+		`mutation { createPost(title: "t", content: "c", author: "mallory") { id } }`,
+		`{ posts { id } }`,
+	} {
+		req, err := http.NewRequest(http.MethodGet, ts.URL+"?query="+url.QueryEscape(query), nil)
+		require.NoError(t, err)
+		req.Header.Set("Accept", "text/event-stream")
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		resp.Body.Close()
+		assert.Equal(t, http.StatusMethodNotAllowed, resp.StatusCode, query)
+		assert.Equal(t, http.MethodPost, resp.Header.Get("Allow"))
+	}
+}
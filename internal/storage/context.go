@@ -0,0 +1,16 @@
+package storage
+
+import "context"
+
+type primaryKey struct{}
+
+// WithPrimary просит читать в обход кеша и реплик из основной базы: для
+// проверок перед записью и для данных, которые потом долго хранятся в кеше.
+func WithPrimary(ctx context.Context) context.Context {
+	return context.WithValue(ctx, primaryKey{}, true)
+}
+
+// PrimaryRequested сообщает, что чтение нужно выполнить в основной базе.
+func PrimaryRequested(ctx context.Context) bool {
+	return ctx.Value(primaryKey{}) != nil
+}
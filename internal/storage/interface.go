@@ -2,21 +2,95 @@ package storage
 
 import (
 	"context"
+	"errors"
+	"time"
 
 	"hivemind/graph/model"
 )
 
+// Visibility определяет, попадает ли запись в общие списки.
+type Visibility string
+
+const (
+	VisibilityPublic Visibility = "public"
+	// VisibilityHeld — запись ждёт решения модератора.
+	VisibilityHeld Visibility = "held"
+	// VisibilityShadowHidden — запись скрыта из списков, но автор, знающий её ID,
+	// продолжает её видеть и не узнаёт о скрытии.
+	VisibilityShadowHidden Visibility = "shadow_hidden"
+)
+
+// ErrReportResolved возвращает UpdateReportStatus, если жалобу уже закрыл
+// другой модератор.
+var ErrReportResolved = errors.New("report is already resolved")
+
+// IdempotencyKey связывает clientMutationId мутации с созданным ею объектом.
+type IdempotencyKey struct {
+	Key string
+	// Fingerprint — хеш аргументов мутации. Повтор с тем же ключом, но
+	// другими аргументами считается ошибкой клиента.
+	Fingerprint string
+	ResultID    string
+	// Completed отмечает, что объект ResultID создан. До этого ключ живёт
+	// недолго, чтобы после сбоя посреди мутации повтор мог его перехватить.
+	Completed bool
+	ExpiresAt time.Time
+}
+
 //go:generate minimock -i hivemind/internal/storage.Storage -o ./mocks -s "_mock.go"
 
 type Storage interface {
+	// Ping проверяет, что хранилище доступно.
+	Ping(ctx context.Context) error
+
 	// Post
-	CreatePost(ctx context.Context, post *model.Post) error
+	CreatePost(ctx context.Context, post *model.Post, visibility Visibility) error
 	GetPosts(ctx context.Context) ([]*model.Post, error)
 	GetPostByID(ctx context.Context, id string) (*model.Post, error)
+	// GetPostWithVisibility возвращает пост вместе с его видимостью.
+	GetPostWithVisibility(ctx context.Context, id string) (*model.Post, Visibility, error)
 	ToggleComments(ctx context.Context, postID string, enabled bool, author string) (*model.Post, error)
+	RemovePost(ctx context.Context, id string) error
+	// PublishPost открывает пост, задержанный для модерации. Скрытые иначе
+	// посты не меняются.
+	PublishPost(ctx context.Context, id string) error
 
 	// Comment
-	CreateComment(ctx context.Context, comment *model.Comment) error
+	CreateComment(ctx context.Context, comment *model.Comment, visibility Visibility) error
+	GetCommentByID(ctx context.Context, id string) (*model.Comment, error)
 	GetCommentsByPostID(ctx context.Context, postID string, limit, offset int) ([]*model.Comment, error)
 	GetReplies(ctx context.Context, parentID string, limit, offset int) ([]*model.Comment, error)
+	// GetCommentsSince возвращает комментарии поста любой вложенности,
+	// упорядоченные по (created_at, id) и идущие после (since, afterID).
+	GetCommentsSince(ctx context.Context, postID string, since time.Time, afterID string, limit int) ([]*model.Comment, error)
+	// UpdateComment меняет текст комментария и возвращает его вместе с видимостью.
+	UpdateComment(ctx context.Context, id, content string, editedAt time.Time) (*model.Comment, Visibility, error)
+	RemoveComment(ctx context.Context, id string) error
+	// PublishComment открывает комментарий, задержанный для модерации.
+	PublishComment(ctx context.Context, id string) error
+
+	// Report
+	CreateReport(ctx context.Context, report *model.Report) error
+	GetReportByID(ctx context.Context, id string) (*model.Report, error)
+	GetReports(ctx context.Context, status model.ReportStatus, limit int, afterID string) ([]*model.Report, error)
+	// UpdateReportStatus закрывает открытую жалобу; для уже закрытой
+	// возвращает ErrReportResolved.
+	UpdateReportStatus(ctx context.Context, id string, change *model.ReportStatusChange) (*model.Report, error)
+	// ReopenReport возвращает жалобу из статуса from в OPEN, если действие
+	// модератора по ней не выполнилось.
+	ReopenReport(ctx context.Context, id string, from model.ReportStatus, change *model.ReportStatusChange) error
+
+	// Audit
+	CreateAuditEntry(ctx context.Context, entry *model.AuditEntry) error
+	GetAuditEntries(ctx context.Context, filter model.AuditLogFilter, limit int, afterID string) ([]*model.AuditEntry, error)
+
+	// Idempotency
+	// ClaimIdempotencyKey сохраняет ключ, если он свободен или истёк, и
+	// возвращает действующую запись: переданную или сохранённую раньше.
+	ClaimIdempotencyKey(ctx context.Context, key *IdempotencyKey) (*IdempotencyKey, error)
+	// CompleteIdempotencyKey отмечает мутацию завершённой и продлевает ключ
+	// до expiresAt, если он всё ещё закреплён за resultID.
+	CompleteIdempotencyKey(ctx context.Context, key, resultID string, expiresAt time.Time) error
+	// ReleaseIdempotencyKey освобождает ключ, если он всё ещё закреплён за resultID.
+	ReleaseIdempotencyKey(ctx context.Context, key, resultID string) error
 }
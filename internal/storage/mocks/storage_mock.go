@@ -7,8 +7,10 @@ package mocks
 import (
 	"context"
 	"hivemind/graph/model"
+	mm_storage "hivemind/internal/storage"
 	"sync"
 	mm_atomic "sync/atomic"
+	"time"
 	mm_time "time"
 
 	"github.com/gojuno/minimock/v3"
@@ -19,20 +21,62 @@ type StorageMock struct {
 	t          minimock.Tester
 	finishOnce sync.Once
 
-	funcCreateComment          func(ctx context.Context, comment *model.Comment) (err error)
+	funcClaimIdempotencyKey          func(ctx context.Context, key *mm_storage.IdempotencyKey) (ip1 *mm_storage.IdempotencyKey, err error)
+	funcClaimIdempotencyKeyOrigin    string
+	inspectFuncClaimIdempotencyKey   func(ctx context.Context, key *mm_storage.IdempotencyKey)
+	afterClaimIdempotencyKeyCounter  uint64
+	beforeClaimIdempotencyKeyCounter uint64
+	ClaimIdempotencyKeyMock          mStorageMockClaimIdempotencyKey
+
+	funcCompleteIdempotencyKey          func(ctx context.Context, key string, resultID string, expiresAt time.Time) (err error)
+	funcCompleteIdempotencyKeyOrigin    string
+	inspectFuncCompleteIdempotencyKey   func(ctx context.Context, key string, resultID string, expiresAt time.Time)
+	afterCompleteIdempotencyKeyCounter  uint64
+	beforeCompleteIdempotencyKeyCounter uint64
+	CompleteIdempotencyKeyMock          mStorageMockCompleteIdempotencyKey
+
+	funcCreateAuditEntry          func(ctx context.Context, entry *model.AuditEntry) (err error)
+	funcCreateAuditEntryOrigin    string
+	inspectFuncCreateAuditEntry   func(ctx context.Context, entry *model.AuditEntry)
+	afterCreateAuditEntryCounter  uint64
+	beforeCreateAuditEntryCounter uint64
+	CreateAuditEntryMock          mStorageMockCreateAuditEntry
+
+	funcCreateComment          func(ctx context.Context, comment *model.Comment, visibility mm_storage.Visibility) (err error)
 	funcCreateCommentOrigin    string
-	inspectFuncCreateComment   func(ctx context.Context, comment *model.Comment)
+	inspectFuncCreateComment   func(ctx context.Context, comment *model.Comment, visibility mm_storage.Visibility)
 	afterCreateCommentCounter  uint64
 	beforeCreateCommentCounter uint64
 	CreateCommentMock          mStorageMockCreateComment
 
-	funcCreatePost          func(ctx context.Context, post *model.Post) (err error)
+	funcCreatePost          func(ctx context.Context, post *model.Post, visibility mm_storage.Visibility) (err error)
 	funcCreatePostOrigin    string
-	inspectFuncCreatePost   func(ctx context.Context, post *model.Post)
+	inspectFuncCreatePost   func(ctx context.Context, post *model.Post, visibility mm_storage.Visibility)
 	afterCreatePostCounter  uint64
 	beforeCreatePostCounter uint64
 	CreatePostMock          mStorageMockCreatePost
 
+	funcCreateReport          func(ctx context.Context, report *model.Report) (err error)
+	funcCreateReportOrigin    string
+	inspectFuncCreateReport   func(ctx context.Context, report *model.Report)
+	afterCreateReportCounter  uint64
+	beforeCreateReportCounter uint64
+	CreateReportMock          mStorageMockCreateReport
+
+	funcGetAuditEntries          func(ctx context.Context, filter model.AuditLogFilter, limit int, afterID string) (apa1 []*model.AuditEntry, err error)
+	funcGetAuditEntriesOrigin    string
+	inspectFuncGetAuditEntries   func(ctx context.Context, filter model.AuditLogFilter, limit int, afterID string)
+	afterGetAuditEntriesCounter  uint64
+	beforeGetAuditEntriesCounter uint64
+	GetAuditEntriesMock          mStorageMockGetAuditEntries
+
+	funcGetCommentByID          func(ctx context.Context, id string) (cp1 *model.Comment, err error)
+	funcGetCommentByIDOrigin    string
+	inspectFuncGetCommentByID   func(ctx context.Context, id string)
+	afterGetCommentByIDCounter  uint64
+	beforeGetCommentByIDCounter uint64
+	GetCommentByIDMock          mStorageMockGetCommentByID
+
 	funcGetCommentsByPostID          func(ctx context.Context, postID string, limit int, offset int) (cpa1 []*model.Comment, err error)
 	funcGetCommentsByPostIDOrigin    string
 	inspectFuncGetCommentsByPostID   func(ctx context.Context, postID string, limit int, offset int)
@@ -40,6 +84,13 @@ type StorageMock struct {
 	beforeGetCommentsByPostIDCounter uint64
 	GetCommentsByPostIDMock          mStorageMockGetCommentsByPostID
 
+	funcGetCommentsSince          func(ctx context.Context, postID string, since time.Time, afterID string, limit int) (cpa1 []*model.Comment, err error)
+	funcGetCommentsSinceOrigin    string
+	inspectFuncGetCommentsSince   func(ctx context.Context, postID string, since time.Time, afterID string, limit int)
+	afterGetCommentsSinceCounter  uint64
+	beforeGetCommentsSinceCounter uint64
+	GetCommentsSinceMock          mStorageMockGetCommentsSince
+
 	funcGetPostByID          func(ctx context.Context, id string) (pp1 *model.Post, err error)
 	funcGetPostByIDOrigin    string
 	inspectFuncGetPostByID   func(ctx context.Context, id string)
@@ -47,6 +98,13 @@ type StorageMock struct {
 	beforeGetPostByIDCounter uint64
 	GetPostByIDMock          mStorageMockGetPostByID
 
+	funcGetPostWithVisibility          func(ctx context.Context, id string) (pp1 *model.Post, v1 mm_storage.Visibility, err error)
+	funcGetPostWithVisibilityOrigin    string
+	inspectFuncGetPostWithVisibility   func(ctx context.Context, id string)
+	afterGetPostWithVisibilityCounter  uint64
+	beforeGetPostWithVisibilityCounter uint64
+	GetPostWithVisibilityMock          mStorageMockGetPostWithVisibility
+
 	funcGetPosts          func(ctx context.Context) (ppa1 []*model.Post, err error)
 	funcGetPostsOrigin    string
 	inspectFuncGetPosts   func(ctx context.Context)
@@ -61,12 +119,89 @@ type StorageMock struct {
 	beforeGetRepliesCounter uint64
 	GetRepliesMock          mStorageMockGetReplies
 
+	funcGetReportByID          func(ctx context.Context, id string) (rp1 *model.Report, err error)
+	funcGetReportByIDOrigin    string
+	inspectFuncGetReportByID   func(ctx context.Context, id string)
+	afterGetReportByIDCounter  uint64
+	beforeGetReportByIDCounter uint64
+	GetReportByIDMock          mStorageMockGetReportByID
+
+	funcGetReports          func(ctx context.Context, status model.ReportStatus, limit int, afterID string) (rpa1 []*model.Report, err error)
+	funcGetReportsOrigin    string
+	inspectFuncGetReports   func(ctx context.Context, status model.ReportStatus, limit int, afterID string)
+	afterGetReportsCounter  uint64
+	beforeGetReportsCounter uint64
+	GetReportsMock          mStorageMockGetReports
+
+	funcPing          func(ctx context.Context) (err error)
+	funcPingOrigin    string
+	inspectFuncPing   func(ctx context.Context)
+	afterPingCounter  uint64
+	beforePingCounter uint64
+	PingMock          mStorageMockPing
+
+	funcPublishComment          func(ctx context.Context, id string) (err error)
+	funcPublishCommentOrigin    string
+	inspectFuncPublishComment   func(ctx context.Context, id string)
+	afterPublishCommentCounter  uint64
+	beforePublishCommentCounter uint64
+	PublishCommentMock          mStorageMockPublishComment
+
+	funcPublishPost          func(ctx context.Context, id string) (err error)
+	funcPublishPostOrigin    string
+	inspectFuncPublishPost   func(ctx context.Context, id string)
+	afterPublishPostCounter  uint64
+	beforePublishPostCounter uint64
+	PublishPostMock          mStorageMockPublishPost
+
+	funcReleaseIdempotencyKey          func(ctx context.Context, key string, resultID string) (err error)
+	funcReleaseIdempotencyKeyOrigin    string
+	inspectFuncReleaseIdempotencyKey   func(ctx context.Context, key string, resultID string)
+	afterReleaseIdempotencyKeyCounter  uint64
+	beforeReleaseIdempotencyKeyCounter uint64
+	ReleaseIdempotencyKeyMock          mStorageMockReleaseIdempotencyKey
+
+	funcRemoveComment          func(ctx context.Context, id string) (err error)
+	funcRemoveCommentOrigin    string
+	inspectFuncRemoveComment   func(ctx context.Context, id string)
+	afterRemoveCommentCounter  uint64
+	beforeRemoveCommentCounter uint64
+	RemoveCommentMock          mStorageMockRemoveComment
+
+	funcRemovePost          func(ctx context.Context, id string) (err error)
+	funcRemovePostOrigin    string
+	inspectFuncRemovePost   func(ctx context.Context, id string)
+	afterRemovePostCounter  uint64
+	beforeRemovePostCounter uint64
+	RemovePostMock          mStorageMockRemovePost
+
+	funcReopenReport          func(ctx context.Context, id string, from model.ReportStatus, change *model.ReportStatusChange) (err error)
+	funcReopenReportOrigin    string
+	inspectFuncReopenReport   func(ctx context.Context, id string, from model.ReportStatus, change *model.ReportStatusChange)
+	afterReopenReportCounter  uint64
+	beforeReopenReportCounter uint64
+	ReopenReportMock          mStorageMockReopenReport
+
 	funcToggleComments          func(ctx context.Context, postID string, enabled bool, author string) (pp1 *model.Post, err error)
 	funcToggleCommentsOrigin    string
 	inspectFuncToggleComments   func(ctx context.Context, postID string, enabled bool, author string)
 	afterToggleCommentsCounter  uint64
 	beforeToggleCommentsCounter uint64
 	ToggleCommentsMock          mStorageMockToggleComments
+
+	funcUpdateComment          func(ctx context.Context, id string, content string, editedAt time.Time) (cp1 *model.Comment, v1 mm_storage.Visibility, err error)
+	funcUpdateCommentOrigin    string
+	inspectFuncUpdateComment   func(ctx context.Context, id string, content string, editedAt time.Time)
+	afterUpdateCommentCounter  uint64
+	beforeUpdateCommentCounter uint64
+	UpdateCommentMock          mStorageMockUpdateComment
+
+	funcUpdateReportStatus          func(ctx context.Context, id string, change *model.ReportStatusChange) (rp1 *model.Report, err error)
+	funcUpdateReportStatusOrigin    string
+	inspectFuncUpdateReportStatus   func(ctx context.Context, id string, change *model.ReportStatusChange)
+	afterUpdateReportStatusCounter  uint64
+	beforeUpdateReportStatusCounter uint64
+	UpdateReportStatusMock          mStorageMockUpdateReportStatus
 }
 
 // NewStorageMock returns a mock for mm_storage.Storage
@@ -77,78 +212,136 @@ func NewStorageMock(t minimock.Tester) *StorageMock {
 		controller.RegisterMocker(m)
 	}
 
+	m.ClaimIdempotencyKeyMock = mStorageMockClaimIdempotencyKey{mock: m}
+	m.ClaimIdempotencyKeyMock.callArgs = []*StorageMockClaimIdempotencyKeyParams{}
+
+	m.CompleteIdempotencyKeyMock = mStorageMockCompleteIdempotencyKey{mock: m}
+	m.CompleteIdempotencyKeyMock.callArgs = []*StorageMockCompleteIdempotencyKeyParams{}
+
+	m.CreateAuditEntryMock = mStorageMockCreateAuditEntry{mock: m}
+	m.CreateAuditEntryMock.callArgs = []*StorageMockCreateAuditEntryParams{}
+
 	m.CreateCommentMock = mStorageMockCreateComment{mock: m}
 	m.CreateCommentMock.callArgs = []*StorageMockCreateCommentParams{}
 
 	m.CreatePostMock = mStorageMockCreatePost{mock: m}
 	m.CreatePostMock.callArgs = []*StorageMockCreatePostParams{}
 
+	m.CreateReportMock = mStorageMockCreateReport{mock: m}
+	m.CreateReportMock.callArgs = []*StorageMockCreateReportParams{}
+
+	m.GetAuditEntriesMock = mStorageMockGetAuditEntries{mock: m}
+	m.GetAuditEntriesMock.callArgs = []*StorageMockGetAuditEntriesParams{}
+
+	m.GetCommentByIDMock = mStorageMockGetCommentByID{mock: m}
+	m.GetCommentByIDMock.callArgs = []*StorageMockGetCommentByIDParams{}
+
 	m.GetCommentsByPostIDMock = mStorageMockGetCommentsByPostID{mock: m}
 	m.GetCommentsByPostIDMock.callArgs = []*StorageMockGetCommentsByPostIDParams{}
 
+	m.GetCommentsSinceMock = mStorageMockGetCommentsSince{mock: m}
+	m.GetCommentsSinceMock.callArgs = []*StorageMockGetCommentsSinceParams{}
+
 	m.GetPostByIDMock = mStorageMockGetPostByID{mock: m}
 	m.GetPostByIDMock.callArgs = []*StorageMockGetPostByIDParams{}
 
+	m.GetPostWithVisibilityMock = mStorageMockGetPostWithVisibility{mock: m}
+	m.GetPostWithVisibilityMock.callArgs = []*StorageMockGetPostWithVisibilityParams{}
+
 	m.GetPostsMock = mStorageMockGetPosts{mock: m}
 	m.GetPostsMock.callArgs = []*StorageMockGetPostsParams{}
 
 	m.GetRepliesMock = mStorageMockGetReplies{mock: m}
 	m.GetRepliesMock.callArgs = []*StorageMockGetRepliesParams{}
 
+	m.GetReportByIDMock = mStorageMockGetReportByID{mock: m}
+	m.GetReportByIDMock.callArgs = []*StorageMockGetReportByIDParams{}
+
+	m.GetReportsMock = mStorageMockGetReports{mock: m}
+	m.GetReportsMock.callArgs = []*StorageMockGetReportsParams{}
+
+	m.PingMock = mStorageMockPing{mock: m}
+	m.PingMock.callArgs = []*StorageMockPingParams{}
+
+	m.PublishCommentMock = mStorageMockPublishComment{mock: m}
+	m.PublishCommentMock.callArgs = []*StorageMockPublishCommentParams{}
+
+	m.PublishPostMock = mStorageMockPublishPost{mock: m}
+	m.PublishPostMock.callArgs = []*StorageMockPublishPostParams{}
+
+	m.ReleaseIdempotencyKeyMock = mStorageMockReleaseIdempotencyKey{mock: m}
+	m.ReleaseIdempotencyKeyMock.callArgs = []*StorageMockReleaseIdempotencyKeyParams{}
+
+	m.RemoveCommentMock = mStorageMockRemoveComment{mock: m}
+	m.RemoveCommentMock.callArgs = []*StorageMockRemoveCommentParams{}
+
+	m.RemovePostMock = mStorageMockRemovePost{mock: m}
+	m.RemovePostMock.callArgs = []*StorageMockRemovePostParams{}
+
+	m.ReopenReportMock = mStorageMockReopenReport{mock: m}
+	m.ReopenReportMock.callArgs = []*StorageMockReopenReportParams{}
+
 	m.ToggleCommentsMock = mStorageMockToggleComments{mock: m}
 	m.ToggleCommentsMock.callArgs = []*StorageMockToggleCommentsParams{}
 
+	m.UpdateCommentMock = mStorageMockUpdateComment{mock: m}
+	m.UpdateCommentMock.callArgs = []*StorageMockUpdateCommentParams{}
+
+	m.UpdateReportStatusMock = mStorageMockUpdateReportStatus{mock: m}
+	m.UpdateReportStatusMock.callArgs = []*StorageMockUpdateReportStatusParams{}
+
 	t.Cleanup(m.MinimockFinish)
 
 	return m
 }
 
-type mStorageMockCreateComment struct {
+type mStorageMockClaimIdempotencyKey struct {
 	optional           bool
 	mock               *StorageMock
-	defaultExpectation *StorageMockCreateCommentExpectation
-	expectations       []*StorageMockCreateCommentExpectation
+	defaultExpectation *StorageMockClaimIdempotencyKeyExpectation
+	expectations       []*StorageMockClaimIdempotencyKeyExpectation
 
-	callArgs []*StorageMockCreateCommentParams
+	callArgs []*StorageMockClaimIdempotencyKeyParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// StorageMockCreateCommentExpectation specifies expectation struct of the Storage.CreateComment
-type StorageMockCreateCommentExpectation struct {
+// StorageMockClaimIdempotencyKeyExpectation specifies expectation struct of the Storage.ClaimIdempotencyKey
+type StorageMockClaimIdempotencyKeyExpectation struct {
 	mock               *StorageMock
-	params             *StorageMockCreateCommentParams
-	paramPtrs          *StorageMockCreateCommentParamPtrs
-	expectationOrigins StorageMockCreateCommentExpectationOrigins
-	results            *StorageMockCreateCommentResults
+	params             *StorageMockClaimIdempotencyKeyParams
+	paramPtrs          *StorageMockClaimIdempotencyKeyParamPtrs
+	expectationOrigins StorageMockClaimIdempotencyKeyExpectationOrigins
+	results            *StorageMockClaimIdempotencyKeyResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// StorageMockCreateCommentParams contains parameters of the Storage.CreateComment
-type StorageMockCreateCommentParams struct {
-	ctx     context.Context
-	comment *model.Comment
+// StorageMockClaimIdempotencyKeyParams contains parameters of the Storage.ClaimIdempotencyKey
+type StorageMockClaimIdempotencyKeyParams struct {
+	ctx context.Context
+	key *mm_storage.IdempotencyKey
 }
 
-// StorageMockCreateCommentParamPtrs contains pointers to parameters of the Storage.CreateComment
-type StorageMockCreateCommentParamPtrs struct {
-	ctx     *context.Context
-	comment **model.Comment
+// StorageMockClaimIdempotencyKeyParamPtrs contains pointers to parameters of the Storage.ClaimIdempotencyKey
+type StorageMockClaimIdempotencyKeyParamPtrs struct {
+	ctx *context.Context
+	key **mm_storage.IdempotencyKey
 }
 
-// StorageMockCreateCommentResults contains results of the Storage.CreateComment
-type StorageMockCreateCommentResults struct {
+// StorageMockClaimIdempotencyKeyResults contains results of the Storage.ClaimIdempotencyKey
+type StorageMockClaimIdempotencyKeyResults struct {
+	ip1 *mm_storage.IdempotencyKey
 	err error
 }
 
-// StorageMockCreateCommentOrigins contains origins of expectations of the Storage.CreateComment
-type StorageMockCreateCommentExpectationOrigins struct {
-	origin        string
-	originCtx     string
-	originComment string
+// StorageMockClaimIdempotencyKeyOrigins contains origins of expectations of the Storage.ClaimIdempotencyKey
+type StorageMockClaimIdempotencyKeyExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originKey string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -156,341 +349,347 @@ type StorageMockCreateCommentExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmCreateComment *mStorageMockCreateComment) Optional() *mStorageMockCreateComment {
-	mmCreateComment.optional = true
-	return mmCreateComment
+func (mmClaimIdempotencyKey *mStorageMockClaimIdempotencyKey) Optional() *mStorageMockClaimIdempotencyKey {
+	mmClaimIdempotencyKey.optional = true
+	return mmClaimIdempotencyKey
 }
 
-// Expect sets up expected params for Storage.CreateComment
-func (mmCreateComment *mStorageMockCreateComment) Expect(ctx context.Context, comment *model.Comment) *mStorageMockCreateComment {
-	if mmCreateComment.mock.funcCreateComment != nil {
-		mmCreateComment.mock.t.Fatalf("StorageMock.CreateComment mock is already set by Set")
+// Expect sets up expected params for Storage.ClaimIdempotencyKey
+func (mmClaimIdempotencyKey *mStorageMockClaimIdempotencyKey) Expect(ctx context.Context, key *mm_storage.IdempotencyKey) *mStorageMockClaimIdempotencyKey {
+	if mmClaimIdempotencyKey.mock.funcClaimIdempotencyKey != nil {
+		mmClaimIdempotencyKey.mock.t.Fatalf("StorageMock.ClaimIdempotencyKey mock is already set by Set")
 	}
 
-	if mmCreateComment.defaultExpectation == nil {
-		mmCreateComment.defaultExpectation = &StorageMockCreateCommentExpectation{}
+	if mmClaimIdempotencyKey.defaultExpectation == nil {
+		mmClaimIdempotencyKey.defaultExpectation = &StorageMockClaimIdempotencyKeyExpectation{}
 	}
 
-	if mmCreateComment.defaultExpectation.paramPtrs != nil {
-		mmCreateComment.mock.t.Fatalf("StorageMock.CreateComment mock is already set by ExpectParams functions")
+	if mmClaimIdempotencyKey.defaultExpectation.paramPtrs != nil {
+		mmClaimIdempotencyKey.mock.t.Fatalf("StorageMock.ClaimIdempotencyKey mock is already set by ExpectParams functions")
 	}
 
-	mmCreateComment.defaultExpectation.params = &StorageMockCreateCommentParams{ctx, comment}
-	mmCreateComment.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmCreateComment.expectations {
-		if minimock.Equal(e.params, mmCreateComment.defaultExpectation.params) {
-			mmCreateComment.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateComment.defaultExpectation.params)
+	mmClaimIdempotencyKey.defaultExpectation.params = &StorageMockClaimIdempotencyKeyParams{ctx, key}
+	mmClaimIdempotencyKey.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmClaimIdempotencyKey.expectations {
+		if minimock.Equal(e.params, mmClaimIdempotencyKey.defaultExpectation.params) {
+			mmClaimIdempotencyKey.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmClaimIdempotencyKey.defaultExpectation.params)
 		}
 	}
 
-	return mmCreateComment
+	return mmClaimIdempotencyKey
 }
 
-// ExpectCtxParam1 sets up expected param ctx for Storage.CreateComment
-func (mmCreateComment *mStorageMockCreateComment) ExpectCtxParam1(ctx context.Context) *mStorageMockCreateComment {
-	if mmCreateComment.mock.funcCreateComment != nil {
-		mmCreateComment.mock.t.Fatalf("StorageMock.CreateComment mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for Storage.ClaimIdempotencyKey
+func (mmClaimIdempotencyKey *mStorageMockClaimIdempotencyKey) ExpectCtxParam1(ctx context.Context) *mStorageMockClaimIdempotencyKey {
+	if mmClaimIdempotencyKey.mock.funcClaimIdempotencyKey != nil {
+		mmClaimIdempotencyKey.mock.t.Fatalf("StorageMock.ClaimIdempotencyKey mock is already set by Set")
 	}
 
-	if mmCreateComment.defaultExpectation == nil {
-		mmCreateComment.defaultExpectation = &StorageMockCreateCommentExpectation{}
+	if mmClaimIdempotencyKey.defaultExpectation == nil {
+		mmClaimIdempotencyKey.defaultExpectation = &StorageMockClaimIdempotencyKeyExpectation{}
 	}
 
-	if mmCreateComment.defaultExpectation.params != nil {
-		mmCreateComment.mock.t.Fatalf("StorageMock.CreateComment mock is already set by Expect")
+	if mmClaimIdempotencyKey.defaultExpectation.params != nil {
+		mmClaimIdempotencyKey.mock.t.Fatalf("StorageMock.ClaimIdempotencyKey mock is already set by Expect")
 	}
 
-	if mmCreateComment.defaultExpectation.paramPtrs == nil {
-		mmCreateComment.defaultExpectation.paramPtrs = &StorageMockCreateCommentParamPtrs{}
+	if mmClaimIdempotencyKey.defaultExpectation.paramPtrs == nil {
+		mmClaimIdempotencyKey.defaultExpectation.paramPtrs = &StorageMockClaimIdempotencyKeyParamPtrs{}
 	}
-	mmCreateComment.defaultExpectation.paramPtrs.ctx = &ctx
-	mmCreateComment.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmClaimIdempotencyKey.defaultExpectation.paramPtrs.ctx = &ctx
+	mmClaimIdempotencyKey.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmCreateComment
+	return mmClaimIdempotencyKey
 }
 
-// ExpectCommentParam2 sets up expected param comment for Storage.CreateComment
-func (mmCreateComment *mStorageMockCreateComment) ExpectCommentParam2(comment *model.Comment) *mStorageMockCreateComment {
-	if mmCreateComment.mock.funcCreateComment != nil {
-		mmCreateComment.mock.t.Fatalf("StorageMock.CreateComment mock is already set by Set")
+// ExpectKeyParam2 sets up expected param key for Storage.ClaimIdempotencyKey
+func (mmClaimIdempotencyKey *mStorageMockClaimIdempotencyKey) ExpectKeyParam2(key *mm_storage.IdempotencyKey) *mStorageMockClaimIdempotencyKey {
+	if mmClaimIdempotencyKey.mock.funcClaimIdempotencyKey != nil {
+		mmClaimIdempotencyKey.mock.t.Fatalf("StorageMock.ClaimIdempotencyKey mock is already set by Set")
 	}
 
-	if mmCreateComment.defaultExpectation == nil {
-		mmCreateComment.defaultExpectation = &StorageMockCreateCommentExpectation{}
+	if mmClaimIdempotencyKey.defaultExpectation == nil {
+		mmClaimIdempotencyKey.defaultExpectation = &StorageMockClaimIdempotencyKeyExpectation{}
 	}
 
-	if mmCreateComment.defaultExpectation.params != nil {
-		mmCreateComment.mock.t.Fatalf("StorageMock.CreateComment mock is already set by Expect")
+	if mmClaimIdempotencyKey.defaultExpectation.params != nil {
+		mmClaimIdempotencyKey.mock.t.Fatalf("StorageMock.ClaimIdempotencyKey mock is already set by Expect")
 	}
 
-	if mmCreateComment.defaultExpectation.paramPtrs == nil {
-		mmCreateComment.defaultExpectation.paramPtrs = &StorageMockCreateCommentParamPtrs{}
+	if mmClaimIdempotencyKey.defaultExpectation.paramPtrs == nil {
+		mmClaimIdempotencyKey.defaultExpectation.paramPtrs = &StorageMockClaimIdempotencyKeyParamPtrs{}
 	}
-	mmCreateComment.defaultExpectation.paramPtrs.comment = &comment
-	mmCreateComment.defaultExpectation.expectationOrigins.originComment = minimock.CallerInfo(1)
+	mmClaimIdempotencyKey.defaultExpectation.paramPtrs.key = &key
+	mmClaimIdempotencyKey.defaultExpectation.expectationOrigins.originKey = minimock.CallerInfo(1)
 
-	return mmCreateComment
+	return mmClaimIdempotencyKey
 }
 
-// Inspect accepts an inspector function that has same arguments as the Storage.CreateComment
-func (mmCreateComment *mStorageMockCreateComment) Inspect(f func(ctx context.Context, comment *model.Comment)) *mStorageMockCreateComment {
-	if mmCreateComment.mock.inspectFuncCreateComment != nil {
-		mmCreateComment.mock.t.Fatalf("Inspect function is already set for StorageMock.CreateComment")
+// Inspect accepts an inspector function that has same arguments as the Storage.ClaimIdempotencyKey
+func (mmClaimIdempotencyKey *mStorageMockClaimIdempotencyKey) Inspect(f func(ctx context.Context, key *mm_storage.IdempotencyKey)) *mStorageMockClaimIdempotencyKey {
+	if mmClaimIdempotencyKey.mock.inspectFuncClaimIdempotencyKey != nil {
+		mmClaimIdempotencyKey.mock.t.Fatalf("Inspect function is already set for StorageMock.ClaimIdempotencyKey")
 	}
 
-	mmCreateComment.mock.inspectFuncCreateComment = f
+	mmClaimIdempotencyKey.mock.inspectFuncClaimIdempotencyKey = f
 
-	return mmCreateComment
+	return mmClaimIdempotencyKey
 }
 
-// Return sets up results that will be returned by Storage.CreateComment
-func (mmCreateComment *mStorageMockCreateComment) Return(err error) *StorageMock {
-	if mmCreateComment.mock.funcCreateComment != nil {
-		mmCreateComment.mock.t.Fatalf("StorageMock.CreateComment mock is already set by Set")
+// Return sets up results that will be returned by Storage.ClaimIdempotencyKey
+func (mmClaimIdempotencyKey *mStorageMockClaimIdempotencyKey) Return(ip1 *mm_storage.IdempotencyKey, err error) *StorageMock {
+	if mmClaimIdempotencyKey.mock.funcClaimIdempotencyKey != nil {
+		mmClaimIdempotencyKey.mock.t.Fatalf("StorageMock.ClaimIdempotencyKey mock is already set by Set")
 	}
 
-	if mmCreateComment.defaultExpectation == nil {
-		mmCreateComment.defaultExpectation = &StorageMockCreateCommentExpectation{mock: mmCreateComment.mock}
+	if mmClaimIdempotencyKey.defaultExpectation == nil {
+		mmClaimIdempotencyKey.defaultExpectation = &StorageMockClaimIdempotencyKeyExpectation{mock: mmClaimIdempotencyKey.mock}
 	}
-	mmCreateComment.defaultExpectation.results = &StorageMockCreateCommentResults{err}
-	mmCreateComment.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmCreateComment.mock
+	mmClaimIdempotencyKey.defaultExpectation.results = &StorageMockClaimIdempotencyKeyResults{ip1, err}
+	mmClaimIdempotencyKey.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmClaimIdempotencyKey.mock
 }
 
-// Set uses given function f to mock the Storage.CreateComment method
-func (mmCreateComment *mStorageMockCreateComment) Set(f func(ctx context.Context, comment *model.Comment) (err error)) *StorageMock {
-	if mmCreateComment.defaultExpectation != nil {
-		mmCreateComment.mock.t.Fatalf("Default expectation is already set for the Storage.CreateComment method")
+// Set uses given function f to mock the Storage.ClaimIdempotencyKey method
+func (mmClaimIdempotencyKey *mStorageMockClaimIdempotencyKey) Set(f func(ctx context.Context, key *mm_storage.IdempotencyKey) (ip1 *mm_storage.IdempotencyKey, err error)) *StorageMock {
+	if mmClaimIdempotencyKey.defaultExpectation != nil {
+		mmClaimIdempotencyKey.mock.t.Fatalf("Default expectation is already set for the Storage.ClaimIdempotencyKey method")
 	}
 
-	if len(mmCreateComment.expectations) > 0 {
-		mmCreateComment.mock.t.Fatalf("Some expectations are already set for the Storage.CreateComment method")
+	if len(mmClaimIdempotencyKey.expectations) > 0 {
+		mmClaimIdempotencyKey.mock.t.Fatalf("Some expectations are already set for the Storage.ClaimIdempotencyKey method")
 	}
 
-	mmCreateComment.mock.funcCreateComment = f
-	mmCreateComment.mock.funcCreateCommentOrigin = minimock.CallerInfo(1)
-	return mmCreateComment.mock
+	mmClaimIdempotencyKey.mock.funcClaimIdempotencyKey = f
+	mmClaimIdempotencyKey.mock.funcClaimIdempotencyKeyOrigin = minimock.CallerInfo(1)
+	return mmClaimIdempotencyKey.mock
 }
 
-// When sets expectation for the Storage.CreateComment which will trigger the result defined by the following
+// When sets expectation for the Storage.ClaimIdempotencyKey which will trigger the result defined by the following
 // Then helper
-func (mmCreateComment *mStorageMockCreateComment) When(ctx context.Context, comment *model.Comment) *StorageMockCreateCommentExpectation {
-	if mmCreateComment.mock.funcCreateComment != nil {
-		mmCreateComment.mock.t.Fatalf("StorageMock.CreateComment mock is already set by Set")
+func (mmClaimIdempotencyKey *mStorageMockClaimIdempotencyKey) When(ctx context.Context, key *mm_storage.IdempotencyKey) *StorageMockClaimIdempotencyKeyExpectation {
+	if mmClaimIdempotencyKey.mock.funcClaimIdempotencyKey != nil {
+		mmClaimIdempotencyKey.mock.t.Fatalf("StorageMock.ClaimIdempotencyKey mock is already set by Set")
 	}
 
-	expectation := &StorageMockCreateCommentExpectation{
-		mock:               mmCreateComment.mock,
-		params:             &StorageMockCreateCommentParams{ctx, comment},
-		expectationOrigins: StorageMockCreateCommentExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &StorageMockClaimIdempotencyKeyExpectation{
+		mock:               mmClaimIdempotencyKey.mock,
+		params:             &StorageMockClaimIdempotencyKeyParams{ctx, key},
+		expectationOrigins: StorageMockClaimIdempotencyKeyExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmCreateComment.expectations = append(mmCreateComment.expectations, expectation)
+	mmClaimIdempotencyKey.expectations = append(mmClaimIdempotencyKey.expectations, expectation)
 	return expectation
 }
 
-// Then sets up Storage.CreateComment return parameters for the expectation previously defined by the When method
-func (e *StorageMockCreateCommentExpectation) Then(err error) *StorageMock {
-	e.results = &StorageMockCreateCommentResults{err}
+// Then sets up Storage.ClaimIdempotencyKey return parameters for the expectation previously defined by the When method
+func (e *StorageMockClaimIdempotencyKeyExpectation) Then(ip1 *mm_storage.IdempotencyKey, err error) *StorageMock {
+	e.results = &StorageMockClaimIdempotencyKeyResults{ip1, err}
 	return e.mock
 }
 
-// Times sets number of times Storage.CreateComment should be invoked
-func (mmCreateComment *mStorageMockCreateComment) Times(n uint64) *mStorageMockCreateComment {
+// Times sets number of times Storage.ClaimIdempotencyKey should be invoked
+func (mmClaimIdempotencyKey *mStorageMockClaimIdempotencyKey) Times(n uint64) *mStorageMockClaimIdempotencyKey {
 	if n == 0 {
-		mmCreateComment.mock.t.Fatalf("Times of StorageMock.CreateComment mock can not be zero")
+		mmClaimIdempotencyKey.mock.t.Fatalf("Times of StorageMock.ClaimIdempotencyKey mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmCreateComment.expectedInvocations, n)
-	mmCreateComment.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmCreateComment
+	mm_atomic.StoreUint64(&mmClaimIdempotencyKey.expectedInvocations, n)
+	mmClaimIdempotencyKey.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmClaimIdempotencyKey
 }
 
-func (mmCreateComment *mStorageMockCreateComment) invocationsDone() bool {
-	if len(mmCreateComment.expectations) == 0 && mmCreateComment.defaultExpectation == nil && mmCreateComment.mock.funcCreateComment == nil {
+func (mmClaimIdempotencyKey *mStorageMockClaimIdempotencyKey) invocationsDone() bool {
+	if len(mmClaimIdempotencyKey.expectations) == 0 && mmClaimIdempotencyKey.defaultExpectation == nil && mmClaimIdempotencyKey.mock.funcClaimIdempotencyKey == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmCreateComment.mock.afterCreateCommentCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmCreateComment.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmClaimIdempotencyKey.mock.afterClaimIdempotencyKeyCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmClaimIdempotencyKey.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// CreateComment implements mm_storage.Storage
-func (mmCreateComment *StorageMock) CreateComment(ctx context.Context, comment *model.Comment) (err error) {
-	mm_atomic.AddUint64(&mmCreateComment.beforeCreateCommentCounter, 1)
-	defer mm_atomic.AddUint64(&mmCreateComment.afterCreateCommentCounter, 1)
+// ClaimIdempotencyKey implements mm_storage.Storage
+func (mmClaimIdempotencyKey *StorageMock) ClaimIdempotencyKey(ctx context.Context, key *mm_storage.IdempotencyKey) (ip1 *mm_storage.IdempotencyKey, err error) {
+	mm_atomic.AddUint64(&mmClaimIdempotencyKey.beforeClaimIdempotencyKeyCounter, 1)
+	defer mm_atomic.AddUint64(&mmClaimIdempotencyKey.afterClaimIdempotencyKeyCounter, 1)
 
-	mmCreateComment.t.Helper()
+	mmClaimIdempotencyKey.t.Helper()
 
-	if mmCreateComment.inspectFuncCreateComment != nil {
-		mmCreateComment.inspectFuncCreateComment(ctx, comment)
+	if mmClaimIdempotencyKey.inspectFuncClaimIdempotencyKey != nil {
+		mmClaimIdempotencyKey.inspectFuncClaimIdempotencyKey(ctx, key)
 	}
 
-	mm_params := StorageMockCreateCommentParams{ctx, comment}
+	mm_params := StorageMockClaimIdempotencyKeyParams{ctx, key}
 
 	// Record call args
-	mmCreateComment.CreateCommentMock.mutex.Lock()
-	mmCreateComment.CreateCommentMock.callArgs = append(mmCreateComment.CreateCommentMock.callArgs, &mm_params)
-	mmCreateComment.CreateCommentMock.mutex.Unlock()
+	mmClaimIdempotencyKey.ClaimIdempotencyKeyMock.mutex.Lock()
+	mmClaimIdempotencyKey.ClaimIdempotencyKeyMock.callArgs = append(mmClaimIdempotencyKey.ClaimIdempotencyKeyMock.callArgs, &mm_params)
+	mmClaimIdempotencyKey.ClaimIdempotencyKeyMock.mutex.Unlock()
 
-	for _, e := range mmCreateComment.CreateCommentMock.expectations {
+	for _, e := range mmClaimIdempotencyKey.ClaimIdempotencyKeyMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.err
+			return e.results.ip1, e.results.err
 		}
 	}
 
-	if mmCreateComment.CreateCommentMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmCreateComment.CreateCommentMock.defaultExpectation.Counter, 1)
-		mm_want := mmCreateComment.CreateCommentMock.defaultExpectation.params
-		mm_want_ptrs := mmCreateComment.CreateCommentMock.defaultExpectation.paramPtrs
+	if mmClaimIdempotencyKey.ClaimIdempotencyKeyMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmClaimIdempotencyKey.ClaimIdempotencyKeyMock.defaultExpectation.Counter, 1)
+		mm_want := mmClaimIdempotencyKey.ClaimIdempotencyKeyMock.defaultExpectation.params
+		mm_want_ptrs := mmClaimIdempotencyKey.ClaimIdempotencyKeyMock.defaultExpectation.paramPtrs
 
-		mm_got := StorageMockCreateCommentParams{ctx, comment}
+		mm_got := StorageMockClaimIdempotencyKeyParams{ctx, key}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmCreateComment.t.Errorf("StorageMock.CreateComment got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmCreateComment.CreateCommentMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmClaimIdempotencyKey.t.Errorf("StorageMock.ClaimIdempotencyKey got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmClaimIdempotencyKey.ClaimIdempotencyKeyMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.comment != nil && !minimock.Equal(*mm_want_ptrs.comment, mm_got.comment) {
-				mmCreateComment.t.Errorf("StorageMock.CreateComment got unexpected parameter comment, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmCreateComment.CreateCommentMock.defaultExpectation.expectationOrigins.originComment, *mm_want_ptrs.comment, mm_got.comment, minimock.Diff(*mm_want_ptrs.comment, mm_got.comment))
+			if mm_want_ptrs.key != nil && !minimock.Equal(*mm_want_ptrs.key, mm_got.key) {
+				mmClaimIdempotencyKey.t.Errorf("StorageMock.ClaimIdempotencyKey got unexpected parameter key, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmClaimIdempotencyKey.ClaimIdempotencyKeyMock.defaultExpectation.expectationOrigins.originKey, *mm_want_ptrs.key, mm_got.key, minimock.Diff(*mm_want_ptrs.key, mm_got.key))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmCreateComment.t.Errorf("StorageMock.CreateComment got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmCreateComment.CreateCommentMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmClaimIdempotencyKey.t.Errorf("StorageMock.ClaimIdempotencyKey got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmClaimIdempotencyKey.ClaimIdempotencyKeyMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmCreateComment.CreateCommentMock.defaultExpectation.results
+		mm_results := mmClaimIdempotencyKey.ClaimIdempotencyKeyMock.defaultExpectation.results
 		if mm_results == nil {
-			mmCreateComment.t.Fatal("No results are set for the StorageMock.CreateComment")
+			mmClaimIdempotencyKey.t.Fatal("No results are set for the StorageMock.ClaimIdempotencyKey")
 		}
-		return (*mm_results).err
+		return (*mm_results).ip1, (*mm_results).err
 	}
-	if mmCreateComment.funcCreateComment != nil {
-		return mmCreateComment.funcCreateComment(ctx, comment)
+	if mmClaimIdempotencyKey.funcClaimIdempotencyKey != nil {
+		return mmClaimIdempotencyKey.funcClaimIdempotencyKey(ctx, key)
 	}
-	mmCreateComment.t.Fatalf("Unexpected call to StorageMock.CreateComment. %v %v", ctx, comment)
+	mmClaimIdempotencyKey.t.Fatalf("Unexpected call to StorageMock.ClaimIdempotencyKey. %v %v", ctx, key)
 	return
 }
 
-// CreateCommentAfterCounter returns a count of finished StorageMock.CreateComment invocations
-func (mmCreateComment *StorageMock) CreateCommentAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmCreateComment.afterCreateCommentCounter)
+// ClaimIdempotencyKeyAfterCounter returns a count of finished StorageMock.ClaimIdempotencyKey invocations
+func (mmClaimIdempotencyKey *StorageMock) ClaimIdempotencyKeyAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmClaimIdempotencyKey.afterClaimIdempotencyKeyCounter)
 }
 
-// CreateCommentBeforeCounter returns a count of StorageMock.CreateComment invocations
-func (mmCreateComment *StorageMock) CreateCommentBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmCreateComment.beforeCreateCommentCounter)
+// ClaimIdempotencyKeyBeforeCounter returns a count of StorageMock.ClaimIdempotencyKey invocations
+func (mmClaimIdempotencyKey *StorageMock) ClaimIdempotencyKeyBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmClaimIdempotencyKey.beforeClaimIdempotencyKeyCounter)
 }
 
-// Calls returns a list of arguments used in each call to StorageMock.CreateComment.
+// Calls returns a list of arguments used in each call to StorageMock.ClaimIdempotencyKey.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmCreateComment *mStorageMockCreateComment) Calls() []*StorageMockCreateCommentParams {
-	mmCreateComment.mutex.RLock()
+func (mmClaimIdempotencyKey *mStorageMockClaimIdempotencyKey) Calls() []*StorageMockClaimIdempotencyKeyParams {
+	mmClaimIdempotencyKey.mutex.RLock()
 
-	argCopy := make([]*StorageMockCreateCommentParams, len(mmCreateComment.callArgs))
-	copy(argCopy, mmCreateComment.callArgs)
+	argCopy := make([]*StorageMockClaimIdempotencyKeyParams, len(mmClaimIdempotencyKey.callArgs))
+	copy(argCopy, mmClaimIdempotencyKey.callArgs)
 
-	mmCreateComment.mutex.RUnlock()
+	mmClaimIdempotencyKey.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockCreateCommentDone returns true if the count of the CreateComment invocations corresponds
+// MinimockClaimIdempotencyKeyDone returns true if the count of the ClaimIdempotencyKey invocations corresponds
 // the number of defined expectations
-func (m *StorageMock) MinimockCreateCommentDone() bool {
-	if m.CreateCommentMock.optional {
+func (m *StorageMock) MinimockClaimIdempotencyKeyDone() bool {
+	if m.ClaimIdempotencyKeyMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.CreateCommentMock.expectations {
+	for _, e := range m.ClaimIdempotencyKeyMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.CreateCommentMock.invocationsDone()
+	return m.ClaimIdempotencyKeyMock.invocationsDone()
 }
 
-// MinimockCreateCommentInspect logs each unmet expectation
-func (m *StorageMock) MinimockCreateCommentInspect() {
-	for _, e := range m.CreateCommentMock.expectations {
+// MinimockClaimIdempotencyKeyInspect logs each unmet expectation
+func (m *StorageMock) MinimockClaimIdempotencyKeyInspect() {
+	for _, e := range m.ClaimIdempotencyKeyMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to StorageMock.CreateComment at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to StorageMock.ClaimIdempotencyKey at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterCreateCommentCounter := mm_atomic.LoadUint64(&m.afterCreateCommentCounter)
+	afterClaimIdempotencyKeyCounter := mm_atomic.LoadUint64(&m.afterClaimIdempotencyKeyCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.CreateCommentMock.defaultExpectation != nil && afterCreateCommentCounter < 1 {
-		if m.CreateCommentMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to StorageMock.CreateComment at\n%s", m.CreateCommentMock.defaultExpectation.returnOrigin)
+	if m.ClaimIdempotencyKeyMock.defaultExpectation != nil && afterClaimIdempotencyKeyCounter < 1 {
+		if m.ClaimIdempotencyKeyMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to StorageMock.ClaimIdempotencyKey at\n%s", m.ClaimIdempotencyKeyMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to StorageMock.CreateComment at\n%s with params: %#v", m.CreateCommentMock.defaultExpectation.expectationOrigins.origin, *m.CreateCommentMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to StorageMock.ClaimIdempotencyKey at\n%s with params: %#v", m.ClaimIdempotencyKeyMock.defaultExpectation.expectationOrigins.origin, *m.ClaimIdempotencyKeyMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcCreateComment != nil && afterCreateCommentCounter < 1 {
-		m.t.Errorf("Expected call to StorageMock.CreateComment at\n%s", m.funcCreateCommentOrigin)
+	if m.funcClaimIdempotencyKey != nil && afterClaimIdempotencyKeyCounter < 1 {
+		m.t.Errorf("Expected call to StorageMock.ClaimIdempotencyKey at\n%s", m.funcClaimIdempotencyKeyOrigin)
 	}
 
-	if !m.CreateCommentMock.invocationsDone() && afterCreateCommentCounter > 0 {
-		m.t.Errorf("Expected %d calls to StorageMock.CreateComment at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.CreateCommentMock.expectedInvocations), m.CreateCommentMock.expectedInvocationsOrigin, afterCreateCommentCounter)
+	if !m.ClaimIdempotencyKeyMock.invocationsDone() && afterClaimIdempotencyKeyCounter > 0 {
+		m.t.Errorf("Expected %d calls to StorageMock.ClaimIdempotencyKey at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ClaimIdempotencyKeyMock.expectedInvocations), m.ClaimIdempotencyKeyMock.expectedInvocationsOrigin, afterClaimIdempotencyKeyCounter)
 	}
 }
 
-type mStorageMockCreatePost struct {
+type mStorageMockCompleteIdempotencyKey struct {
 	optional           bool
 	mock               *StorageMock
-	defaultExpectation *StorageMockCreatePostExpectation
-	expectations       []*StorageMockCreatePostExpectation
+	defaultExpectation *StorageMockCompleteIdempotencyKeyExpectation
+	expectations       []*StorageMockCompleteIdempotencyKeyExpectation
 
-	callArgs []*StorageMockCreatePostParams
+	callArgs []*StorageMockCompleteIdempotencyKeyParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// StorageMockCreatePostExpectation specifies expectation struct of the Storage.CreatePost
-type StorageMockCreatePostExpectation struct {
+// StorageMockCompleteIdempotencyKeyExpectation specifies expectation struct of the Storage.CompleteIdempotencyKey
+type StorageMockCompleteIdempotencyKeyExpectation struct {
 	mock               *StorageMock
-	params             *StorageMockCreatePostParams
-	paramPtrs          *StorageMockCreatePostParamPtrs
-	expectationOrigins StorageMockCreatePostExpectationOrigins
-	results            *StorageMockCreatePostResults
+	params             *StorageMockCompleteIdempotencyKeyParams
+	paramPtrs          *StorageMockCompleteIdempotencyKeyParamPtrs
+	expectationOrigins StorageMockCompleteIdempotencyKeyExpectationOrigins
+	results            *StorageMockCompleteIdempotencyKeyResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// StorageMockCreatePostParams contains parameters of the Storage.CreatePost
-type StorageMockCreatePostParams struct {
-	ctx  context.Context
-	post *model.Post
+// StorageMockCompleteIdempotencyKeyParams contains parameters of the Storage.CompleteIdempotencyKey
+type StorageMockCompleteIdempotencyKeyParams struct {
+	ctx       context.Context
+	key       string
+	resultID  string
+	expiresAt time.Time
 }
 
-// StorageMockCreatePostParamPtrs contains pointers to parameters of the Storage.CreatePost
-type StorageMockCreatePostParamPtrs struct {
-	ctx  *context.Context
-	post **model.Post
+// StorageMockCompleteIdempotencyKeyParamPtrs contains pointers to parameters of the Storage.CompleteIdempotencyKey
+type StorageMockCompleteIdempotencyKeyParamPtrs struct {
+	ctx       *context.Context
+	key       *string
+	resultID  *string
+	expiresAt *time.Time
 }
 
-// StorageMockCreatePostResults contains results of the Storage.CreatePost
-type StorageMockCreatePostResults struct {
+// StorageMockCompleteIdempotencyKeyResults contains results of the Storage.CompleteIdempotencyKey
+type StorageMockCompleteIdempotencyKeyResults struct {
 	err error
 }
 
-// StorageMockCreatePostOrigins contains origins of expectations of the Storage.CreatePost
-type StorageMockCreatePostExpectationOrigins struct {
-	origin     string
-	originCtx  string
-	originPost string
+// StorageMockCompleteIdempotencyKeyOrigins contains origins of expectations of the Storage.CompleteIdempotencyKey
+type StorageMockCompleteIdempotencyKeyExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originKey       string
+	originResultID  string
+	originExpiresAt string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -498,348 +697,397 @@ type StorageMockCreatePostExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmCreatePost *mStorageMockCreatePost) Optional() *mStorageMockCreatePost {
-	mmCreatePost.optional = true
-	return mmCreatePost
+func (mmCompleteIdempotencyKey *mStorageMockCompleteIdempotencyKey) Optional() *mStorageMockCompleteIdempotencyKey {
+	mmCompleteIdempotencyKey.optional = true
+	return mmCompleteIdempotencyKey
 }
 
-// Expect sets up expected params for Storage.CreatePost
-func (mmCreatePost *mStorageMockCreatePost) Expect(ctx context.Context, post *model.Post) *mStorageMockCreatePost {
-	if mmCreatePost.mock.funcCreatePost != nil {
-		mmCreatePost.mock.t.Fatalf("StorageMock.CreatePost mock is already set by Set")
+// Expect sets up expected params for Storage.CompleteIdempotencyKey
+func (mmCompleteIdempotencyKey *mStorageMockCompleteIdempotencyKey) Expect(ctx context.Context, key string, resultID string, expiresAt time.Time) *mStorageMockCompleteIdempotencyKey {
+	if mmCompleteIdempotencyKey.mock.funcCompleteIdempotencyKey != nil {
+		mmCompleteIdempotencyKey.mock.t.Fatalf("StorageMock.CompleteIdempotencyKey mock is already set by Set")
 	}
 
-	if mmCreatePost.defaultExpectation == nil {
-		mmCreatePost.defaultExpectation = &StorageMockCreatePostExpectation{}
+	if mmCompleteIdempotencyKey.defaultExpectation == nil {
+		mmCompleteIdempotencyKey.defaultExpectation = &StorageMockCompleteIdempotencyKeyExpectation{}
 	}
 
-	if mmCreatePost.defaultExpectation.paramPtrs != nil {
-		mmCreatePost.mock.t.Fatalf("StorageMock.CreatePost mock is already set by ExpectParams functions")
+	if mmCompleteIdempotencyKey.defaultExpectation.paramPtrs != nil {
+		mmCompleteIdempotencyKey.mock.t.Fatalf("StorageMock.CompleteIdempotencyKey mock is already set by ExpectParams functions")
 	}
 
-	mmCreatePost.defaultExpectation.params = &StorageMockCreatePostParams{ctx, post}
-	mmCreatePost.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmCreatePost.expectations {
-		if minimock.Equal(e.params, mmCreatePost.defaultExpectation.params) {
-			mmCreatePost.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreatePost.defaultExpectation.params)
+	mmCompleteIdempotencyKey.defaultExpectation.params = &StorageMockCompleteIdempotencyKeyParams{ctx, key, resultID, expiresAt}
+	mmCompleteIdempotencyKey.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCompleteIdempotencyKey.expectations {
+		if minimock.Equal(e.params, mmCompleteIdempotencyKey.defaultExpectation.params) {
+			mmCompleteIdempotencyKey.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCompleteIdempotencyKey.defaultExpectation.params)
 		}
 	}
 
-	return mmCreatePost
+	return mmCompleteIdempotencyKey
 }
 
-// ExpectCtxParam1 sets up expected param ctx for Storage.CreatePost
-func (mmCreatePost *mStorageMockCreatePost) ExpectCtxParam1(ctx context.Context) *mStorageMockCreatePost {
-	if mmCreatePost.mock.funcCreatePost != nil {
-		mmCreatePost.mock.t.Fatalf("StorageMock.CreatePost mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for Storage.CompleteIdempotencyKey
+func (mmCompleteIdempotencyKey *mStorageMockCompleteIdempotencyKey) ExpectCtxParam1(ctx context.Context) *mStorageMockCompleteIdempotencyKey {
+	if mmCompleteIdempotencyKey.mock.funcCompleteIdempotencyKey != nil {
+		mmCompleteIdempotencyKey.mock.t.Fatalf("StorageMock.CompleteIdempotencyKey mock is already set by Set")
 	}
 
-	if mmCreatePost.defaultExpectation == nil {
-		mmCreatePost.defaultExpectation = &StorageMockCreatePostExpectation{}
+	if mmCompleteIdempotencyKey.defaultExpectation == nil {
+		mmCompleteIdempotencyKey.defaultExpectation = &StorageMockCompleteIdempotencyKeyExpectation{}
 	}
 
-	if mmCreatePost.defaultExpectation.params != nil {
-		mmCreatePost.mock.t.Fatalf("StorageMock.CreatePost mock is already set by Expect")
+	if mmCompleteIdempotencyKey.defaultExpectation.params != nil {
+		mmCompleteIdempotencyKey.mock.t.Fatalf("StorageMock.CompleteIdempotencyKey mock is already set by Expect")
 	}
 
-	if mmCreatePost.defaultExpectation.paramPtrs == nil {
-		mmCreatePost.defaultExpectation.paramPtrs = &StorageMockCreatePostParamPtrs{}
+	if mmCompleteIdempotencyKey.defaultExpectation.paramPtrs == nil {
+		mmCompleteIdempotencyKey.defaultExpectation.paramPtrs = &StorageMockCompleteIdempotencyKeyParamPtrs{}
 	}
-	mmCreatePost.defaultExpectation.paramPtrs.ctx = &ctx
-	mmCreatePost.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmCompleteIdempotencyKey.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCompleteIdempotencyKey.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmCreatePost
+	return mmCompleteIdempotencyKey
 }
 
-// ExpectPostParam2 sets up expected param post for Storage.CreatePost
-func (mmCreatePost *mStorageMockCreatePost) ExpectPostParam2(post *model.Post) *mStorageMockCreatePost {
-	if mmCreatePost.mock.funcCreatePost != nil {
-		mmCreatePost.mock.t.Fatalf("StorageMock.CreatePost mock is already set by Set")
+// ExpectKeyParam2 sets up expected param key for Storage.CompleteIdempotencyKey
+func (mmCompleteIdempotencyKey *mStorageMockCompleteIdempotencyKey) ExpectKeyParam2(key string) *mStorageMockCompleteIdempotencyKey {
+	if mmCompleteIdempotencyKey.mock.funcCompleteIdempotencyKey != nil {
+		mmCompleteIdempotencyKey.mock.t.Fatalf("StorageMock.CompleteIdempotencyKey mock is already set by Set")
 	}
 
-	if mmCreatePost.defaultExpectation == nil {
-		mmCreatePost.defaultExpectation = &StorageMockCreatePostExpectation{}
+	if mmCompleteIdempotencyKey.defaultExpectation == nil {
+		mmCompleteIdempotencyKey.defaultExpectation = &StorageMockCompleteIdempotencyKeyExpectation{}
 	}
 
-	if mmCreatePost.defaultExpectation.params != nil {
-		mmCreatePost.mock.t.Fatalf("StorageMock.CreatePost mock is already set by Expect")
+	if mmCompleteIdempotencyKey.defaultExpectation.params != nil {
+		mmCompleteIdempotencyKey.mock.t.Fatalf("StorageMock.CompleteIdempotencyKey mock is already set by Expect")
 	}
 
-	if mmCreatePost.defaultExpectation.paramPtrs == nil {
-		mmCreatePost.defaultExpectation.paramPtrs = &StorageMockCreatePostParamPtrs{}
+	if mmCompleteIdempotencyKey.defaultExpectation.paramPtrs == nil {
+		mmCompleteIdempotencyKey.defaultExpectation.paramPtrs = &StorageMockCompleteIdempotencyKeyParamPtrs{}
 	}
-	mmCreatePost.defaultExpectation.paramPtrs.post = &post
-	mmCreatePost.defaultExpectation.expectationOrigins.originPost = minimock.CallerInfo(1)
+	mmCompleteIdempotencyKey.defaultExpectation.paramPtrs.key = &key
+	mmCompleteIdempotencyKey.defaultExpectation.expectationOrigins.originKey = minimock.CallerInfo(1)
 
-	return mmCreatePost
+	return mmCompleteIdempotencyKey
 }
 
-// Inspect accepts an inspector function that has same arguments as the Storage.CreatePost
-func (mmCreatePost *mStorageMockCreatePost) Inspect(f func(ctx context.Context, post *model.Post)) *mStorageMockCreatePost {
-	if mmCreatePost.mock.inspectFuncCreatePost != nil {
-		mmCreatePost.mock.t.Fatalf("Inspect function is already set for StorageMock.CreatePost")
+// ExpectResultIDParam3 sets up expected param resultID for Storage.CompleteIdempotencyKey
+func (mmCompleteIdempotencyKey *mStorageMockCompleteIdempotencyKey) ExpectResultIDParam3(resultID string) *mStorageMockCompleteIdempotencyKey {
+	if mmCompleteIdempotencyKey.mock.funcCompleteIdempotencyKey != nil {
+		mmCompleteIdempotencyKey.mock.t.Fatalf("StorageMock.CompleteIdempotencyKey mock is already set by Set")
 	}
 
-	mmCreatePost.mock.inspectFuncCreatePost = f
+	if mmCompleteIdempotencyKey.defaultExpectation == nil {
+		mmCompleteIdempotencyKey.defaultExpectation = &StorageMockCompleteIdempotencyKeyExpectation{}
+	}
 
-	return mmCreatePost
-}
+	if mmCompleteIdempotencyKey.defaultExpectation.params != nil {
+		mmCompleteIdempotencyKey.mock.t.Fatalf("StorageMock.CompleteIdempotencyKey mock is already set by Expect")
+	}
 
-// Return sets up results that will be returned by Storage.CreatePost
-func (mmCreatePost *mStorageMockCreatePost) Return(err error) *StorageMock {
-	if mmCreatePost.mock.funcCreatePost != nil {
-		mmCreatePost.mock.t.Fatalf("StorageMock.CreatePost mock is already set by Set")
+	if mmCompleteIdempotencyKey.defaultExpectation.paramPtrs == nil {
+		mmCompleteIdempotencyKey.defaultExpectation.paramPtrs = &StorageMockCompleteIdempotencyKeyParamPtrs{}
 	}
+	mmCompleteIdempotencyKey.defaultExpectation.paramPtrs.resultID = &resultID
+	mmCompleteIdempotencyKey.defaultExpectation.expectationOrigins.originResultID = minimock.CallerInfo(1)
 
-	if mmCreatePost.defaultExpectation == nil {
-		mmCreatePost.defaultExpectation = &StorageMockCreatePostExpectation{mock: mmCreatePost.mock}
+	return mmCompleteIdempotencyKey
+}
+
+// ExpectExpiresAtParam4 sets up expected param expiresAt for Storage.CompleteIdempotencyKey
+func (mmCompleteIdempotencyKey *mStorageMockCompleteIdempotencyKey) ExpectExpiresAtParam4(expiresAt time.Time) *mStorageMockCompleteIdempotencyKey {
+	if mmCompleteIdempotencyKey.mock.funcCompleteIdempotencyKey != nil {
+		mmCompleteIdempotencyKey.mock.t.Fatalf("StorageMock.CompleteIdempotencyKey mock is already set by Set")
 	}
-	mmCreatePost.defaultExpectation.results = &StorageMockCreatePostResults{err}
-	mmCreatePost.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmCreatePost.mock
+
+	if mmCompleteIdempotencyKey.defaultExpectation == nil {
+		mmCompleteIdempotencyKey.defaultExpectation = &StorageMockCompleteIdempotencyKeyExpectation{}
+	}
+
+	if mmCompleteIdempotencyKey.defaultExpectation.params != nil {
+		mmCompleteIdempotencyKey.mock.t.Fatalf("StorageMock.CompleteIdempotencyKey mock is already set by Expect")
+	}
+
+	if mmCompleteIdempotencyKey.defaultExpectation.paramPtrs == nil {
+		mmCompleteIdempotencyKey.defaultExpectation.paramPtrs = &StorageMockCompleteIdempotencyKeyParamPtrs{}
+	}
+	mmCompleteIdempotencyKey.defaultExpectation.paramPtrs.expiresAt = &expiresAt
+	mmCompleteIdempotencyKey.defaultExpectation.expectationOrigins.originExpiresAt = minimock.CallerInfo(1)
+
+	return mmCompleteIdempotencyKey
 }
 
-// Set uses given function f to mock the Storage.CreatePost method
-func (mmCreatePost *mStorageMockCreatePost) Set(f func(ctx context.Context, post *model.Post) (err error)) *StorageMock {
-	if mmCreatePost.defaultExpectation != nil {
-		mmCreatePost.mock.t.Fatalf("Default expectation is already set for the Storage.CreatePost method")
+// Inspect accepts an inspector function that has same arguments as the Storage.CompleteIdempotencyKey
+func (mmCompleteIdempotencyKey *mStorageMockCompleteIdempotencyKey) Inspect(f func(ctx context.Context, key string, resultID string, expiresAt time.Time)) *mStorageMockCompleteIdempotencyKey {
+	if mmCompleteIdempotencyKey.mock.inspectFuncCompleteIdempotencyKey != nil {
+		mmCompleteIdempotencyKey.mock.t.Fatalf("Inspect function is already set for StorageMock.CompleteIdempotencyKey")
 	}
 
-	if len(mmCreatePost.expectations) > 0 {
-		mmCreatePost.mock.t.Fatalf("Some expectations are already set for the Storage.CreatePost method")
+	mmCompleteIdempotencyKey.mock.inspectFuncCompleteIdempotencyKey = f
+
+	return mmCompleteIdempotencyKey
+}
+
+// Return sets up results that will be returned by Storage.CompleteIdempotencyKey
+func (mmCompleteIdempotencyKey *mStorageMockCompleteIdempotencyKey) Return(err error) *StorageMock {
+	if mmCompleteIdempotencyKey.mock.funcCompleteIdempotencyKey != nil {
+		mmCompleteIdempotencyKey.mock.t.Fatalf("StorageMock.CompleteIdempotencyKey mock is already set by Set")
 	}
 
-	mmCreatePost.mock.funcCreatePost = f
-	mmCreatePost.mock.funcCreatePostOrigin = minimock.CallerInfo(1)
-	return mmCreatePost.mock
+	if mmCompleteIdempotencyKey.defaultExpectation == nil {
+		mmCompleteIdempotencyKey.defaultExpectation = &StorageMockCompleteIdempotencyKeyExpectation{mock: mmCompleteIdempotencyKey.mock}
+	}
+	mmCompleteIdempotencyKey.defaultExpectation.results = &StorageMockCompleteIdempotencyKeyResults{err}
+	mmCompleteIdempotencyKey.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCompleteIdempotencyKey.mock
 }
 
-// When sets expectation for the Storage.CreatePost which will trigger the result defined by the following
+// Set uses given function f to mock the Storage.CompleteIdempotencyKey method
+func (mmCompleteIdempotencyKey *mStorageMockCompleteIdempotencyKey) Set(f func(ctx context.Context, key string, resultID string, expiresAt time.Time) (err error)) *StorageMock {
+	if mmCompleteIdempotencyKey.defaultExpectation != nil {
+		mmCompleteIdempotencyKey.mock.t.Fatalf("Default expectation is already set for the Storage.CompleteIdempotencyKey method")
+	}
+
+	if len(mmCompleteIdempotencyKey.expectations) > 0 {
+		mmCompleteIdempotencyKey.mock.t.Fatalf("Some expectations are already set for the Storage.CompleteIdempotencyKey method")
+	}
+
+	mmCompleteIdempotencyKey.mock.funcCompleteIdempotencyKey = f
+	mmCompleteIdempotencyKey.mock.funcCompleteIdempotencyKeyOrigin = minimock.CallerInfo(1)
+	return mmCompleteIdempotencyKey.mock
+}
+
+// When sets expectation for the Storage.CompleteIdempotencyKey which will trigger the result defined by the following
 // Then helper
-func (mmCreatePost *mStorageMockCreatePost) When(ctx context.Context, post *model.Post) *StorageMockCreatePostExpectation {
-	if mmCreatePost.mock.funcCreatePost != nil {
-		mmCreatePost.mock.t.Fatalf("StorageMock.CreatePost mock is already set by Set")
+func (mmCompleteIdempotencyKey *mStorageMockCompleteIdempotencyKey) When(ctx context.Context, key string, resultID string, expiresAt time.Time) *StorageMockCompleteIdempotencyKeyExpectation {
+	if mmCompleteIdempotencyKey.mock.funcCompleteIdempotencyKey != nil {
+		mmCompleteIdempotencyKey.mock.t.Fatalf("StorageMock.CompleteIdempotencyKey mock is already set by Set")
 	}
 
-	expectation := &StorageMockCreatePostExpectation{
-		mock:               mmCreatePost.mock,
-		params:             &StorageMockCreatePostParams{ctx, post},
-		expectationOrigins: StorageMockCreatePostExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &StorageMockCompleteIdempotencyKeyExpectation{
+		mock:               mmCompleteIdempotencyKey.mock,
+		params:             &StorageMockCompleteIdempotencyKeyParams{ctx, key, resultID, expiresAt},
+		expectationOrigins: StorageMockCompleteIdempotencyKeyExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmCreatePost.expectations = append(mmCreatePost.expectations, expectation)
+	mmCompleteIdempotencyKey.expectations = append(mmCompleteIdempotencyKey.expectations, expectation)
 	return expectation
 }
 
-// Then sets up Storage.CreatePost return parameters for the expectation previously defined by the When method
-func (e *StorageMockCreatePostExpectation) Then(err error) *StorageMock {
-	e.results = &StorageMockCreatePostResults{err}
+// Then sets up Storage.CompleteIdempotencyKey return parameters for the expectation previously defined by the When method
+func (e *StorageMockCompleteIdempotencyKeyExpectation) Then(err error) *StorageMock {
+	e.results = &StorageMockCompleteIdempotencyKeyResults{err}
 	return e.mock
 }
 
-// Times sets number of times Storage.CreatePost should be invoked
-func (mmCreatePost *mStorageMockCreatePost) Times(n uint64) *mStorageMockCreatePost {
+// Times sets number of times Storage.CompleteIdempotencyKey should be invoked
+func (mmCompleteIdempotencyKey *mStorageMockCompleteIdempotencyKey) Times(n uint64) *mStorageMockCompleteIdempotencyKey {
 	if n == 0 {
-		mmCreatePost.mock.t.Fatalf("Times of StorageMock.CreatePost mock can not be zero")
+		mmCompleteIdempotencyKey.mock.t.Fatalf("Times of StorageMock.CompleteIdempotencyKey mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmCreatePost.expectedInvocations, n)
-	mmCreatePost.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmCreatePost
+	mm_atomic.StoreUint64(&mmCompleteIdempotencyKey.expectedInvocations, n)
+	mmCompleteIdempotencyKey.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCompleteIdempotencyKey
 }
 
-func (mmCreatePost *mStorageMockCreatePost) invocationsDone() bool {
-	if len(mmCreatePost.expectations) == 0 && mmCreatePost.defaultExpectation == nil && mmCreatePost.mock.funcCreatePost == nil {
+func (mmCompleteIdempotencyKey *mStorageMockCompleteIdempotencyKey) invocationsDone() bool {
+	if len(mmCompleteIdempotencyKey.expectations) == 0 && mmCompleteIdempotencyKey.defaultExpectation == nil && mmCompleteIdempotencyKey.mock.funcCompleteIdempotencyKey == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmCreatePost.mock.afterCreatePostCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmCreatePost.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmCompleteIdempotencyKey.mock.afterCompleteIdempotencyKeyCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCompleteIdempotencyKey.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// CreatePost implements mm_storage.Storage
-func (mmCreatePost *StorageMock) CreatePost(ctx context.Context, post *model.Post) (err error) {
-	mm_atomic.AddUint64(&mmCreatePost.beforeCreatePostCounter, 1)
-	defer mm_atomic.AddUint64(&mmCreatePost.afterCreatePostCounter, 1)
+// CompleteIdempotencyKey implements mm_storage.Storage
+func (mmCompleteIdempotencyKey *StorageMock) CompleteIdempotencyKey(ctx context.Context, key string, resultID string, expiresAt time.Time) (err error) {
+	mm_atomic.AddUint64(&mmCompleteIdempotencyKey.beforeCompleteIdempotencyKeyCounter, 1)
+	defer mm_atomic.AddUint64(&mmCompleteIdempotencyKey.afterCompleteIdempotencyKeyCounter, 1)
 
-	mmCreatePost.t.Helper()
+	mmCompleteIdempotencyKey.t.Helper()
 
-	if mmCreatePost.inspectFuncCreatePost != nil {
-		mmCreatePost.inspectFuncCreatePost(ctx, post)
+	if mmCompleteIdempotencyKey.inspectFuncCompleteIdempotencyKey != nil {
+		mmCompleteIdempotencyKey.inspectFuncCompleteIdempotencyKey(ctx, key, resultID, expiresAt)
 	}
 
-	mm_params := StorageMockCreatePostParams{ctx, post}
+	mm_params := StorageMockCompleteIdempotencyKeyParams{ctx, key, resultID, expiresAt}
 
 	// Record call args
-	mmCreatePost.CreatePostMock.mutex.Lock()
-	mmCreatePost.CreatePostMock.callArgs = append(mmCreatePost.CreatePostMock.callArgs, &mm_params)
-	mmCreatePost.CreatePostMock.mutex.Unlock()
+	mmCompleteIdempotencyKey.CompleteIdempotencyKeyMock.mutex.Lock()
+	mmCompleteIdempotencyKey.CompleteIdempotencyKeyMock.callArgs = append(mmCompleteIdempotencyKey.CompleteIdempotencyKeyMock.callArgs, &mm_params)
+	mmCompleteIdempotencyKey.CompleteIdempotencyKeyMock.mutex.Unlock()
 
-	for _, e := range mmCreatePost.CreatePostMock.expectations {
+	for _, e := range mmCompleteIdempotencyKey.CompleteIdempotencyKeyMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
 			return e.results.err
 		}
 	}
 
-	if mmCreatePost.CreatePostMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmCreatePost.CreatePostMock.defaultExpectation.Counter, 1)
-		mm_want := mmCreatePost.CreatePostMock.defaultExpectation.params
-		mm_want_ptrs := mmCreatePost.CreatePostMock.defaultExpectation.paramPtrs
+	if mmCompleteIdempotencyKey.CompleteIdempotencyKeyMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCompleteIdempotencyKey.CompleteIdempotencyKeyMock.defaultExpectation.Counter, 1)
+		mm_want := mmCompleteIdempotencyKey.CompleteIdempotencyKeyMock.defaultExpectation.params
+		mm_want_ptrs := mmCompleteIdempotencyKey.CompleteIdempotencyKeyMock.defaultExpectation.paramPtrs
 
-		mm_got := StorageMockCreatePostParams{ctx, post}
+		mm_got := StorageMockCompleteIdempotencyKeyParams{ctx, key, resultID, expiresAt}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmCreatePost.t.Errorf("StorageMock.CreatePost got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmCreatePost.CreatePostMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmCompleteIdempotencyKey.t.Errorf("StorageMock.CompleteIdempotencyKey got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCompleteIdempotencyKey.CompleteIdempotencyKeyMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.post != nil && !minimock.Equal(*mm_want_ptrs.post, mm_got.post) {
-				mmCreatePost.t.Errorf("StorageMock.CreatePost got unexpected parameter post, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmCreatePost.CreatePostMock.defaultExpectation.expectationOrigins.originPost, *mm_want_ptrs.post, mm_got.post, minimock.Diff(*mm_want_ptrs.post, mm_got.post))
+			if mm_want_ptrs.key != nil && !minimock.Equal(*mm_want_ptrs.key, mm_got.key) {
+				mmCompleteIdempotencyKey.t.Errorf("StorageMock.CompleteIdempotencyKey got unexpected parameter key, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCompleteIdempotencyKey.CompleteIdempotencyKeyMock.defaultExpectation.expectationOrigins.originKey, *mm_want_ptrs.key, mm_got.key, minimock.Diff(*mm_want_ptrs.key, mm_got.key))
+			}
+
+			if mm_want_ptrs.resultID != nil && !minimock.Equal(*mm_want_ptrs.resultID, mm_got.resultID) {
+				mmCompleteIdempotencyKey.t.Errorf("StorageMock.CompleteIdempotencyKey got unexpected parameter resultID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCompleteIdempotencyKey.CompleteIdempotencyKeyMock.defaultExpectation.expectationOrigins.originResultID, *mm_want_ptrs.resultID, mm_got.resultID, minimock.Diff(*mm_want_ptrs.resultID, mm_got.resultID))
+			}
+
+			if mm_want_ptrs.expiresAt != nil && !minimock.Equal(*mm_want_ptrs.expiresAt, mm_got.expiresAt) {
+				mmCompleteIdempotencyKey.t.Errorf("StorageMock.CompleteIdempotencyKey got unexpected parameter expiresAt, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCompleteIdempotencyKey.CompleteIdempotencyKeyMock.defaultExpectation.expectationOrigins.originExpiresAt, *mm_want_ptrs.expiresAt, mm_got.expiresAt, minimock.Diff(*mm_want_ptrs.expiresAt, mm_got.expiresAt))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmCreatePost.t.Errorf("StorageMock.CreatePost got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmCreatePost.CreatePostMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmCompleteIdempotencyKey.t.Errorf("StorageMock.CompleteIdempotencyKey got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCompleteIdempotencyKey.CompleteIdempotencyKeyMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmCreatePost.CreatePostMock.defaultExpectation.results
+		mm_results := mmCompleteIdempotencyKey.CompleteIdempotencyKeyMock.defaultExpectation.results
 		if mm_results == nil {
-			mmCreatePost.t.Fatal("No results are set for the StorageMock.CreatePost")
+			mmCompleteIdempotencyKey.t.Fatal("No results are set for the StorageMock.CompleteIdempotencyKey")
 		}
 		return (*mm_results).err
 	}
-	if mmCreatePost.funcCreatePost != nil {
-		return mmCreatePost.funcCreatePost(ctx, post)
+	if mmCompleteIdempotencyKey.funcCompleteIdempotencyKey != nil {
+		return mmCompleteIdempotencyKey.funcCompleteIdempotencyKey(ctx, key, resultID, expiresAt)
 	}
-	mmCreatePost.t.Fatalf("Unexpected call to StorageMock.CreatePost. %v %v", ctx, post)
+	mmCompleteIdempotencyKey.t.Fatalf("Unexpected call to StorageMock.CompleteIdempotencyKey. %v %v %v %v", ctx, key, resultID, expiresAt)
 	return
 }
 
-// CreatePostAfterCounter returns a count of finished StorageMock.CreatePost invocations
-func (mmCreatePost *StorageMock) CreatePostAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmCreatePost.afterCreatePostCounter)
+// CompleteIdempotencyKeyAfterCounter returns a count of finished StorageMock.CompleteIdempotencyKey invocations
+func (mmCompleteIdempotencyKey *StorageMock) CompleteIdempotencyKeyAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCompleteIdempotencyKey.afterCompleteIdempotencyKeyCounter)
 }
 
-// CreatePostBeforeCounter returns a count of StorageMock.CreatePost invocations
-func (mmCreatePost *StorageMock) CreatePostBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmCreatePost.beforeCreatePostCounter)
+// CompleteIdempotencyKeyBeforeCounter returns a count of StorageMock.CompleteIdempotencyKey invocations
+func (mmCompleteIdempotencyKey *StorageMock) CompleteIdempotencyKeyBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCompleteIdempotencyKey.beforeCompleteIdempotencyKeyCounter)
 }
 
-// Calls returns a list of arguments used in each call to StorageMock.CreatePost.
+// Calls returns a list of arguments used in each call to StorageMock.CompleteIdempotencyKey.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmCreatePost *mStorageMockCreatePost) Calls() []*StorageMockCreatePostParams {
-	mmCreatePost.mutex.RLock()
+func (mmCompleteIdempotencyKey *mStorageMockCompleteIdempotencyKey) Calls() []*StorageMockCompleteIdempotencyKeyParams {
+	mmCompleteIdempotencyKey.mutex.RLock()
 
-	argCopy := make([]*StorageMockCreatePostParams, len(mmCreatePost.callArgs))
-	copy(argCopy, mmCreatePost.callArgs)
+	argCopy := make([]*StorageMockCompleteIdempotencyKeyParams, len(mmCompleteIdempotencyKey.callArgs))
+	copy(argCopy, mmCompleteIdempotencyKey.callArgs)
 
-	mmCreatePost.mutex.RUnlock()
+	mmCompleteIdempotencyKey.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockCreatePostDone returns true if the count of the CreatePost invocations corresponds
+// MinimockCompleteIdempotencyKeyDone returns true if the count of the CompleteIdempotencyKey invocations corresponds
 // the number of defined expectations
-func (m *StorageMock) MinimockCreatePostDone() bool {
-	if m.CreatePostMock.optional {
+func (m *StorageMock) MinimockCompleteIdempotencyKeyDone() bool {
+	if m.CompleteIdempotencyKeyMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.CreatePostMock.expectations {
+	for _, e := range m.CompleteIdempotencyKeyMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.CreatePostMock.invocationsDone()
+	return m.CompleteIdempotencyKeyMock.invocationsDone()
 }
 
-// MinimockCreatePostInspect logs each unmet expectation
-func (m *StorageMock) MinimockCreatePostInspect() {
-	for _, e := range m.CreatePostMock.expectations {
+// MinimockCompleteIdempotencyKeyInspect logs each unmet expectation
+func (m *StorageMock) MinimockCompleteIdempotencyKeyInspect() {
+	for _, e := range m.CompleteIdempotencyKeyMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to StorageMock.CreatePost at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to StorageMock.CompleteIdempotencyKey at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterCreatePostCounter := mm_atomic.LoadUint64(&m.afterCreatePostCounter)
+	afterCompleteIdempotencyKeyCounter := mm_atomic.LoadUint64(&m.afterCompleteIdempotencyKeyCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.CreatePostMock.defaultExpectation != nil && afterCreatePostCounter < 1 {
-		if m.CreatePostMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to StorageMock.CreatePost at\n%s", m.CreatePostMock.defaultExpectation.returnOrigin)
+	if m.CompleteIdempotencyKeyMock.defaultExpectation != nil && afterCompleteIdempotencyKeyCounter < 1 {
+		if m.CompleteIdempotencyKeyMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to StorageMock.CompleteIdempotencyKey at\n%s", m.CompleteIdempotencyKeyMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to StorageMock.CreatePost at\n%s with params: %#v", m.CreatePostMock.defaultExpectation.expectationOrigins.origin, *m.CreatePostMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to StorageMock.CompleteIdempotencyKey at\n%s with params: %#v", m.CompleteIdempotencyKeyMock.defaultExpectation.expectationOrigins.origin, *m.CompleteIdempotencyKeyMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcCreatePost != nil && afterCreatePostCounter < 1 {
-		m.t.Errorf("Expected call to StorageMock.CreatePost at\n%s", m.funcCreatePostOrigin)
+	if m.funcCompleteIdempotencyKey != nil && afterCompleteIdempotencyKeyCounter < 1 {
+		m.t.Errorf("Expected call to StorageMock.CompleteIdempotencyKey at\n%s", m.funcCompleteIdempotencyKeyOrigin)
 	}
 
-	if !m.CreatePostMock.invocationsDone() && afterCreatePostCounter > 0 {
-		m.t.Errorf("Expected %d calls to StorageMock.CreatePost at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.CreatePostMock.expectedInvocations), m.CreatePostMock.expectedInvocationsOrigin, afterCreatePostCounter)
+	if !m.CompleteIdempotencyKeyMock.invocationsDone() && afterCompleteIdempotencyKeyCounter > 0 {
+		m.t.Errorf("Expected %d calls to StorageMock.CompleteIdempotencyKey at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CompleteIdempotencyKeyMock.expectedInvocations), m.CompleteIdempotencyKeyMock.expectedInvocationsOrigin, afterCompleteIdempotencyKeyCounter)
 	}
 }
 
-type mStorageMockGetCommentsByPostID struct {
+type mStorageMockCreateAuditEntry struct {
 	optional           bool
 	mock               *StorageMock
-	defaultExpectation *StorageMockGetCommentsByPostIDExpectation
-	expectations       []*StorageMockGetCommentsByPostIDExpectation
+	defaultExpectation *StorageMockCreateAuditEntryExpectation
+	expectations       []*StorageMockCreateAuditEntryExpectation
 
-	callArgs []*StorageMockGetCommentsByPostIDParams
+	callArgs []*StorageMockCreateAuditEntryParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// StorageMockGetCommentsByPostIDExpectation specifies expectation struct of the Storage.GetCommentsByPostID
-type StorageMockGetCommentsByPostIDExpectation struct {
+// StorageMockCreateAuditEntryExpectation specifies expectation struct of the Storage.CreateAuditEntry
+type StorageMockCreateAuditEntryExpectation struct {
 	mock               *StorageMock
-	params             *StorageMockGetCommentsByPostIDParams
-	paramPtrs          *StorageMockGetCommentsByPostIDParamPtrs
-	expectationOrigins StorageMockGetCommentsByPostIDExpectationOrigins
-	results            *StorageMockGetCommentsByPostIDResults
+	params             *StorageMockCreateAuditEntryParams
+	paramPtrs          *StorageMockCreateAuditEntryParamPtrs
+	expectationOrigins StorageMockCreateAuditEntryExpectationOrigins
+	results            *StorageMockCreateAuditEntryResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// StorageMockGetCommentsByPostIDParams contains parameters of the Storage.GetCommentsByPostID
-type StorageMockGetCommentsByPostIDParams struct {
-	ctx    context.Context
-	postID string
-	limit  int
-	offset int
+// StorageMockCreateAuditEntryParams contains parameters of the Storage.CreateAuditEntry
+type StorageMockCreateAuditEntryParams struct {
+	ctx   context.Context
+	entry *model.AuditEntry
 }
 
-// StorageMockGetCommentsByPostIDParamPtrs contains pointers to parameters of the Storage.GetCommentsByPostID
-type StorageMockGetCommentsByPostIDParamPtrs struct {
-	ctx    *context.Context
-	postID *string
-	limit  *int
-	offset *int
+// StorageMockCreateAuditEntryParamPtrs contains pointers to parameters of the Storage.CreateAuditEntry
+type StorageMockCreateAuditEntryParamPtrs struct {
+	ctx   *context.Context
+	entry **model.AuditEntry
 }
 
-// StorageMockGetCommentsByPostIDResults contains results of the Storage.GetCommentsByPostID
-type StorageMockGetCommentsByPostIDResults struct {
-	cpa1 []*model.Comment
-	err  error
+// StorageMockCreateAuditEntryResults contains results of the Storage.CreateAuditEntry
+type StorageMockCreateAuditEntryResults struct {
+	err error
 }
 
-// StorageMockGetCommentsByPostIDOrigins contains origins of expectations of the Storage.GetCommentsByPostID
-type StorageMockGetCommentsByPostIDExpectationOrigins struct {
-	origin       string
-	originCtx    string
-	originPostID string
-	originLimit  string
-	originOffset string
+// StorageMockCreateAuditEntryOrigins contains origins of expectations of the Storage.CreateAuditEntry
+type StorageMockCreateAuditEntryExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originEntry string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -847,398 +1095,344 @@ type StorageMockGetCommentsByPostIDExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmGetCommentsByPostID *mStorageMockGetCommentsByPostID) Optional() *mStorageMockGetCommentsByPostID {
-	mmGetCommentsByPostID.optional = true
-	return mmGetCommentsByPostID
+func (mmCreateAuditEntry *mStorageMockCreateAuditEntry) Optional() *mStorageMockCreateAuditEntry {
+	mmCreateAuditEntry.optional = true
+	return mmCreateAuditEntry
 }
 
-// Expect sets up expected params for Storage.GetCommentsByPostID
-func (mmGetCommentsByPostID *mStorageMockGetCommentsByPostID) Expect(ctx context.Context, postID string, limit int, offset int) *mStorageMockGetCommentsByPostID {
-	if mmGetCommentsByPostID.mock.funcGetCommentsByPostID != nil {
-		mmGetCommentsByPostID.mock.t.Fatalf("StorageMock.GetCommentsByPostID mock is already set by Set")
+// Expect sets up expected params for Storage.CreateAuditEntry
+func (mmCreateAuditEntry *mStorageMockCreateAuditEntry) Expect(ctx context.Context, entry *model.AuditEntry) *mStorageMockCreateAuditEntry {
+	if mmCreateAuditEntry.mock.funcCreateAuditEntry != nil {
+		mmCreateAuditEntry.mock.t.Fatalf("StorageMock.CreateAuditEntry mock is already set by Set")
 	}
 
-	if mmGetCommentsByPostID.defaultExpectation == nil {
-		mmGetCommentsByPostID.defaultExpectation = &StorageMockGetCommentsByPostIDExpectation{}
+	if mmCreateAuditEntry.defaultExpectation == nil {
+		mmCreateAuditEntry.defaultExpectation = &StorageMockCreateAuditEntryExpectation{}
 	}
 
-	if mmGetCommentsByPostID.defaultExpectation.paramPtrs != nil {
-		mmGetCommentsByPostID.mock.t.Fatalf("StorageMock.GetCommentsByPostID mock is already set by ExpectParams functions")
+	if mmCreateAuditEntry.defaultExpectation.paramPtrs != nil {
+		mmCreateAuditEntry.mock.t.Fatalf("StorageMock.CreateAuditEntry mock is already set by ExpectParams functions")
 	}
 
-	mmGetCommentsByPostID.defaultExpectation.params = &StorageMockGetCommentsByPostIDParams{ctx, postID, limit, offset}
-	mmGetCommentsByPostID.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmGetCommentsByPostID.expectations {
-		if minimock.Equal(e.params, mmGetCommentsByPostID.defaultExpectation.params) {
-			mmGetCommentsByPostID.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetCommentsByPostID.defaultExpectation.params)
+	mmCreateAuditEntry.defaultExpectation.params = &StorageMockCreateAuditEntryParams{ctx, entry}
+	mmCreateAuditEntry.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreateAuditEntry.expectations {
+		if minimock.Equal(e.params, mmCreateAuditEntry.defaultExpectation.params) {
+			mmCreateAuditEntry.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateAuditEntry.defaultExpectation.params)
 		}
 	}
 
-	return mmGetCommentsByPostID
+	return mmCreateAuditEntry
 }
 
-// ExpectCtxParam1 sets up expected param ctx for Storage.GetCommentsByPostID
-func (mmGetCommentsByPostID *mStorageMockGetCommentsByPostID) ExpectCtxParam1(ctx context.Context) *mStorageMockGetCommentsByPostID {
-	if mmGetCommentsByPostID.mock.funcGetCommentsByPostID != nil {
-		mmGetCommentsByPostID.mock.t.Fatalf("StorageMock.GetCommentsByPostID mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for Storage.CreateAuditEntry
+func (mmCreateAuditEntry *mStorageMockCreateAuditEntry) ExpectCtxParam1(ctx context.Context) *mStorageMockCreateAuditEntry {
+	if mmCreateAuditEntry.mock.funcCreateAuditEntry != nil {
+		mmCreateAuditEntry.mock.t.Fatalf("StorageMock.CreateAuditEntry mock is already set by Set")
 	}
 
-	if mmGetCommentsByPostID.defaultExpectation == nil {
-		mmGetCommentsByPostID.defaultExpectation = &StorageMockGetCommentsByPostIDExpectation{}
+	if mmCreateAuditEntry.defaultExpectation == nil {
+		mmCreateAuditEntry.defaultExpectation = &StorageMockCreateAuditEntryExpectation{}
 	}
 
-	if mmGetCommentsByPostID.defaultExpectation.params != nil {
-		mmGetCommentsByPostID.mock.t.Fatalf("StorageMock.GetCommentsByPostID mock is already set by Expect")
+	if mmCreateAuditEntry.defaultExpectation.params != nil {
+		mmCreateAuditEntry.mock.t.Fatalf("StorageMock.CreateAuditEntry mock is already set by Expect")
 	}
 
-	if mmGetCommentsByPostID.defaultExpectation.paramPtrs == nil {
-		mmGetCommentsByPostID.defaultExpectation.paramPtrs = &StorageMockGetCommentsByPostIDParamPtrs{}
+	if mmCreateAuditEntry.defaultExpectation.paramPtrs == nil {
+		mmCreateAuditEntry.defaultExpectation.paramPtrs = &StorageMockCreateAuditEntryParamPtrs{}
 	}
-	mmGetCommentsByPostID.defaultExpectation.paramPtrs.ctx = &ctx
-	mmGetCommentsByPostID.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmCreateAuditEntry.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCreateAuditEntry.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmGetCommentsByPostID
+	return mmCreateAuditEntry
 }
 
-// ExpectPostIDParam2 sets up expected param postID for Storage.GetCommentsByPostID
-func (mmGetCommentsByPostID *mStorageMockGetCommentsByPostID) ExpectPostIDParam2(postID string) *mStorageMockGetCommentsByPostID {
-	if mmGetCommentsByPostID.mock.funcGetCommentsByPostID != nil {
-		mmGetCommentsByPostID.mock.t.Fatalf("StorageMock.GetCommentsByPostID mock is already set by Set")
+// ExpectEntryParam2 sets up expected param entry for Storage.CreateAuditEntry
+func (mmCreateAuditEntry *mStorageMockCreateAuditEntry) ExpectEntryParam2(entry *model.AuditEntry) *mStorageMockCreateAuditEntry {
+	if mmCreateAuditEntry.mock.funcCreateAuditEntry != nil {
+		mmCreateAuditEntry.mock.t.Fatalf("StorageMock.CreateAuditEntry mock is already set by Set")
 	}
 
-	if mmGetCommentsByPostID.defaultExpectation == nil {
-		mmGetCommentsByPostID.defaultExpectation = &StorageMockGetCommentsByPostIDExpectation{}
+	if mmCreateAuditEntry.defaultExpectation == nil {
+		mmCreateAuditEntry.defaultExpectation = &StorageMockCreateAuditEntryExpectation{}
 	}
 
-	if mmGetCommentsByPostID.defaultExpectation.params != nil {
-		mmGetCommentsByPostID.mock.t.Fatalf("StorageMock.GetCommentsByPostID mock is already set by Expect")
+	if mmCreateAuditEntry.defaultExpectation.params != nil {
+		mmCreateAuditEntry.mock.t.Fatalf("StorageMock.CreateAuditEntry mock is already set by Expect")
 	}
 
-	if mmGetCommentsByPostID.defaultExpectation.paramPtrs == nil {
-		mmGetCommentsByPostID.defaultExpectation.paramPtrs = &StorageMockGetCommentsByPostIDParamPtrs{}
+	if mmCreateAuditEntry.defaultExpectation.paramPtrs == nil {
+		mmCreateAuditEntry.defaultExpectation.paramPtrs = &StorageMockCreateAuditEntryParamPtrs{}
 	}
-	mmGetCommentsByPostID.defaultExpectation.paramPtrs.postID = &postID
-	mmGetCommentsByPostID.defaultExpectation.expectationOrigins.originPostID = minimock.CallerInfo(1)
+	mmCreateAuditEntry.defaultExpectation.paramPtrs.entry = &entry
+	mmCreateAuditEntry.defaultExpectation.expectationOrigins.originEntry = minimock.CallerInfo(1)
 
-	return mmGetCommentsByPostID
+	return mmCreateAuditEntry
 }
 
-// ExpectLimitParam3 sets up expected param limit for Storage.GetCommentsByPostID
-func (mmGetCommentsByPostID *mStorageMockGetCommentsByPostID) ExpectLimitParam3(limit int) *mStorageMockGetCommentsByPostID {
-	if mmGetCommentsByPostID.mock.funcGetCommentsByPostID != nil {
-		mmGetCommentsByPostID.mock.t.Fatalf("StorageMock.GetCommentsByPostID mock is already set by Set")
-	}
-
-	if mmGetCommentsByPostID.defaultExpectation == nil {
-		mmGetCommentsByPostID.defaultExpectation = &StorageMockGetCommentsByPostIDExpectation{}
+// Inspect accepts an inspector function that has same arguments as the Storage.CreateAuditEntry
+func (mmCreateAuditEntry *mStorageMockCreateAuditEntry) Inspect(f func(ctx context.Context, entry *model.AuditEntry)) *mStorageMockCreateAuditEntry {
+	if mmCreateAuditEntry.mock.inspectFuncCreateAuditEntry != nil {
+		mmCreateAuditEntry.mock.t.Fatalf("Inspect function is already set for StorageMock.CreateAuditEntry")
 	}
 
-	if mmGetCommentsByPostID.defaultExpectation.params != nil {
-		mmGetCommentsByPostID.mock.t.Fatalf("StorageMock.GetCommentsByPostID mock is already set by Expect")
-	}
-
-	if mmGetCommentsByPostID.defaultExpectation.paramPtrs == nil {
-		mmGetCommentsByPostID.defaultExpectation.paramPtrs = &StorageMockGetCommentsByPostIDParamPtrs{}
-	}
-	mmGetCommentsByPostID.defaultExpectation.paramPtrs.limit = &limit
-	mmGetCommentsByPostID.defaultExpectation.expectationOrigins.originLimit = minimock.CallerInfo(1)
+	mmCreateAuditEntry.mock.inspectFuncCreateAuditEntry = f
 
-	return mmGetCommentsByPostID
+	return mmCreateAuditEntry
 }
 
-// ExpectOffsetParam4 sets up expected param offset for Storage.GetCommentsByPostID
-func (mmGetCommentsByPostID *mStorageMockGetCommentsByPostID) ExpectOffsetParam4(offset int) *mStorageMockGetCommentsByPostID {
-	if mmGetCommentsByPostID.mock.funcGetCommentsByPostID != nil {
-		mmGetCommentsByPostID.mock.t.Fatalf("StorageMock.GetCommentsByPostID mock is already set by Set")
+// Return sets up results that will be returned by Storage.CreateAuditEntry
+func (mmCreateAuditEntry *mStorageMockCreateAuditEntry) Return(err error) *StorageMock {
+	if mmCreateAuditEntry.mock.funcCreateAuditEntry != nil {
+		mmCreateAuditEntry.mock.t.Fatalf("StorageMock.CreateAuditEntry mock is already set by Set")
 	}
 
-	if mmGetCommentsByPostID.defaultExpectation == nil {
-		mmGetCommentsByPostID.defaultExpectation = &StorageMockGetCommentsByPostIDExpectation{}
+	if mmCreateAuditEntry.defaultExpectation == nil {
+		mmCreateAuditEntry.defaultExpectation = &StorageMockCreateAuditEntryExpectation{mock: mmCreateAuditEntry.mock}
 	}
+	mmCreateAuditEntry.defaultExpectation.results = &StorageMockCreateAuditEntryResults{err}
+	mmCreateAuditEntry.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreateAuditEntry.mock
+}
 
-	if mmGetCommentsByPostID.defaultExpectation.params != nil {
-		mmGetCommentsByPostID.mock.t.Fatalf("StorageMock.GetCommentsByPostID mock is already set by Expect")
+// Set uses given function f to mock the Storage.CreateAuditEntry method
+func (mmCreateAuditEntry *mStorageMockCreateAuditEntry) Set(f func(ctx context.Context, entry *model.AuditEntry) (err error)) *StorageMock {
+	if mmCreateAuditEntry.defaultExpectation != nil {
+		mmCreateAuditEntry.mock.t.Fatalf("Default expectation is already set for the Storage.CreateAuditEntry method")
 	}
 
-	if mmGetCommentsByPostID.defaultExpectation.paramPtrs == nil {
-		mmGetCommentsByPostID.defaultExpectation.paramPtrs = &StorageMockGetCommentsByPostIDParamPtrs{}
+	if len(mmCreateAuditEntry.expectations) > 0 {
+		mmCreateAuditEntry.mock.t.Fatalf("Some expectations are already set for the Storage.CreateAuditEntry method")
 	}
-	mmGetCommentsByPostID.defaultExpectation.paramPtrs.offset = &offset
-	mmGetCommentsByPostID.defaultExpectation.expectationOrigins.originOffset = minimock.CallerInfo(1)
 
-	return mmGetCommentsByPostID
+	mmCreateAuditEntry.mock.funcCreateAuditEntry = f
+	mmCreateAuditEntry.mock.funcCreateAuditEntryOrigin = minimock.CallerInfo(1)
+	return mmCreateAuditEntry.mock
 }
 
-// Inspect accepts an inspector function that has same arguments as the Storage.GetCommentsByPostID
-func (mmGetCommentsByPostID *mStorageMockGetCommentsByPostID) Inspect(f func(ctx context.Context, postID string, limit int, offset int)) *mStorageMockGetCommentsByPostID {
-	if mmGetCommentsByPostID.mock.inspectFuncGetCommentsByPostID != nil {
-		mmGetCommentsByPostID.mock.t.Fatalf("Inspect function is already set for StorageMock.GetCommentsByPostID")
+// When sets expectation for the Storage.CreateAuditEntry which will trigger the result defined by the following
+// Then helper
+func (mmCreateAuditEntry *mStorageMockCreateAuditEntry) When(ctx context.Context, entry *model.AuditEntry) *StorageMockCreateAuditEntryExpectation {
+	if mmCreateAuditEntry.mock.funcCreateAuditEntry != nil {
+		mmCreateAuditEntry.mock.t.Fatalf("StorageMock.CreateAuditEntry mock is already set by Set")
 	}
 
-	mmGetCommentsByPostID.mock.inspectFuncGetCommentsByPostID = f
+	expectation := &StorageMockCreateAuditEntryExpectation{
+		mock:               mmCreateAuditEntry.mock,
+		params:             &StorageMockCreateAuditEntryParams{ctx, entry},
+		expectationOrigins: StorageMockCreateAuditEntryExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCreateAuditEntry.expectations = append(mmCreateAuditEntry.expectations, expectation)
+	return expectation
+}
 
-	return mmGetCommentsByPostID
+// Then sets up Storage.CreateAuditEntry return parameters for the expectation previously defined by the When method
+func (e *StorageMockCreateAuditEntryExpectation) Then(err error) *StorageMock {
+	e.results = &StorageMockCreateAuditEntryResults{err}
+	return e.mock
 }
 
-// Return sets up results that will be returned by Storage.GetCommentsByPostID
-func (mmGetCommentsByPostID *mStorageMockGetCommentsByPostID) Return(cpa1 []*model.Comment, err error) *StorageMock {
-	if mmGetCommentsByPostID.mock.funcGetCommentsByPostID != nil {
-		mmGetCommentsByPostID.mock.t.Fatalf("StorageMock.GetCommentsByPostID mock is already set by Set")
+// Times sets number of times Storage.CreateAuditEntry should be invoked
+func (mmCreateAuditEntry *mStorageMockCreateAuditEntry) Times(n uint64) *mStorageMockCreateAuditEntry {
+	if n == 0 {
+		mmCreateAuditEntry.mock.t.Fatalf("Times of StorageMock.CreateAuditEntry mock can not be zero")
 	}
+	mm_atomic.StoreUint64(&mmCreateAuditEntry.expectedInvocations, n)
+	mmCreateAuditEntry.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreateAuditEntry
+}
 
-	if mmGetCommentsByPostID.defaultExpectation == nil {
-		mmGetCommentsByPostID.defaultExpectation = &StorageMockGetCommentsByPostIDExpectation{mock: mmGetCommentsByPostID.mock}
+func (mmCreateAuditEntry *mStorageMockCreateAuditEntry) invocationsDone() bool {
+	if len(mmCreateAuditEntry.expectations) == 0 && mmCreateAuditEntry.defaultExpectation == nil && mmCreateAuditEntry.mock.funcCreateAuditEntry == nil {
+		return true
 	}
-	mmGetCommentsByPostID.defaultExpectation.results = &StorageMockGetCommentsByPostIDResults{cpa1, err}
-	mmGetCommentsByPostID.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmGetCommentsByPostID.mock
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreateAuditEntry.mock.afterCreateAuditEntryCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreateAuditEntry.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// Set uses given function f to mock the Storage.GetCommentsByPostID method
-func (mmGetCommentsByPostID *mStorageMockGetCommentsByPostID) Set(f func(ctx context.Context, postID string, limit int, offset int) (cpa1 []*model.Comment, err error)) *StorageMock {
-	if mmGetCommentsByPostID.defaultExpectation != nil {
-		mmGetCommentsByPostID.mock.t.Fatalf("Default expectation is already set for the Storage.GetCommentsByPostID method")
+// CreateAuditEntry implements mm_storage.Storage
+func (mmCreateAuditEntry *StorageMock) CreateAuditEntry(ctx context.Context, entry *model.AuditEntry) (err error) {
+	mm_atomic.AddUint64(&mmCreateAuditEntry.beforeCreateAuditEntryCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreateAuditEntry.afterCreateAuditEntryCounter, 1)
+
+	mmCreateAuditEntry.t.Helper()
+
+	if mmCreateAuditEntry.inspectFuncCreateAuditEntry != nil {
+		mmCreateAuditEntry.inspectFuncCreateAuditEntry(ctx, entry)
 	}
 
-	if len(mmGetCommentsByPostID.expectations) > 0 {
-		mmGetCommentsByPostID.mock.t.Fatalf("Some expectations are already set for the Storage.GetCommentsByPostID method")
-	}
-
-	mmGetCommentsByPostID.mock.funcGetCommentsByPostID = f
-	mmGetCommentsByPostID.mock.funcGetCommentsByPostIDOrigin = minimock.CallerInfo(1)
-	return mmGetCommentsByPostID.mock
-}
-
-// When sets expectation for the Storage.GetCommentsByPostID which will trigger the result defined by the following
-// Then helper
-func (mmGetCommentsByPostID *mStorageMockGetCommentsByPostID) When(ctx context.Context, postID string, limit int, offset int) *StorageMockGetCommentsByPostIDExpectation {
-	if mmGetCommentsByPostID.mock.funcGetCommentsByPostID != nil {
-		mmGetCommentsByPostID.mock.t.Fatalf("StorageMock.GetCommentsByPostID mock is already set by Set")
-	}
-
-	expectation := &StorageMockGetCommentsByPostIDExpectation{
-		mock:               mmGetCommentsByPostID.mock,
-		params:             &StorageMockGetCommentsByPostIDParams{ctx, postID, limit, offset},
-		expectationOrigins: StorageMockGetCommentsByPostIDExpectationOrigins{origin: minimock.CallerInfo(1)},
-	}
-	mmGetCommentsByPostID.expectations = append(mmGetCommentsByPostID.expectations, expectation)
-	return expectation
-}
-
-// Then sets up Storage.GetCommentsByPostID return parameters for the expectation previously defined by the When method
-func (e *StorageMockGetCommentsByPostIDExpectation) Then(cpa1 []*model.Comment, err error) *StorageMock {
-	e.results = &StorageMockGetCommentsByPostIDResults{cpa1, err}
-	return e.mock
-}
-
-// Times sets number of times Storage.GetCommentsByPostID should be invoked
-func (mmGetCommentsByPostID *mStorageMockGetCommentsByPostID) Times(n uint64) *mStorageMockGetCommentsByPostID {
-	if n == 0 {
-		mmGetCommentsByPostID.mock.t.Fatalf("Times of StorageMock.GetCommentsByPostID mock can not be zero")
-	}
-	mm_atomic.StoreUint64(&mmGetCommentsByPostID.expectedInvocations, n)
-	mmGetCommentsByPostID.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmGetCommentsByPostID
-}
-
-func (mmGetCommentsByPostID *mStorageMockGetCommentsByPostID) invocationsDone() bool {
-	if len(mmGetCommentsByPostID.expectations) == 0 && mmGetCommentsByPostID.defaultExpectation == nil && mmGetCommentsByPostID.mock.funcGetCommentsByPostID == nil {
-		return true
-	}
-
-	totalInvocations := mm_atomic.LoadUint64(&mmGetCommentsByPostID.mock.afterGetCommentsByPostIDCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmGetCommentsByPostID.expectedInvocations)
-
-	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
-}
-
-// GetCommentsByPostID implements mm_storage.Storage
-func (mmGetCommentsByPostID *StorageMock) GetCommentsByPostID(ctx context.Context, postID string, limit int, offset int) (cpa1 []*model.Comment, err error) {
-	mm_atomic.AddUint64(&mmGetCommentsByPostID.beforeGetCommentsByPostIDCounter, 1)
-	defer mm_atomic.AddUint64(&mmGetCommentsByPostID.afterGetCommentsByPostIDCounter, 1)
-
-	mmGetCommentsByPostID.t.Helper()
-
-	if mmGetCommentsByPostID.inspectFuncGetCommentsByPostID != nil {
-		mmGetCommentsByPostID.inspectFuncGetCommentsByPostID(ctx, postID, limit, offset)
-	}
-
-	mm_params := StorageMockGetCommentsByPostIDParams{ctx, postID, limit, offset}
+	mm_params := StorageMockCreateAuditEntryParams{ctx, entry}
 
 	// Record call args
-	mmGetCommentsByPostID.GetCommentsByPostIDMock.mutex.Lock()
-	mmGetCommentsByPostID.GetCommentsByPostIDMock.callArgs = append(mmGetCommentsByPostID.GetCommentsByPostIDMock.callArgs, &mm_params)
-	mmGetCommentsByPostID.GetCommentsByPostIDMock.mutex.Unlock()
+	mmCreateAuditEntry.CreateAuditEntryMock.mutex.Lock()
+	mmCreateAuditEntry.CreateAuditEntryMock.callArgs = append(mmCreateAuditEntry.CreateAuditEntryMock.callArgs, &mm_params)
+	mmCreateAuditEntry.CreateAuditEntryMock.mutex.Unlock()
 
-	for _, e := range mmGetCommentsByPostID.GetCommentsByPostIDMock.expectations {
+	for _, e := range mmCreateAuditEntry.CreateAuditEntryMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.cpa1, e.results.err
+			return e.results.err
 		}
 	}
 
-	if mmGetCommentsByPostID.GetCommentsByPostIDMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmGetCommentsByPostID.GetCommentsByPostIDMock.defaultExpectation.Counter, 1)
-		mm_want := mmGetCommentsByPostID.GetCommentsByPostIDMock.defaultExpectation.params
-		mm_want_ptrs := mmGetCommentsByPostID.GetCommentsByPostIDMock.defaultExpectation.paramPtrs
+	if mmCreateAuditEntry.CreateAuditEntryMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreateAuditEntry.CreateAuditEntryMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreateAuditEntry.CreateAuditEntryMock.defaultExpectation.params
+		mm_want_ptrs := mmCreateAuditEntry.CreateAuditEntryMock.defaultExpectation.paramPtrs
 
-		mm_got := StorageMockGetCommentsByPostIDParams{ctx, postID, limit, offset}
+		mm_got := StorageMockCreateAuditEntryParams{ctx, entry}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmGetCommentsByPostID.t.Errorf("StorageMock.GetCommentsByPostID got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmGetCommentsByPostID.GetCommentsByPostIDMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
-			}
-
-			if mm_want_ptrs.postID != nil && !minimock.Equal(*mm_want_ptrs.postID, mm_got.postID) {
-				mmGetCommentsByPostID.t.Errorf("StorageMock.GetCommentsByPostID got unexpected parameter postID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmGetCommentsByPostID.GetCommentsByPostIDMock.defaultExpectation.expectationOrigins.originPostID, *mm_want_ptrs.postID, mm_got.postID, minimock.Diff(*mm_want_ptrs.postID, mm_got.postID))
-			}
-
-			if mm_want_ptrs.limit != nil && !minimock.Equal(*mm_want_ptrs.limit, mm_got.limit) {
-				mmGetCommentsByPostID.t.Errorf("StorageMock.GetCommentsByPostID got unexpected parameter limit, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmGetCommentsByPostID.GetCommentsByPostIDMock.defaultExpectation.expectationOrigins.originLimit, *mm_want_ptrs.limit, mm_got.limit, minimock.Diff(*mm_want_ptrs.limit, mm_got.limit))
+				mmCreateAuditEntry.t.Errorf("StorageMock.CreateAuditEntry got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateAuditEntry.CreateAuditEntryMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.offset != nil && !minimock.Equal(*mm_want_ptrs.offset, mm_got.offset) {
-				mmGetCommentsByPostID.t.Errorf("StorageMock.GetCommentsByPostID got unexpected parameter offset, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmGetCommentsByPostID.GetCommentsByPostIDMock.defaultExpectation.expectationOrigins.originOffset, *mm_want_ptrs.offset, mm_got.offset, minimock.Diff(*mm_want_ptrs.offset, mm_got.offset))
+			if mm_want_ptrs.entry != nil && !minimock.Equal(*mm_want_ptrs.entry, mm_got.entry) {
+				mmCreateAuditEntry.t.Errorf("StorageMock.CreateAuditEntry got unexpected parameter entry, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateAuditEntry.CreateAuditEntryMock.defaultExpectation.expectationOrigins.originEntry, *mm_want_ptrs.entry, mm_got.entry, minimock.Diff(*mm_want_ptrs.entry, mm_got.entry))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmGetCommentsByPostID.t.Errorf("StorageMock.GetCommentsByPostID got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmGetCommentsByPostID.GetCommentsByPostIDMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmCreateAuditEntry.t.Errorf("StorageMock.CreateAuditEntry got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreateAuditEntry.CreateAuditEntryMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmGetCommentsByPostID.GetCommentsByPostIDMock.defaultExpectation.results
+		mm_results := mmCreateAuditEntry.CreateAuditEntryMock.defaultExpectation.results
 		if mm_results == nil {
-			mmGetCommentsByPostID.t.Fatal("No results are set for the StorageMock.GetCommentsByPostID")
+			mmCreateAuditEntry.t.Fatal("No results are set for the StorageMock.CreateAuditEntry")
 		}
-		return (*mm_results).cpa1, (*mm_results).err
+		return (*mm_results).err
 	}
-	if mmGetCommentsByPostID.funcGetCommentsByPostID != nil {
-		return mmGetCommentsByPostID.funcGetCommentsByPostID(ctx, postID, limit, offset)
+	if mmCreateAuditEntry.funcCreateAuditEntry != nil {
+		return mmCreateAuditEntry.funcCreateAuditEntry(ctx, entry)
 	}
-	mmGetCommentsByPostID.t.Fatalf("Unexpected call to StorageMock.GetCommentsByPostID. %v %v %v %v", ctx, postID, limit, offset)
+	mmCreateAuditEntry.t.Fatalf("Unexpected call to StorageMock.CreateAuditEntry. %v %v", ctx, entry)
 	return
 }
 
-// GetCommentsByPostIDAfterCounter returns a count of finished StorageMock.GetCommentsByPostID invocations
-func (mmGetCommentsByPostID *StorageMock) GetCommentsByPostIDAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmGetCommentsByPostID.afterGetCommentsByPostIDCounter)
+// CreateAuditEntryAfterCounter returns a count of finished StorageMock.CreateAuditEntry invocations
+func (mmCreateAuditEntry *StorageMock) CreateAuditEntryAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateAuditEntry.afterCreateAuditEntryCounter)
 }
 
-// GetCommentsByPostIDBeforeCounter returns a count of StorageMock.GetCommentsByPostID invocations
-func (mmGetCommentsByPostID *StorageMock) GetCommentsByPostIDBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmGetCommentsByPostID.beforeGetCommentsByPostIDCounter)
+// CreateAuditEntryBeforeCounter returns a count of StorageMock.CreateAuditEntry invocations
+func (mmCreateAuditEntry *StorageMock) CreateAuditEntryBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateAuditEntry.beforeCreateAuditEntryCounter)
 }
 
-// Calls returns a list of arguments used in each call to StorageMock.GetCommentsByPostID.
+// Calls returns a list of arguments used in each call to StorageMock.CreateAuditEntry.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmGetCommentsByPostID *mStorageMockGetCommentsByPostID) Calls() []*StorageMockGetCommentsByPostIDParams {
-	mmGetCommentsByPostID.mutex.RLock()
+func (mmCreateAuditEntry *mStorageMockCreateAuditEntry) Calls() []*StorageMockCreateAuditEntryParams {
+	mmCreateAuditEntry.mutex.RLock()
 
-	argCopy := make([]*StorageMockGetCommentsByPostIDParams, len(mmGetCommentsByPostID.callArgs))
-	copy(argCopy, mmGetCommentsByPostID.callArgs)
+	argCopy := make([]*StorageMockCreateAuditEntryParams, len(mmCreateAuditEntry.callArgs))
+	copy(argCopy, mmCreateAuditEntry.callArgs)
 
-	mmGetCommentsByPostID.mutex.RUnlock()
+	mmCreateAuditEntry.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockGetCommentsByPostIDDone returns true if the count of the GetCommentsByPostID invocations corresponds
+// MinimockCreateAuditEntryDone returns true if the count of the CreateAuditEntry invocations corresponds
 // the number of defined expectations
-func (m *StorageMock) MinimockGetCommentsByPostIDDone() bool {
-	if m.GetCommentsByPostIDMock.optional {
+func (m *StorageMock) MinimockCreateAuditEntryDone() bool {
+	if m.CreateAuditEntryMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.GetCommentsByPostIDMock.expectations {
+	for _, e := range m.CreateAuditEntryMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.GetCommentsByPostIDMock.invocationsDone()
+	return m.CreateAuditEntryMock.invocationsDone()
 }
 
-// MinimockGetCommentsByPostIDInspect logs each unmet expectation
-func (m *StorageMock) MinimockGetCommentsByPostIDInspect() {
-	for _, e := range m.GetCommentsByPostIDMock.expectations {
+// MinimockCreateAuditEntryInspect logs each unmet expectation
+func (m *StorageMock) MinimockCreateAuditEntryInspect() {
+	for _, e := range m.CreateAuditEntryMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to StorageMock.GetCommentsByPostID at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to StorageMock.CreateAuditEntry at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterGetCommentsByPostIDCounter := mm_atomic.LoadUint64(&m.afterGetCommentsByPostIDCounter)
+	afterCreateAuditEntryCounter := mm_atomic.LoadUint64(&m.afterCreateAuditEntryCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.GetCommentsByPostIDMock.defaultExpectation != nil && afterGetCommentsByPostIDCounter < 1 {
-		if m.GetCommentsByPostIDMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to StorageMock.GetCommentsByPostID at\n%s", m.GetCommentsByPostIDMock.defaultExpectation.returnOrigin)
+	if m.CreateAuditEntryMock.defaultExpectation != nil && afterCreateAuditEntryCounter < 1 {
+		if m.CreateAuditEntryMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to StorageMock.CreateAuditEntry at\n%s", m.CreateAuditEntryMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to StorageMock.GetCommentsByPostID at\n%s with params: %#v", m.GetCommentsByPostIDMock.defaultExpectation.expectationOrigins.origin, *m.GetCommentsByPostIDMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to StorageMock.CreateAuditEntry at\n%s with params: %#v", m.CreateAuditEntryMock.defaultExpectation.expectationOrigins.origin, *m.CreateAuditEntryMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcGetCommentsByPostID != nil && afterGetCommentsByPostIDCounter < 1 {
-		m.t.Errorf("Expected call to StorageMock.GetCommentsByPostID at\n%s", m.funcGetCommentsByPostIDOrigin)
+	if m.funcCreateAuditEntry != nil && afterCreateAuditEntryCounter < 1 {
+		m.t.Errorf("Expected call to StorageMock.CreateAuditEntry at\n%s", m.funcCreateAuditEntryOrigin)
 	}
 
-	if !m.GetCommentsByPostIDMock.invocationsDone() && afterGetCommentsByPostIDCounter > 0 {
-		m.t.Errorf("Expected %d calls to StorageMock.GetCommentsByPostID at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.GetCommentsByPostIDMock.expectedInvocations), m.GetCommentsByPostIDMock.expectedInvocationsOrigin, afterGetCommentsByPostIDCounter)
+	if !m.CreateAuditEntryMock.invocationsDone() && afterCreateAuditEntryCounter > 0 {
+		m.t.Errorf("Expected %d calls to StorageMock.CreateAuditEntry at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateAuditEntryMock.expectedInvocations), m.CreateAuditEntryMock.expectedInvocationsOrigin, afterCreateAuditEntryCounter)
 	}
 }
 
-type mStorageMockGetPostByID struct {
+type mStorageMockCreateComment struct {
 	optional           bool
 	mock               *StorageMock
-	defaultExpectation *StorageMockGetPostByIDExpectation
-	expectations       []*StorageMockGetPostByIDExpectation
+	defaultExpectation *StorageMockCreateCommentExpectation
+	expectations       []*StorageMockCreateCommentExpectation
 
-	callArgs []*StorageMockGetPostByIDParams
+	callArgs []*StorageMockCreateCommentParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// StorageMockGetPostByIDExpectation specifies expectation struct of the Storage.GetPostByID
-type StorageMockGetPostByIDExpectation struct {
+// StorageMockCreateCommentExpectation specifies expectation struct of the Storage.CreateComment
+type StorageMockCreateCommentExpectation struct {
 	mock               *StorageMock
-	params             *StorageMockGetPostByIDParams
-	paramPtrs          *StorageMockGetPostByIDParamPtrs
-	expectationOrigins StorageMockGetPostByIDExpectationOrigins
-	results            *StorageMockGetPostByIDResults
+	params             *StorageMockCreateCommentParams
+	paramPtrs          *StorageMockCreateCommentParamPtrs
+	expectationOrigins StorageMockCreateCommentExpectationOrigins
+	results            *StorageMockCreateCommentResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// StorageMockGetPostByIDParams contains parameters of the Storage.GetPostByID
-type StorageMockGetPostByIDParams struct {
-	ctx context.Context
-	id  string
+// StorageMockCreateCommentParams contains parameters of the Storage.CreateComment
+type StorageMockCreateCommentParams struct {
+	ctx        context.Context
+	comment    *model.Comment
+	visibility mm_storage.Visibility
 }
 
-// StorageMockGetPostByIDParamPtrs contains pointers to parameters of the Storage.GetPostByID
-type StorageMockGetPostByIDParamPtrs struct {
-	ctx *context.Context
-	id  *string
+// StorageMockCreateCommentParamPtrs contains pointers to parameters of the Storage.CreateComment
+type StorageMockCreateCommentParamPtrs struct {
+	ctx        *context.Context
+	comment    **model.Comment
+	visibility *mm_storage.Visibility
 }
 
-// StorageMockGetPostByIDResults contains results of the Storage.GetPostByID
-type StorageMockGetPostByIDResults struct {
-	pp1 *model.Post
+// StorageMockCreateCommentResults contains results of the Storage.CreateComment
+type StorageMockCreateCommentResults struct {
 	err error
 }
 
-// StorageMockGetPostByIDOrigins contains origins of expectations of the Storage.GetPostByID
-type StorageMockGetPostByIDExpectationOrigins struct {
-	origin    string
-	originCtx string
-	originId  string
+// StorageMockCreateCommentOrigins contains origins of expectations of the Storage.CreateComment
+type StorageMockCreateCommentExpectationOrigins struct {
+	origin           string
+	originCtx        string
+	originComment    string
+	originVisibility string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -1246,339 +1440,372 @@ type StorageMockGetPostByIDExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmGetPostByID *mStorageMockGetPostByID) Optional() *mStorageMockGetPostByID {
-	mmGetPostByID.optional = true
-	return mmGetPostByID
+func (mmCreateComment *mStorageMockCreateComment) Optional() *mStorageMockCreateComment {
+	mmCreateComment.optional = true
+	return mmCreateComment
 }
 
-// Expect sets up expected params for Storage.GetPostByID
-func (mmGetPostByID *mStorageMockGetPostByID) Expect(ctx context.Context, id string) *mStorageMockGetPostByID {
-	if mmGetPostByID.mock.funcGetPostByID != nil {
-		mmGetPostByID.mock.t.Fatalf("StorageMock.GetPostByID mock is already set by Set")
+// Expect sets up expected params for Storage.CreateComment
+func (mmCreateComment *mStorageMockCreateComment) Expect(ctx context.Context, comment *model.Comment, visibility mm_storage.Visibility) *mStorageMockCreateComment {
+	if mmCreateComment.mock.funcCreateComment != nil {
+		mmCreateComment.mock.t.Fatalf("StorageMock.CreateComment mock is already set by Set")
 	}
 
-	if mmGetPostByID.defaultExpectation == nil {
-		mmGetPostByID.defaultExpectation = &StorageMockGetPostByIDExpectation{}
+	if mmCreateComment.defaultExpectation == nil {
+		mmCreateComment.defaultExpectation = &StorageMockCreateCommentExpectation{}
 	}
 
-	if mmGetPostByID.defaultExpectation.paramPtrs != nil {
-		mmGetPostByID.mock.t.Fatalf("StorageMock.GetPostByID mock is already set by ExpectParams functions")
+	if mmCreateComment.defaultExpectation.paramPtrs != nil {
+		mmCreateComment.mock.t.Fatalf("StorageMock.CreateComment mock is already set by ExpectParams functions")
 	}
 
-	mmGetPostByID.defaultExpectation.params = &StorageMockGetPostByIDParams{ctx, id}
-	mmGetPostByID.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmGetPostByID.expectations {
-		if minimock.Equal(e.params, mmGetPostByID.defaultExpectation.params) {
-			mmGetPostByID.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetPostByID.defaultExpectation.params)
+	mmCreateComment.defaultExpectation.params = &StorageMockCreateCommentParams{ctx, comment, visibility}
+	mmCreateComment.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreateComment.expectations {
+		if minimock.Equal(e.params, mmCreateComment.defaultExpectation.params) {
+			mmCreateComment.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateComment.defaultExpectation.params)
 		}
 	}
 
-	return mmGetPostByID
+	return mmCreateComment
 }
 
-// ExpectCtxParam1 sets up expected param ctx for Storage.GetPostByID
-func (mmGetPostByID *mStorageMockGetPostByID) ExpectCtxParam1(ctx context.Context) *mStorageMockGetPostByID {
-	if mmGetPostByID.mock.funcGetPostByID != nil {
-		mmGetPostByID.mock.t.Fatalf("StorageMock.GetPostByID mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for Storage.CreateComment
+func (mmCreateComment *mStorageMockCreateComment) ExpectCtxParam1(ctx context.Context) *mStorageMockCreateComment {
+	if mmCreateComment.mock.funcCreateComment != nil {
+		mmCreateComment.mock.t.Fatalf("StorageMock.CreateComment mock is already set by Set")
 	}
 
-	if mmGetPostByID.defaultExpectation == nil {
-		mmGetPostByID.defaultExpectation = &StorageMockGetPostByIDExpectation{}
+	if mmCreateComment.defaultExpectation == nil {
+		mmCreateComment.defaultExpectation = &StorageMockCreateCommentExpectation{}
 	}
 
-	if mmGetPostByID.defaultExpectation.params != nil {
-		mmGetPostByID.mock.t.Fatalf("StorageMock.GetPostByID mock is already set by Expect")
+	if mmCreateComment.defaultExpectation.params != nil {
+		mmCreateComment.mock.t.Fatalf("StorageMock.CreateComment mock is already set by Expect")
 	}
 
-	if mmGetPostByID.defaultExpectation.paramPtrs == nil {
-		mmGetPostByID.defaultExpectation.paramPtrs = &StorageMockGetPostByIDParamPtrs{}
+	if mmCreateComment.defaultExpectation.paramPtrs == nil {
+		mmCreateComment.defaultExpectation.paramPtrs = &StorageMockCreateCommentParamPtrs{}
 	}
-	mmGetPostByID.defaultExpectation.paramPtrs.ctx = &ctx
-	mmGetPostByID.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmCreateComment.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCreateComment.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmGetPostByID
+	return mmCreateComment
 }
 
-// ExpectIdParam2 sets up expected param id for Storage.GetPostByID
-func (mmGetPostByID *mStorageMockGetPostByID) ExpectIdParam2(id string) *mStorageMockGetPostByID {
-	if mmGetPostByID.mock.funcGetPostByID != nil {
-		mmGetPostByID.mock.t.Fatalf("StorageMock.GetPostByID mock is already set by Set")
+// ExpectCommentParam2 sets up expected param comment for Storage.CreateComment
+func (mmCreateComment *mStorageMockCreateComment) ExpectCommentParam2(comment *model.Comment) *mStorageMockCreateComment {
+	if mmCreateComment.mock.funcCreateComment != nil {
+		mmCreateComment.mock.t.Fatalf("StorageMock.CreateComment mock is already set by Set")
 	}
 
-	if mmGetPostByID.defaultExpectation == nil {
-		mmGetPostByID.defaultExpectation = &StorageMockGetPostByIDExpectation{}
+	if mmCreateComment.defaultExpectation == nil {
+		mmCreateComment.defaultExpectation = &StorageMockCreateCommentExpectation{}
 	}
 
-	if mmGetPostByID.defaultExpectation.params != nil {
-		mmGetPostByID.mock.t.Fatalf("StorageMock.GetPostByID mock is already set by Expect")
+	if mmCreateComment.defaultExpectation.params != nil {
+		mmCreateComment.mock.t.Fatalf("StorageMock.CreateComment mock is already set by Expect")
 	}
 
-	if mmGetPostByID.defaultExpectation.paramPtrs == nil {
-		mmGetPostByID.defaultExpectation.paramPtrs = &StorageMockGetPostByIDParamPtrs{}
+	if mmCreateComment.defaultExpectation.paramPtrs == nil {
+		mmCreateComment.defaultExpectation.paramPtrs = &StorageMockCreateCommentParamPtrs{}
 	}
-	mmGetPostByID.defaultExpectation.paramPtrs.id = &id
-	mmGetPostByID.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+	mmCreateComment.defaultExpectation.paramPtrs.comment = &comment
+	mmCreateComment.defaultExpectation.expectationOrigins.originComment = minimock.CallerInfo(1)
 
-	return mmGetPostByID
+	return mmCreateComment
 }
 
-// Inspect accepts an inspector function that has same arguments as the Storage.GetPostByID
-func (mmGetPostByID *mStorageMockGetPostByID) Inspect(f func(ctx context.Context, id string)) *mStorageMockGetPostByID {
-	if mmGetPostByID.mock.inspectFuncGetPostByID != nil {
-		mmGetPostByID.mock.t.Fatalf("Inspect function is already set for StorageMock.GetPostByID")
+// ExpectVisibilityParam3 sets up expected param visibility for Storage.CreateComment
+func (mmCreateComment *mStorageMockCreateComment) ExpectVisibilityParam3(visibility mm_storage.Visibility) *mStorageMockCreateComment {
+	if mmCreateComment.mock.funcCreateComment != nil {
+		mmCreateComment.mock.t.Fatalf("StorageMock.CreateComment mock is already set by Set")
 	}
 
-	mmGetPostByID.mock.inspectFuncGetPostByID = f
+	if mmCreateComment.defaultExpectation == nil {
+		mmCreateComment.defaultExpectation = &StorageMockCreateCommentExpectation{}
+	}
 
-	return mmGetPostByID
+	if mmCreateComment.defaultExpectation.params != nil {
+		mmCreateComment.mock.t.Fatalf("StorageMock.CreateComment mock is already set by Expect")
+	}
+
+	if mmCreateComment.defaultExpectation.paramPtrs == nil {
+		mmCreateComment.defaultExpectation.paramPtrs = &StorageMockCreateCommentParamPtrs{}
+	}
+	mmCreateComment.defaultExpectation.paramPtrs.visibility = &visibility
+	mmCreateComment.defaultExpectation.expectationOrigins.originVisibility = minimock.CallerInfo(1)
+
+	return mmCreateComment
 }
 
-// Return sets up results that will be returned by Storage.GetPostByID
-func (mmGetPostByID *mStorageMockGetPostByID) Return(pp1 *model.Post, err error) *StorageMock {
-	if mmGetPostByID.mock.funcGetPostByID != nil {
-		mmGetPostByID.mock.t.Fatalf("StorageMock.GetPostByID mock is already set by Set")
+// Inspect accepts an inspector function that has same arguments as the Storage.CreateComment
+func (mmCreateComment *mStorageMockCreateComment) Inspect(f func(ctx context.Context, comment *model.Comment, visibility mm_storage.Visibility)) *mStorageMockCreateComment {
+	if mmCreateComment.mock.inspectFuncCreateComment != nil {
+		mmCreateComment.mock.t.Fatalf("Inspect function is already set for StorageMock.CreateComment")
 	}
 
-	if mmGetPostByID.defaultExpectation == nil {
-		mmGetPostByID.defaultExpectation = &StorageMockGetPostByIDExpectation{mock: mmGetPostByID.mock}
+	mmCreateComment.mock.inspectFuncCreateComment = f
+
+	return mmCreateComment
+}
+
+// Return sets up results that will be returned by Storage.CreateComment
+func (mmCreateComment *mStorageMockCreateComment) Return(err error) *StorageMock {
+	if mmCreateComment.mock.funcCreateComment != nil {
+		mmCreateComment.mock.t.Fatalf("StorageMock.CreateComment mock is already set by Set")
 	}
-	mmGetPostByID.defaultExpectation.results = &StorageMockGetPostByIDResults{pp1, err}
-	mmGetPostByID.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmGetPostByID.mock
+
+	if mmCreateComment.defaultExpectation == nil {
+		mmCreateComment.defaultExpectation = &StorageMockCreateCommentExpectation{mock: mmCreateComment.mock}
+	}
+	mmCreateComment.defaultExpectation.results = &StorageMockCreateCommentResults{err}
+	mmCreateComment.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreateComment.mock
 }
 
-// Set uses given function f to mock the Storage.GetPostByID method
-func (mmGetPostByID *mStorageMockGetPostByID) Set(f func(ctx context.Context, id string) (pp1 *model.Post, err error)) *StorageMock {
-	if mmGetPostByID.defaultExpectation != nil {
-		mmGetPostByID.mock.t.Fatalf("Default expectation is already set for the Storage.GetPostByID method")
+// Set uses given function f to mock the Storage.CreateComment method
+func (mmCreateComment *mStorageMockCreateComment) Set(f func(ctx context.Context, comment *model.Comment, visibility mm_storage.Visibility) (err error)) *StorageMock {
+	if mmCreateComment.defaultExpectation != nil {
+		mmCreateComment.mock.t.Fatalf("Default expectation is already set for the Storage.CreateComment method")
 	}
 
-	if len(mmGetPostByID.expectations) > 0 {
-		mmGetPostByID.mock.t.Fatalf("Some expectations are already set for the Storage.GetPostByID method")
+	if len(mmCreateComment.expectations) > 0 {
+		mmCreateComment.mock.t.Fatalf("Some expectations are already set for the Storage.CreateComment method")
 	}
 
-	mmGetPostByID.mock.funcGetPostByID = f
-	mmGetPostByID.mock.funcGetPostByIDOrigin = minimock.CallerInfo(1)
-	return mmGetPostByID.mock
+	mmCreateComment.mock.funcCreateComment = f
+	mmCreateComment.mock.funcCreateCommentOrigin = minimock.CallerInfo(1)
+	return mmCreateComment.mock
 }
 
-// When sets expectation for the Storage.GetPostByID which will trigger the result defined by the following
+// When sets expectation for the Storage.CreateComment which will trigger the result defined by the following
 // Then helper
-func (mmGetPostByID *mStorageMockGetPostByID) When(ctx context.Context, id string) *StorageMockGetPostByIDExpectation {
-	if mmGetPostByID.mock.funcGetPostByID != nil {
-		mmGetPostByID.mock.t.Fatalf("StorageMock.GetPostByID mock is already set by Set")
+func (mmCreateComment *mStorageMockCreateComment) When(ctx context.Context, comment *model.Comment, visibility mm_storage.Visibility) *StorageMockCreateCommentExpectation {
+	if mmCreateComment.mock.funcCreateComment != nil {
+		mmCreateComment.mock.t.Fatalf("StorageMock.CreateComment mock is already set by Set")
 	}
 
-	expectation := &StorageMockGetPostByIDExpectation{
-		mock:               mmGetPostByID.mock,
-		params:             &StorageMockGetPostByIDParams{ctx, id},
-		expectationOrigins: StorageMockGetPostByIDExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &StorageMockCreateCommentExpectation{
+		mock:               mmCreateComment.mock,
+		params:             &StorageMockCreateCommentParams{ctx, comment, visibility},
+		expectationOrigins: StorageMockCreateCommentExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmGetPostByID.expectations = append(mmGetPostByID.expectations, expectation)
+	mmCreateComment.expectations = append(mmCreateComment.expectations, expectation)
 	return expectation
 }
 
-// Then sets up Storage.GetPostByID return parameters for the expectation previously defined by the When method
-func (e *StorageMockGetPostByIDExpectation) Then(pp1 *model.Post, err error) *StorageMock {
-	e.results = &StorageMockGetPostByIDResults{pp1, err}
+// Then sets up Storage.CreateComment return parameters for the expectation previously defined by the When method
+func (e *StorageMockCreateCommentExpectation) Then(err error) *StorageMock {
+	e.results = &StorageMockCreateCommentResults{err}
 	return e.mock
 }
 
-// Times sets number of times Storage.GetPostByID should be invoked
-func (mmGetPostByID *mStorageMockGetPostByID) Times(n uint64) *mStorageMockGetPostByID {
+// Times sets number of times Storage.CreateComment should be invoked
+func (mmCreateComment *mStorageMockCreateComment) Times(n uint64) *mStorageMockCreateComment {
 	if n == 0 {
-		mmGetPostByID.mock.t.Fatalf("Times of StorageMock.GetPostByID mock can not be zero")
+		mmCreateComment.mock.t.Fatalf("Times of StorageMock.CreateComment mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmGetPostByID.expectedInvocations, n)
-	mmGetPostByID.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmGetPostByID
+	mm_atomic.StoreUint64(&mmCreateComment.expectedInvocations, n)
+	mmCreateComment.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreateComment
 }
 
-func (mmGetPostByID *mStorageMockGetPostByID) invocationsDone() bool {
-	if len(mmGetPostByID.expectations) == 0 && mmGetPostByID.defaultExpectation == nil && mmGetPostByID.mock.funcGetPostByID == nil {
+func (mmCreateComment *mStorageMockCreateComment) invocationsDone() bool {
+	if len(mmCreateComment.expectations) == 0 && mmCreateComment.defaultExpectation == nil && mmCreateComment.mock.funcCreateComment == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmGetPostByID.mock.afterGetPostByIDCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmGetPostByID.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmCreateComment.mock.afterCreateCommentCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreateComment.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// GetPostByID implements mm_storage.Storage
-func (mmGetPostByID *StorageMock) GetPostByID(ctx context.Context, id string) (pp1 *model.Post, err error) {
-	mm_atomic.AddUint64(&mmGetPostByID.beforeGetPostByIDCounter, 1)
-	defer mm_atomic.AddUint64(&mmGetPostByID.afterGetPostByIDCounter, 1)
+// CreateComment implements mm_storage.Storage
+func (mmCreateComment *StorageMock) CreateComment(ctx context.Context, comment *model.Comment, visibility mm_storage.Visibility) (err error) {
+	mm_atomic.AddUint64(&mmCreateComment.beforeCreateCommentCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreateComment.afterCreateCommentCounter, 1)
 
-	mmGetPostByID.t.Helper()
+	mmCreateComment.t.Helper()
 
-	if mmGetPostByID.inspectFuncGetPostByID != nil {
-		mmGetPostByID.inspectFuncGetPostByID(ctx, id)
+	if mmCreateComment.inspectFuncCreateComment != nil {
+		mmCreateComment.inspectFuncCreateComment(ctx, comment, visibility)
 	}
 
-	mm_params := StorageMockGetPostByIDParams{ctx, id}
+	mm_params := StorageMockCreateCommentParams{ctx, comment, visibility}
 
 	// Record call args
-	mmGetPostByID.GetPostByIDMock.mutex.Lock()
-	mmGetPostByID.GetPostByIDMock.callArgs = append(mmGetPostByID.GetPostByIDMock.callArgs, &mm_params)
-	mmGetPostByID.GetPostByIDMock.mutex.Unlock()
+	mmCreateComment.CreateCommentMock.mutex.Lock()
+	mmCreateComment.CreateCommentMock.callArgs = append(mmCreateComment.CreateCommentMock.callArgs, &mm_params)
+	mmCreateComment.CreateCommentMock.mutex.Unlock()
 
-	for _, e := range mmGetPostByID.GetPostByIDMock.expectations {
+	for _, e := range mmCreateComment.CreateCommentMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.pp1, e.results.err
+			return e.results.err
 		}
 	}
 
-	if mmGetPostByID.GetPostByIDMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmGetPostByID.GetPostByIDMock.defaultExpectation.Counter, 1)
-		mm_want := mmGetPostByID.GetPostByIDMock.defaultExpectation.params
-		mm_want_ptrs := mmGetPostByID.GetPostByIDMock.defaultExpectation.paramPtrs
+	if mmCreateComment.CreateCommentMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreateComment.CreateCommentMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreateComment.CreateCommentMock.defaultExpectation.params
+		mm_want_ptrs := mmCreateComment.CreateCommentMock.defaultExpectation.paramPtrs
 
-		mm_got := StorageMockGetPostByIDParams{ctx, id}
+		mm_got := StorageMockCreateCommentParams{ctx, comment, visibility}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmGetPostByID.t.Errorf("StorageMock.GetPostByID got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmGetPostByID.GetPostByIDMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmCreateComment.t.Errorf("StorageMock.CreateComment got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateComment.CreateCommentMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
-				mmGetPostByID.t.Errorf("StorageMock.GetPostByID got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmGetPostByID.GetPostByIDMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			if mm_want_ptrs.comment != nil && !minimock.Equal(*mm_want_ptrs.comment, mm_got.comment) {
+				mmCreateComment.t.Errorf("StorageMock.CreateComment got unexpected parameter comment, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateComment.CreateCommentMock.defaultExpectation.expectationOrigins.originComment, *mm_want_ptrs.comment, mm_got.comment, minimock.Diff(*mm_want_ptrs.comment, mm_got.comment))
+			}
+
+			if mm_want_ptrs.visibility != nil && !minimock.Equal(*mm_want_ptrs.visibility, mm_got.visibility) {
+				mmCreateComment.t.Errorf("StorageMock.CreateComment got unexpected parameter visibility, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateComment.CreateCommentMock.defaultExpectation.expectationOrigins.originVisibility, *mm_want_ptrs.visibility, mm_got.visibility, minimock.Diff(*mm_want_ptrs.visibility, mm_got.visibility))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmGetPostByID.t.Errorf("StorageMock.GetPostByID got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmGetPostByID.GetPostByIDMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmCreateComment.t.Errorf("StorageMock.CreateComment got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreateComment.CreateCommentMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmGetPostByID.GetPostByIDMock.defaultExpectation.results
+		mm_results := mmCreateComment.CreateCommentMock.defaultExpectation.results
 		if mm_results == nil {
-			mmGetPostByID.t.Fatal("No results are set for the StorageMock.GetPostByID")
+			mmCreateComment.t.Fatal("No results are set for the StorageMock.CreateComment")
 		}
-		return (*mm_results).pp1, (*mm_results).err
+		return (*mm_results).err
 	}
-	if mmGetPostByID.funcGetPostByID != nil {
-		return mmGetPostByID.funcGetPostByID(ctx, id)
+	if mmCreateComment.funcCreateComment != nil {
+		return mmCreateComment.funcCreateComment(ctx, comment, visibility)
 	}
-	mmGetPostByID.t.Fatalf("Unexpected call to StorageMock.GetPostByID. %v %v", ctx, id)
+	mmCreateComment.t.Fatalf("Unexpected call to StorageMock.CreateComment. %v %v %v", ctx, comment, visibility)
 	return
 }
 
-// GetPostByIDAfterCounter returns a count of finished StorageMock.GetPostByID invocations
-func (mmGetPostByID *StorageMock) GetPostByIDAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmGetPostByID.afterGetPostByIDCounter)
+// CreateCommentAfterCounter returns a count of finished StorageMock.CreateComment invocations
+func (mmCreateComment *StorageMock) CreateCommentAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateComment.afterCreateCommentCounter)
 }
 
-// GetPostByIDBeforeCounter returns a count of StorageMock.GetPostByID invocations
-func (mmGetPostByID *StorageMock) GetPostByIDBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmGetPostByID.beforeGetPostByIDCounter)
+// CreateCommentBeforeCounter returns a count of StorageMock.CreateComment invocations
+func (mmCreateComment *StorageMock) CreateCommentBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateComment.beforeCreateCommentCounter)
 }
 
-// Calls returns a list of arguments used in each call to StorageMock.GetPostByID.
+// Calls returns a list of arguments used in each call to StorageMock.CreateComment.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmGetPostByID *mStorageMockGetPostByID) Calls() []*StorageMockGetPostByIDParams {
-	mmGetPostByID.mutex.RLock()
+func (mmCreateComment *mStorageMockCreateComment) Calls() []*StorageMockCreateCommentParams {
+	mmCreateComment.mutex.RLock()
 
-	argCopy := make([]*StorageMockGetPostByIDParams, len(mmGetPostByID.callArgs))
-	copy(argCopy, mmGetPostByID.callArgs)
+	argCopy := make([]*StorageMockCreateCommentParams, len(mmCreateComment.callArgs))
+	copy(argCopy, mmCreateComment.callArgs)
 
-	mmGetPostByID.mutex.RUnlock()
+	mmCreateComment.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockGetPostByIDDone returns true if the count of the GetPostByID invocations corresponds
+// MinimockCreateCommentDone returns true if the count of the CreateComment invocations corresponds
 // the number of defined expectations
-func (m *StorageMock) MinimockGetPostByIDDone() bool {
-	if m.GetPostByIDMock.optional {
+func (m *StorageMock) MinimockCreateCommentDone() bool {
+	if m.CreateCommentMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.GetPostByIDMock.expectations {
+	for _, e := range m.CreateCommentMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.GetPostByIDMock.invocationsDone()
+	return m.CreateCommentMock.invocationsDone()
 }
 
-// MinimockGetPostByIDInspect logs each unmet expectation
-func (m *StorageMock) MinimockGetPostByIDInspect() {
-	for _, e := range m.GetPostByIDMock.expectations {
+// MinimockCreateCommentInspect logs each unmet expectation
+func (m *StorageMock) MinimockCreateCommentInspect() {
+	for _, e := range m.CreateCommentMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to StorageMock.GetPostByID at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to StorageMock.CreateComment at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterGetPostByIDCounter := mm_atomic.LoadUint64(&m.afterGetPostByIDCounter)
+	afterCreateCommentCounter := mm_atomic.LoadUint64(&m.afterCreateCommentCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.GetPostByIDMock.defaultExpectation != nil && afterGetPostByIDCounter < 1 {
-		if m.GetPostByIDMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to StorageMock.GetPostByID at\n%s", m.GetPostByIDMock.defaultExpectation.returnOrigin)
+	if m.CreateCommentMock.defaultExpectation != nil && afterCreateCommentCounter < 1 {
+		if m.CreateCommentMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to StorageMock.CreateComment at\n%s", m.CreateCommentMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to StorageMock.GetPostByID at\n%s with params: %#v", m.GetPostByIDMock.defaultExpectation.expectationOrigins.origin, *m.GetPostByIDMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to StorageMock.CreateComment at\n%s with params: %#v", m.CreateCommentMock.defaultExpectation.expectationOrigins.origin, *m.CreateCommentMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcGetPostByID != nil && afterGetPostByIDCounter < 1 {
-		m.t.Errorf("Expected call to StorageMock.GetPostByID at\n%s", m.funcGetPostByIDOrigin)
+	if m.funcCreateComment != nil && afterCreateCommentCounter < 1 {
+		m.t.Errorf("Expected call to StorageMock.CreateComment at\n%s", m.funcCreateCommentOrigin)
 	}
 
-	if !m.GetPostByIDMock.invocationsDone() && afterGetPostByIDCounter > 0 {
-		m.t.Errorf("Expected %d calls to StorageMock.GetPostByID at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.GetPostByIDMock.expectedInvocations), m.GetPostByIDMock.expectedInvocationsOrigin, afterGetPostByIDCounter)
+	if !m.CreateCommentMock.invocationsDone() && afterCreateCommentCounter > 0 {
+		m.t.Errorf("Expected %d calls to StorageMock.CreateComment at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateCommentMock.expectedInvocations), m.CreateCommentMock.expectedInvocationsOrigin, afterCreateCommentCounter)
 	}
 }
 
-type mStorageMockGetPosts struct {
+type mStorageMockCreatePost struct {
 	optional           bool
 	mock               *StorageMock
-	defaultExpectation *StorageMockGetPostsExpectation
-	expectations       []*StorageMockGetPostsExpectation
+	defaultExpectation *StorageMockCreatePostExpectation
+	expectations       []*StorageMockCreatePostExpectation
 
-	callArgs []*StorageMockGetPostsParams
+	callArgs []*StorageMockCreatePostParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// StorageMockGetPostsExpectation specifies expectation struct of the Storage.GetPosts
-type StorageMockGetPostsExpectation struct {
+// StorageMockCreatePostExpectation specifies expectation struct of the Storage.CreatePost
+type StorageMockCreatePostExpectation struct {
 	mock               *StorageMock
-	params             *StorageMockGetPostsParams
-	paramPtrs          *StorageMockGetPostsParamPtrs
-	expectationOrigins StorageMockGetPostsExpectationOrigins
-	results            *StorageMockGetPostsResults
+	params             *StorageMockCreatePostParams
+	paramPtrs          *StorageMockCreatePostParamPtrs
+	expectationOrigins StorageMockCreatePostExpectationOrigins
+	results            *StorageMockCreatePostResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// StorageMockGetPostsParams contains parameters of the Storage.GetPosts
-type StorageMockGetPostsParams struct {
-	ctx context.Context
+// StorageMockCreatePostParams contains parameters of the Storage.CreatePost
+type StorageMockCreatePostParams struct {
+	ctx        context.Context
+	post       *model.Post
+	visibility mm_storage.Visibility
 }
 
-// StorageMockGetPostsParamPtrs contains pointers to parameters of the Storage.GetPosts
-type StorageMockGetPostsParamPtrs struct {
-	ctx *context.Context
+// StorageMockCreatePostParamPtrs contains pointers to parameters of the Storage.CreatePost
+type StorageMockCreatePostParamPtrs struct {
+	ctx        *context.Context
+	post       **model.Post
+	visibility *mm_storage.Visibility
 }
 
-// StorageMockGetPostsResults contains results of the Storage.GetPosts
-type StorageMockGetPostsResults struct {
-	ppa1 []*model.Post
-	err  error
+// StorageMockCreatePostResults contains results of the Storage.CreatePost
+type StorageMockCreatePostResults struct {
+	err error
 }
 
-// StorageMockGetPostsOrigins contains origins of expectations of the Storage.GetPosts
-type StorageMockGetPostsExpectationOrigins struct {
-	origin    string
-	originCtx string
+// StorageMockCreatePostOrigins contains origins of expectations of the Storage.CreatePost
+type StorageMockCreatePostExpectationOrigins struct {
+	origin           string
+	originCtx        string
+	originPost       string
+	originVisibility string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -1586,320 +1813,7321 @@ type StorageMockGetPostsExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmGetPosts *mStorageMockGetPosts) Optional() *mStorageMockGetPosts {
-	mmGetPosts.optional = true
-	return mmGetPosts
+func (mmCreatePost *mStorageMockCreatePost) Optional() *mStorageMockCreatePost {
+	mmCreatePost.optional = true
+	return mmCreatePost
 }
 
-// Expect sets up expected params for Storage.GetPosts
-func (mmGetPosts *mStorageMockGetPosts) Expect(ctx context.Context) *mStorageMockGetPosts {
-	if mmGetPosts.mock.funcGetPosts != nil {
-		mmGetPosts.mock.t.Fatalf("StorageMock.GetPosts mock is already set by Set")
+// Expect sets up expected params for Storage.CreatePost
+func (mmCreatePost *mStorageMockCreatePost) Expect(ctx context.Context, post *model.Post, visibility mm_storage.Visibility) *mStorageMockCreatePost {
+	if mmCreatePost.mock.funcCreatePost != nil {
+		mmCreatePost.mock.t.Fatalf("StorageMock.CreatePost mock is already set by Set")
 	}
 
-	if mmGetPosts.defaultExpectation == nil {
-		mmGetPosts.defaultExpectation = &StorageMockGetPostsExpectation{}
+	if mmCreatePost.defaultExpectation == nil {
+		mmCreatePost.defaultExpectation = &StorageMockCreatePostExpectation{}
 	}
 
-	if mmGetPosts.defaultExpectation.paramPtrs != nil {
-		mmGetPosts.mock.t.Fatalf("StorageMock.GetPosts mock is already set by ExpectParams functions")
+	if mmCreatePost.defaultExpectation.paramPtrs != nil {
+		mmCreatePost.mock.t.Fatalf("StorageMock.CreatePost mock is already set by ExpectParams functions")
 	}
 
-	mmGetPosts.defaultExpectation.params = &StorageMockGetPostsParams{ctx}
-	mmGetPosts.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmGetPosts.expectations {
-		if minimock.Equal(e.params, mmGetPosts.defaultExpectation.params) {
-			mmGetPosts.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetPosts.defaultExpectation.params)
+	mmCreatePost.defaultExpectation.params = &StorageMockCreatePostParams{ctx, post, visibility}
+	mmCreatePost.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreatePost.expectations {
+		if minimock.Equal(e.params, mmCreatePost.defaultExpectation.params) {
+			mmCreatePost.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreatePost.defaultExpectation.params)
 		}
 	}
 
-	return mmGetPosts
+	return mmCreatePost
 }
 
-// ExpectCtxParam1 sets up expected param ctx for Storage.GetPosts
-func (mmGetPosts *mStorageMockGetPosts) ExpectCtxParam1(ctx context.Context) *mStorageMockGetPosts {
-	if mmGetPosts.mock.funcGetPosts != nil {
-		mmGetPosts.mock.t.Fatalf("StorageMock.GetPosts mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for Storage.CreatePost
+func (mmCreatePost *mStorageMockCreatePost) ExpectCtxParam1(ctx context.Context) *mStorageMockCreatePost {
+	if mmCreatePost.mock.funcCreatePost != nil {
+		mmCreatePost.mock.t.Fatalf("StorageMock.CreatePost mock is already set by Set")
 	}
 
-	if mmGetPosts.defaultExpectation == nil {
-		mmGetPosts.defaultExpectation = &StorageMockGetPostsExpectation{}
+	if mmCreatePost.defaultExpectation == nil {
+		mmCreatePost.defaultExpectation = &StorageMockCreatePostExpectation{}
 	}
 
-	if mmGetPosts.defaultExpectation.params != nil {
-		mmGetPosts.mock.t.Fatalf("StorageMock.GetPosts mock is already set by Expect")
+	if mmCreatePost.defaultExpectation.params != nil {
+		mmCreatePost.mock.t.Fatalf("StorageMock.CreatePost mock is already set by Expect")
 	}
 
-	if mmGetPosts.defaultExpectation.paramPtrs == nil {
-		mmGetPosts.defaultExpectation.paramPtrs = &StorageMockGetPostsParamPtrs{}
+	if mmCreatePost.defaultExpectation.paramPtrs == nil {
+		mmCreatePost.defaultExpectation.paramPtrs = &StorageMockCreatePostParamPtrs{}
 	}
-	mmGetPosts.defaultExpectation.paramPtrs.ctx = &ctx
-	mmGetPosts.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmCreatePost.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCreatePost.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmGetPosts
+	return mmCreatePost
 }
 
-// Inspect accepts an inspector function that has same arguments as the Storage.GetPosts
-func (mmGetPosts *mStorageMockGetPosts) Inspect(f func(ctx context.Context)) *mStorageMockGetPosts {
-	if mmGetPosts.mock.inspectFuncGetPosts != nil {
-		mmGetPosts.mock.t.Fatalf("Inspect function is already set for StorageMock.GetPosts")
+// ExpectPostParam2 sets up expected param post for Storage.CreatePost
+func (mmCreatePost *mStorageMockCreatePost) ExpectPostParam2(post *model.Post) *mStorageMockCreatePost {
+	if mmCreatePost.mock.funcCreatePost != nil {
+		mmCreatePost.mock.t.Fatalf("StorageMock.CreatePost mock is already set by Set")
 	}
 
-	mmGetPosts.mock.inspectFuncGetPosts = f
+	if mmCreatePost.defaultExpectation == nil {
+		mmCreatePost.defaultExpectation = &StorageMockCreatePostExpectation{}
+	}
 
-	return mmGetPosts
+	if mmCreatePost.defaultExpectation.params != nil {
+		mmCreatePost.mock.t.Fatalf("StorageMock.CreatePost mock is already set by Expect")
+	}
+
+	if mmCreatePost.defaultExpectation.paramPtrs == nil {
+		mmCreatePost.defaultExpectation.paramPtrs = &StorageMockCreatePostParamPtrs{}
+	}
+	mmCreatePost.defaultExpectation.paramPtrs.post = &post
+	mmCreatePost.defaultExpectation.expectationOrigins.originPost = minimock.CallerInfo(1)
+
+	return mmCreatePost
 }
 
-// Return sets up results that will be returned by Storage.GetPosts
-func (mmGetPosts *mStorageMockGetPosts) Return(ppa1 []*model.Post, err error) *StorageMock {
-	if mmGetPosts.mock.funcGetPosts != nil {
-		mmGetPosts.mock.t.Fatalf("StorageMock.GetPosts mock is already set by Set")
+// ExpectVisibilityParam3 sets up expected param visibility for Storage.CreatePost
+func (mmCreatePost *mStorageMockCreatePost) ExpectVisibilityParam3(visibility mm_storage.Visibility) *mStorageMockCreatePost {
+	if mmCreatePost.mock.funcCreatePost != nil {
+		mmCreatePost.mock.t.Fatalf("StorageMock.CreatePost mock is already set by Set")
 	}
 
-	if mmGetPosts.defaultExpectation == nil {
-		mmGetPosts.defaultExpectation = &StorageMockGetPostsExpectation{mock: mmGetPosts.mock}
+	if mmCreatePost.defaultExpectation == nil {
+		mmCreatePost.defaultExpectation = &StorageMockCreatePostExpectation{}
 	}
-	mmGetPosts.defaultExpectation.results = &StorageMockGetPostsResults{ppa1, err}
-	mmGetPosts.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmGetPosts.mock
+
+	if mmCreatePost.defaultExpectation.params != nil {
+		mmCreatePost.mock.t.Fatalf("StorageMock.CreatePost mock is already set by Expect")
+	}
+
+	if mmCreatePost.defaultExpectation.paramPtrs == nil {
+		mmCreatePost.defaultExpectation.paramPtrs = &StorageMockCreatePostParamPtrs{}
+	}
+	mmCreatePost.defaultExpectation.paramPtrs.visibility = &visibility
+	mmCreatePost.defaultExpectation.expectationOrigins.originVisibility = minimock.CallerInfo(1)
+
+	return mmCreatePost
 }
 
-// Set uses given function f to mock the Storage.GetPosts method
-func (mmGetPosts *mStorageMockGetPosts) Set(f func(ctx context.Context) (ppa1 []*model.Post, err error)) *StorageMock {
-	if mmGetPosts.defaultExpectation != nil {
-		mmGetPosts.mock.t.Fatalf("Default expectation is already set for the Storage.GetPosts method")
+// Inspect accepts an inspector function that has same arguments as the Storage.CreatePost
+func (mmCreatePost *mStorageMockCreatePost) Inspect(f func(ctx context.Context, post *model.Post, visibility mm_storage.Visibility)) *mStorageMockCreatePost {
+	if mmCreatePost.mock.inspectFuncCreatePost != nil {
+		mmCreatePost.mock.t.Fatalf("Inspect function is already set for StorageMock.CreatePost")
 	}
 
-	if len(mmGetPosts.expectations) > 0 {
-		mmGetPosts.mock.t.Fatalf("Some expectations are already set for the Storage.GetPosts method")
+	mmCreatePost.mock.inspectFuncCreatePost = f
+
+	return mmCreatePost
+}
+
+// Return sets up results that will be returned by Storage.CreatePost
+func (mmCreatePost *mStorageMockCreatePost) Return(err error) *StorageMock {
+	if mmCreatePost.mock.funcCreatePost != nil {
+		mmCreatePost.mock.t.Fatalf("StorageMock.CreatePost mock is already set by Set")
 	}
 
-	mmGetPosts.mock.funcGetPosts = f
-	mmGetPosts.mock.funcGetPostsOrigin = minimock.CallerInfo(1)
-	return mmGetPosts.mock
+	if mmCreatePost.defaultExpectation == nil {
+		mmCreatePost.defaultExpectation = &StorageMockCreatePostExpectation{mock: mmCreatePost.mock}
+	}
+	mmCreatePost.defaultExpectation.results = &StorageMockCreatePostResults{err}
+	mmCreatePost.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreatePost.mock
 }
 
-// When sets expectation for the Storage.GetPosts which will trigger the result defined by the following
+// Set uses given function f to mock the Storage.CreatePost method
+func (mmCreatePost *mStorageMockCreatePost) Set(f func(ctx context.Context, post *model.Post, visibility mm_storage.Visibility) (err error)) *StorageMock {
+	if mmCreatePost.defaultExpectation != nil {
+		mmCreatePost.mock.t.Fatalf("Default expectation is already set for the Storage.CreatePost method")
+	}
+
+	if len(mmCreatePost.expectations) > 0 {
+		mmCreatePost.mock.t.Fatalf("Some expectations are already set for the Storage.CreatePost method")
+	}
+
+	mmCreatePost.mock.funcCreatePost = f
+	mmCreatePost.mock.funcCreatePostOrigin = minimock.CallerInfo(1)
+	return mmCreatePost.mock
+}
+
+// When sets expectation for the Storage.CreatePost which will trigger the result defined by the following
 // Then helper
-func (mmGetPosts *mStorageMockGetPosts) When(ctx context.Context) *StorageMockGetPostsExpectation {
-	if mmGetPosts.mock.funcGetPosts != nil {
-		mmGetPosts.mock.t.Fatalf("StorageMock.GetPosts mock is already set by Set")
+func (mmCreatePost *mStorageMockCreatePost) When(ctx context.Context, post *model.Post, visibility mm_storage.Visibility) *StorageMockCreatePostExpectation {
+	if mmCreatePost.mock.funcCreatePost != nil {
+		mmCreatePost.mock.t.Fatalf("StorageMock.CreatePost mock is already set by Set")
 	}
 
-	expectation := &StorageMockGetPostsExpectation{
-		mock:               mmGetPosts.mock,
-		params:             &StorageMockGetPostsParams{ctx},
-		expectationOrigins: StorageMockGetPostsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &StorageMockCreatePostExpectation{
+		mock:               mmCreatePost.mock,
+		params:             &StorageMockCreatePostParams{ctx, post, visibility},
+		expectationOrigins: StorageMockCreatePostExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmGetPosts.expectations = append(mmGetPosts.expectations, expectation)
+	mmCreatePost.expectations = append(mmCreatePost.expectations, expectation)
 	return expectation
 }
 
-// Then sets up Storage.GetPosts return parameters for the expectation previously defined by the When method
-func (e *StorageMockGetPostsExpectation) Then(ppa1 []*model.Post, err error) *StorageMock {
-	e.results = &StorageMockGetPostsResults{ppa1, err}
+// Then sets up Storage.CreatePost return parameters for the expectation previously defined by the When method
+func (e *StorageMockCreatePostExpectation) Then(err error) *StorageMock {
+	e.results = &StorageMockCreatePostResults{err}
 	return e.mock
 }
 
-// Times sets number of times Storage.GetPosts should be invoked
-func (mmGetPosts *mStorageMockGetPosts) Times(n uint64) *mStorageMockGetPosts {
+// Times sets number of times Storage.CreatePost should be invoked
+func (mmCreatePost *mStorageMockCreatePost) Times(n uint64) *mStorageMockCreatePost {
 	if n == 0 {
-		mmGetPosts.mock.t.Fatalf("Times of StorageMock.GetPosts mock can not be zero")
+		mmCreatePost.mock.t.Fatalf("Times of StorageMock.CreatePost mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmGetPosts.expectedInvocations, n)
-	mmGetPosts.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmGetPosts
+	mm_atomic.StoreUint64(&mmCreatePost.expectedInvocations, n)
+	mmCreatePost.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreatePost
 }
 
-func (mmGetPosts *mStorageMockGetPosts) invocationsDone() bool {
-	if len(mmGetPosts.expectations) == 0 && mmGetPosts.defaultExpectation == nil && mmGetPosts.mock.funcGetPosts == nil {
+func (mmCreatePost *mStorageMockCreatePost) invocationsDone() bool {
+	if len(mmCreatePost.expectations) == 0 && mmCreatePost.defaultExpectation == nil && mmCreatePost.mock.funcCreatePost == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmGetPosts.mock.afterGetPostsCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmGetPosts.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmCreatePost.mock.afterCreatePostCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreatePost.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// GetPosts implements mm_storage.Storage
-func (mmGetPosts *StorageMock) GetPosts(ctx context.Context) (ppa1 []*model.Post, err error) {
-	mm_atomic.AddUint64(&mmGetPosts.beforeGetPostsCounter, 1)
-	defer mm_atomic.AddUint64(&mmGetPosts.afterGetPostsCounter, 1)
+// CreatePost implements mm_storage.Storage
+func (mmCreatePost *StorageMock) CreatePost(ctx context.Context, post *model.Post, visibility mm_storage.Visibility) (err error) {
+	mm_atomic.AddUint64(&mmCreatePost.beforeCreatePostCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreatePost.afterCreatePostCounter, 1)
 
-	mmGetPosts.t.Helper()
+	mmCreatePost.t.Helper()
 
-	if mmGetPosts.inspectFuncGetPosts != nil {
-		mmGetPosts.inspectFuncGetPosts(ctx)
+	if mmCreatePost.inspectFuncCreatePost != nil {
+		mmCreatePost.inspectFuncCreatePost(ctx, post, visibility)
 	}
 
-	mm_params := StorageMockGetPostsParams{ctx}
+	mm_params := StorageMockCreatePostParams{ctx, post, visibility}
 
 	// Record call args
-	mmGetPosts.GetPostsMock.mutex.Lock()
-	mmGetPosts.GetPostsMock.callArgs = append(mmGetPosts.GetPostsMock.callArgs, &mm_params)
-	mmGetPosts.GetPostsMock.mutex.Unlock()
+	mmCreatePost.CreatePostMock.mutex.Lock()
+	mmCreatePost.CreatePostMock.callArgs = append(mmCreatePost.CreatePostMock.callArgs, &mm_params)
+	mmCreatePost.CreatePostMock.mutex.Unlock()
 
-	for _, e := range mmGetPosts.GetPostsMock.expectations {
+	for _, e := range mmCreatePost.CreatePostMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.ppa1, e.results.err
+			return e.results.err
 		}
 	}
 
-	if mmGetPosts.GetPostsMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmGetPosts.GetPostsMock.defaultExpectation.Counter, 1)
-		mm_want := mmGetPosts.GetPostsMock.defaultExpectation.params
-		mm_want_ptrs := mmGetPosts.GetPostsMock.defaultExpectation.paramPtrs
+	if mmCreatePost.CreatePostMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreatePost.CreatePostMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreatePost.CreatePostMock.defaultExpectation.params
+		mm_want_ptrs := mmCreatePost.CreatePostMock.defaultExpectation.paramPtrs
 
-		mm_got := StorageMockGetPostsParams{ctx}
+		mm_got := StorageMockCreatePostParams{ctx, post, visibility}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmGetPosts.t.Errorf("StorageMock.GetPosts got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmGetPosts.GetPostsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmCreatePost.t.Errorf("StorageMock.CreatePost got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreatePost.CreatePostMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.post != nil && !minimock.Equal(*mm_want_ptrs.post, mm_got.post) {
+				mmCreatePost.t.Errorf("StorageMock.CreatePost got unexpected parameter post, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreatePost.CreatePostMock.defaultExpectation.expectationOrigins.originPost, *mm_want_ptrs.post, mm_got.post, minimock.Diff(*mm_want_ptrs.post, mm_got.post))
+			}
+
+			if mm_want_ptrs.visibility != nil && !minimock.Equal(*mm_want_ptrs.visibility, mm_got.visibility) {
+				mmCreatePost.t.Errorf("StorageMock.CreatePost got unexpected parameter visibility, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreatePost.CreatePostMock.defaultExpectation.expectationOrigins.originVisibility, *mm_want_ptrs.visibility, mm_got.visibility, minimock.Diff(*mm_want_ptrs.visibility, mm_got.visibility))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmGetPosts.t.Errorf("StorageMock.GetPosts got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmGetPosts.GetPostsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmCreatePost.t.Errorf("StorageMock.CreatePost got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreatePost.CreatePostMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmGetPosts.GetPostsMock.defaultExpectation.results
+		mm_results := mmCreatePost.CreatePostMock.defaultExpectation.results
 		if mm_results == nil {
-			mmGetPosts.t.Fatal("No results are set for the StorageMock.GetPosts")
+			mmCreatePost.t.Fatal("No results are set for the StorageMock.CreatePost")
 		}
-		return (*mm_results).ppa1, (*mm_results).err
+		return (*mm_results).err
 	}
-	if mmGetPosts.funcGetPosts != nil {
-		return mmGetPosts.funcGetPosts(ctx)
+	if mmCreatePost.funcCreatePost != nil {
+		return mmCreatePost.funcCreatePost(ctx, post, visibility)
 	}
-	mmGetPosts.t.Fatalf("Unexpected call to StorageMock.GetPosts. %v", ctx)
+	mmCreatePost.t.Fatalf("Unexpected call to StorageMock.CreatePost. %v %v %v", ctx, post, visibility)
 	return
 }
 
-// GetPostsAfterCounter returns a count of finished StorageMock.GetPosts invocations
-func (mmGetPosts *StorageMock) GetPostsAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmGetPosts.afterGetPostsCounter)
+// CreatePostAfterCounter returns a count of finished StorageMock.CreatePost invocations
+func (mmCreatePost *StorageMock) CreatePostAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreatePost.afterCreatePostCounter)
 }
 
-// GetPostsBeforeCounter returns a count of StorageMock.GetPosts invocations
-func (mmGetPosts *StorageMock) GetPostsBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmGetPosts.beforeGetPostsCounter)
+// CreatePostBeforeCounter returns a count of StorageMock.CreatePost invocations
+func (mmCreatePost *StorageMock) CreatePostBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreatePost.beforeCreatePostCounter)
 }
 
-// Calls returns a list of arguments used in each call to StorageMock.GetPosts.
+// Calls returns a list of arguments used in each call to StorageMock.CreatePost.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmGetPosts *mStorageMockGetPosts) Calls() []*StorageMockGetPostsParams {
-	mmGetPosts.mutex.RLock()
+func (mmCreatePost *mStorageMockCreatePost) Calls() []*StorageMockCreatePostParams {
+	mmCreatePost.mutex.RLock()
+
+	argCopy := make([]*StorageMockCreatePostParams, len(mmCreatePost.callArgs))
+	copy(argCopy, mmCreatePost.callArgs)
+
+	mmCreatePost.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreatePostDone returns true if the count of the CreatePost invocations corresponds
+// the number of defined expectations
+func (m *StorageMock) MinimockCreatePostDone() bool {
+	if m.CreatePostMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreatePostMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreatePostMock.invocationsDone()
+}
+
+// MinimockCreatePostInspect logs each unmet expectation
+func (m *StorageMock) MinimockCreatePostInspect() {
+	for _, e := range m.CreatePostMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to StorageMock.CreatePost at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCreatePostCounter := mm_atomic.LoadUint64(&m.afterCreatePostCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreatePostMock.defaultExpectation != nil && afterCreatePostCounter < 1 {
+		if m.CreatePostMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to StorageMock.CreatePost at\n%s", m.CreatePostMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to StorageMock.CreatePost at\n%s with params: %#v", m.CreatePostMock.defaultExpectation.expectationOrigins.origin, *m.CreatePostMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreatePost != nil && afterCreatePostCounter < 1 {
+		m.t.Errorf("Expected call to StorageMock.CreatePost at\n%s", m.funcCreatePostOrigin)
+	}
+
+	if !m.CreatePostMock.invocationsDone() && afterCreatePostCounter > 0 {
+		m.t.Errorf("Expected %d calls to StorageMock.CreatePost at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreatePostMock.expectedInvocations), m.CreatePostMock.expectedInvocationsOrigin, afterCreatePostCounter)
+	}
+}
+
+type mStorageMockCreateReport struct {
+	optional           bool
+	mock               *StorageMock
+	defaultExpectation *StorageMockCreateReportExpectation
+	expectations       []*StorageMockCreateReportExpectation
+
+	callArgs []*StorageMockCreateReportParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// StorageMockCreateReportExpectation specifies expectation struct of the Storage.CreateReport
+type StorageMockCreateReportExpectation struct {
+	mock               *StorageMock
+	params             *StorageMockCreateReportParams
+	paramPtrs          *StorageMockCreateReportParamPtrs
+	expectationOrigins StorageMockCreateReportExpectationOrigins
+	results            *StorageMockCreateReportResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// StorageMockCreateReportParams contains parameters of the Storage.CreateReport
+type StorageMockCreateReportParams struct {
+	ctx    context.Context
+	report *model.Report
+}
+
+// StorageMockCreateReportParamPtrs contains pointers to parameters of the Storage.CreateReport
+type StorageMockCreateReportParamPtrs struct {
+	ctx    *context.Context
+	report **model.Report
+}
+
+// StorageMockCreateReportResults contains results of the Storage.CreateReport
+type StorageMockCreateReportResults struct {
+	err error
+}
+
+// StorageMockCreateReportOrigins contains origins of expectations of the Storage.CreateReport
+type StorageMockCreateReportExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originReport string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreateReport *mStorageMockCreateReport) Optional() *mStorageMockCreateReport {
+	mmCreateReport.optional = true
+	return mmCreateReport
+}
+
+// Expect sets up expected params for Storage.CreateReport
+func (mmCreateReport *mStorageMockCreateReport) Expect(ctx context.Context, report *model.Report) *mStorageMockCreateReport {
+	if mmCreateReport.mock.funcCreateReport != nil {
+		mmCreateReport.mock.t.Fatalf("StorageMock.CreateReport mock is already set by Set")
+	}
+
+	if mmCreateReport.defaultExpectation == nil {
+		mmCreateReport.defaultExpectation = &StorageMockCreateReportExpectation{}
+	}
+
+	if mmCreateReport.defaultExpectation.paramPtrs != nil {
+		mmCreateReport.mock.t.Fatalf("StorageMock.CreateReport mock is already set by ExpectParams functions")
+	}
+
+	mmCreateReport.defaultExpectation.params = &StorageMockCreateReportParams{ctx, report}
+	mmCreateReport.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreateReport.expectations {
+		if minimock.Equal(e.params, mmCreateReport.defaultExpectation.params) {
+			mmCreateReport.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateReport.defaultExpectation.params)
+		}
+	}
+
+	return mmCreateReport
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Storage.CreateReport
+func (mmCreateReport *mStorageMockCreateReport) ExpectCtxParam1(ctx context.Context) *mStorageMockCreateReport {
+	if mmCreateReport.mock.funcCreateReport != nil {
+		mmCreateReport.mock.t.Fatalf("StorageMock.CreateReport mock is already set by Set")
+	}
+
+	if mmCreateReport.defaultExpectation == nil {
+		mmCreateReport.defaultExpectation = &StorageMockCreateReportExpectation{}
+	}
+
+	if mmCreateReport.defaultExpectation.params != nil {
+		mmCreateReport.mock.t.Fatalf("StorageMock.CreateReport mock is already set by Expect")
+	}
+
+	if mmCreateReport.defaultExpectation.paramPtrs == nil {
+		mmCreateReport.defaultExpectation.paramPtrs = &StorageMockCreateReportParamPtrs{}
+	}
+	mmCreateReport.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCreateReport.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCreateReport
+}
+
+// ExpectReportParam2 sets up expected param report for Storage.CreateReport
+func (mmCreateReport *mStorageMockCreateReport) ExpectReportParam2(report *model.Report) *mStorageMockCreateReport {
+	if mmCreateReport.mock.funcCreateReport != nil {
+		mmCreateReport.mock.t.Fatalf("StorageMock.CreateReport mock is already set by Set")
+	}
+
+	if mmCreateReport.defaultExpectation == nil {
+		mmCreateReport.defaultExpectation = &StorageMockCreateReportExpectation{}
+	}
+
+	if mmCreateReport.defaultExpectation.params != nil {
+		mmCreateReport.mock.t.Fatalf("StorageMock.CreateReport mock is already set by Expect")
+	}
+
+	if mmCreateReport.defaultExpectation.paramPtrs == nil {
+		mmCreateReport.defaultExpectation.paramPtrs = &StorageMockCreateReportParamPtrs{}
+	}
+	mmCreateReport.defaultExpectation.paramPtrs.report = &report
+	mmCreateReport.defaultExpectation.expectationOrigins.originReport = minimock.CallerInfo(1)
+
+	return mmCreateReport
+}
+
+// Inspect accepts an inspector function that has same arguments as the Storage.CreateReport
+func (mmCreateReport *mStorageMockCreateReport) Inspect(f func(ctx context.Context, report *model.Report)) *mStorageMockCreateReport {
+	if mmCreateReport.mock.inspectFuncCreateReport != nil {
+		mmCreateReport.mock.t.Fatalf("Inspect function is already set for StorageMock.CreateReport")
+	}
+
+	mmCreateReport.mock.inspectFuncCreateReport = f
+
+	return mmCreateReport
+}
+
+// Return sets up results that will be returned by Storage.CreateReport
+func (mmCreateReport *mStorageMockCreateReport) Return(err error) *StorageMock {
+	if mmCreateReport.mock.funcCreateReport != nil {
+		mmCreateReport.mock.t.Fatalf("StorageMock.CreateReport mock is already set by Set")
+	}
+
+	if mmCreateReport.defaultExpectation == nil {
+		mmCreateReport.defaultExpectation = &StorageMockCreateReportExpectation{mock: mmCreateReport.mock}
+	}
+	mmCreateReport.defaultExpectation.results = &StorageMockCreateReportResults{err}
+	mmCreateReport.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreateReport.mock
+}
+
+// Set uses given function f to mock the Storage.CreateReport method
+func (mmCreateReport *mStorageMockCreateReport) Set(f func(ctx context.Context, report *model.Report) (err error)) *StorageMock {
+	if mmCreateReport.defaultExpectation != nil {
+		mmCreateReport.mock.t.Fatalf("Default expectation is already set for the Storage.CreateReport method")
+	}
+
+	if len(mmCreateReport.expectations) > 0 {
+		mmCreateReport.mock.t.Fatalf("Some expectations are already set for the Storage.CreateReport method")
+	}
+
+	mmCreateReport.mock.funcCreateReport = f
+	mmCreateReport.mock.funcCreateReportOrigin = minimock.CallerInfo(1)
+	return mmCreateReport.mock
+}
+
+// When sets expectation for the Storage.CreateReport which will trigger the result defined by the following
+// Then helper
+func (mmCreateReport *mStorageMockCreateReport) When(ctx context.Context, report *model.Report) *StorageMockCreateReportExpectation {
+	if mmCreateReport.mock.funcCreateReport != nil {
+		mmCreateReport.mock.t.Fatalf("StorageMock.CreateReport mock is already set by Set")
+	}
+
+	expectation := &StorageMockCreateReportExpectation{
+		mock:               mmCreateReport.mock,
+		params:             &StorageMockCreateReportParams{ctx, report},
+		expectationOrigins: StorageMockCreateReportExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCreateReport.expectations = append(mmCreateReport.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Storage.CreateReport return parameters for the expectation previously defined by the When method
+func (e *StorageMockCreateReportExpectation) Then(err error) *StorageMock {
+	e.results = &StorageMockCreateReportResults{err}
+	return e.mock
+}
+
+// Times sets number of times Storage.CreateReport should be invoked
+func (mmCreateReport *mStorageMockCreateReport) Times(n uint64) *mStorageMockCreateReport {
+	if n == 0 {
+		mmCreateReport.mock.t.Fatalf("Times of StorageMock.CreateReport mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreateReport.expectedInvocations, n)
+	mmCreateReport.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreateReport
+}
+
+func (mmCreateReport *mStorageMockCreateReport) invocationsDone() bool {
+	if len(mmCreateReport.expectations) == 0 && mmCreateReport.defaultExpectation == nil && mmCreateReport.mock.funcCreateReport == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreateReport.mock.afterCreateReportCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreateReport.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CreateReport implements mm_storage.Storage
+func (mmCreateReport *StorageMock) CreateReport(ctx context.Context, report *model.Report) (err error) {
+	mm_atomic.AddUint64(&mmCreateReport.beforeCreateReportCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreateReport.afterCreateReportCounter, 1)
+
+	mmCreateReport.t.Helper()
+
+	if mmCreateReport.inspectFuncCreateReport != nil {
+		mmCreateReport.inspectFuncCreateReport(ctx, report)
+	}
+
+	mm_params := StorageMockCreateReportParams{ctx, report}
+
+	// Record call args
+	mmCreateReport.CreateReportMock.mutex.Lock()
+	mmCreateReport.CreateReportMock.callArgs = append(mmCreateReport.CreateReportMock.callArgs, &mm_params)
+	mmCreateReport.CreateReportMock.mutex.Unlock()
+
+	for _, e := range mmCreateReport.CreateReportMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmCreateReport.CreateReportMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreateReport.CreateReportMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreateReport.CreateReportMock.defaultExpectation.params
+		mm_want_ptrs := mmCreateReport.CreateReportMock.defaultExpectation.paramPtrs
+
+		mm_got := StorageMockCreateReportParams{ctx, report}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreateReport.t.Errorf("StorageMock.CreateReport got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateReport.CreateReportMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.report != nil && !minimock.Equal(*mm_want_ptrs.report, mm_got.report) {
+				mmCreateReport.t.Errorf("StorageMock.CreateReport got unexpected parameter report, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateReport.CreateReportMock.defaultExpectation.expectationOrigins.originReport, *mm_want_ptrs.report, mm_got.report, minimock.Diff(*mm_want_ptrs.report, mm_got.report))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreateReport.t.Errorf("StorageMock.CreateReport got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreateReport.CreateReportMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreateReport.CreateReportMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreateReport.t.Fatal("No results are set for the StorageMock.CreateReport")
+		}
+		return (*mm_results).err
+	}
+	if mmCreateReport.funcCreateReport != nil {
+		return mmCreateReport.funcCreateReport(ctx, report)
+	}
+	mmCreateReport.t.Fatalf("Unexpected call to StorageMock.CreateReport. %v %v", ctx, report)
+	return
+}
+
+// CreateReportAfterCounter returns a count of finished StorageMock.CreateReport invocations
+func (mmCreateReport *StorageMock) CreateReportAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateReport.afterCreateReportCounter)
+}
+
+// CreateReportBeforeCounter returns a count of StorageMock.CreateReport invocations
+func (mmCreateReport *StorageMock) CreateReportBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateReport.beforeCreateReportCounter)
+}
+
+// Calls returns a list of arguments used in each call to StorageMock.CreateReport.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreateReport *mStorageMockCreateReport) Calls() []*StorageMockCreateReportParams {
+	mmCreateReport.mutex.RLock()
+
+	argCopy := make([]*StorageMockCreateReportParams, len(mmCreateReport.callArgs))
+	copy(argCopy, mmCreateReport.callArgs)
+
+	mmCreateReport.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateReportDone returns true if the count of the CreateReport invocations corresponds
+// the number of defined expectations
+func (m *StorageMock) MinimockCreateReportDone() bool {
+	if m.CreateReportMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateReportMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateReportMock.invocationsDone()
+}
+
+// MinimockCreateReportInspect logs each unmet expectation
+func (m *StorageMock) MinimockCreateReportInspect() {
+	for _, e := range m.CreateReportMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to StorageMock.CreateReport at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCreateReportCounter := mm_atomic.LoadUint64(&m.afterCreateReportCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateReportMock.defaultExpectation != nil && afterCreateReportCounter < 1 {
+		if m.CreateReportMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to StorageMock.CreateReport at\n%s", m.CreateReportMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to StorageMock.CreateReport at\n%s with params: %#v", m.CreateReportMock.defaultExpectation.expectationOrigins.origin, *m.CreateReportMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreateReport != nil && afterCreateReportCounter < 1 {
+		m.t.Errorf("Expected call to StorageMock.CreateReport at\n%s", m.funcCreateReportOrigin)
+	}
+
+	if !m.CreateReportMock.invocationsDone() && afterCreateReportCounter > 0 {
+		m.t.Errorf("Expected %d calls to StorageMock.CreateReport at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateReportMock.expectedInvocations), m.CreateReportMock.expectedInvocationsOrigin, afterCreateReportCounter)
+	}
+}
+
+type mStorageMockGetAuditEntries struct {
+	optional           bool
+	mock               *StorageMock
+	defaultExpectation *StorageMockGetAuditEntriesExpectation
+	expectations       []*StorageMockGetAuditEntriesExpectation
+
+	callArgs []*StorageMockGetAuditEntriesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// StorageMockGetAuditEntriesExpectation specifies expectation struct of the Storage.GetAuditEntries
+type StorageMockGetAuditEntriesExpectation struct {
+	mock               *StorageMock
+	params             *StorageMockGetAuditEntriesParams
+	paramPtrs          *StorageMockGetAuditEntriesParamPtrs
+	expectationOrigins StorageMockGetAuditEntriesExpectationOrigins
+	results            *StorageMockGetAuditEntriesResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// StorageMockGetAuditEntriesParams contains parameters of the Storage.GetAuditEntries
+type StorageMockGetAuditEntriesParams struct {
+	ctx     context.Context
+	filter  model.AuditLogFilter
+	limit   int
+	afterID string
+}
+
+// StorageMockGetAuditEntriesParamPtrs contains pointers to parameters of the Storage.GetAuditEntries
+type StorageMockGetAuditEntriesParamPtrs struct {
+	ctx     *context.Context
+	filter  *model.AuditLogFilter
+	limit   *int
+	afterID *string
+}
+
+// StorageMockGetAuditEntriesResults contains results of the Storage.GetAuditEntries
+type StorageMockGetAuditEntriesResults struct {
+	apa1 []*model.AuditEntry
+	err  error
+}
+
+// StorageMockGetAuditEntriesOrigins contains origins of expectations of the Storage.GetAuditEntries
+type StorageMockGetAuditEntriesExpectationOrigins struct {
+	origin        string
+	originCtx     string
+	originFilter  string
+	originLimit   string
+	originAfterID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetAuditEntries *mStorageMockGetAuditEntries) Optional() *mStorageMockGetAuditEntries {
+	mmGetAuditEntries.optional = true
+	return mmGetAuditEntries
+}
+
+// Expect sets up expected params for Storage.GetAuditEntries
+func (mmGetAuditEntries *mStorageMockGetAuditEntries) Expect(ctx context.Context, filter model.AuditLogFilter, limit int, afterID string) *mStorageMockGetAuditEntries {
+	if mmGetAuditEntries.mock.funcGetAuditEntries != nil {
+		mmGetAuditEntries.mock.t.Fatalf("StorageMock.GetAuditEntries mock is already set by Set")
+	}
+
+	if mmGetAuditEntries.defaultExpectation == nil {
+		mmGetAuditEntries.defaultExpectation = &StorageMockGetAuditEntriesExpectation{}
+	}
+
+	if mmGetAuditEntries.defaultExpectation.paramPtrs != nil {
+		mmGetAuditEntries.mock.t.Fatalf("StorageMock.GetAuditEntries mock is already set by ExpectParams functions")
+	}
+
+	mmGetAuditEntries.defaultExpectation.params = &StorageMockGetAuditEntriesParams{ctx, filter, limit, afterID}
+	mmGetAuditEntries.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetAuditEntries.expectations {
+		if minimock.Equal(e.params, mmGetAuditEntries.defaultExpectation.params) {
+			mmGetAuditEntries.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetAuditEntries.defaultExpectation.params)
+		}
+	}
+
+	return mmGetAuditEntries
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Storage.GetAuditEntries
+func (mmGetAuditEntries *mStorageMockGetAuditEntries) ExpectCtxParam1(ctx context.Context) *mStorageMockGetAuditEntries {
+	if mmGetAuditEntries.mock.funcGetAuditEntries != nil {
+		mmGetAuditEntries.mock.t.Fatalf("StorageMock.GetAuditEntries mock is already set by Set")
+	}
+
+	if mmGetAuditEntries.defaultExpectation == nil {
+		mmGetAuditEntries.defaultExpectation = &StorageMockGetAuditEntriesExpectation{}
+	}
+
+	if mmGetAuditEntries.defaultExpectation.params != nil {
+		mmGetAuditEntries.mock.t.Fatalf("StorageMock.GetAuditEntries mock is already set by Expect")
+	}
+
+	if mmGetAuditEntries.defaultExpectation.paramPtrs == nil {
+		mmGetAuditEntries.defaultExpectation.paramPtrs = &StorageMockGetAuditEntriesParamPtrs{}
+	}
+	mmGetAuditEntries.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetAuditEntries.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetAuditEntries
+}
+
+// ExpectFilterParam2 sets up expected param filter for Storage.GetAuditEntries
+func (mmGetAuditEntries *mStorageMockGetAuditEntries) ExpectFilterParam2(filter model.AuditLogFilter) *mStorageMockGetAuditEntries {
+	if mmGetAuditEntries.mock.funcGetAuditEntries != nil {
+		mmGetAuditEntries.mock.t.Fatalf("StorageMock.GetAuditEntries mock is already set by Set")
+	}
+
+	if mmGetAuditEntries.defaultExpectation == nil {
+		mmGetAuditEntries.defaultExpectation = &StorageMockGetAuditEntriesExpectation{}
+	}
+
+	if mmGetAuditEntries.defaultExpectation.params != nil {
+		mmGetAuditEntries.mock.t.Fatalf("StorageMock.GetAuditEntries mock is already set by Expect")
+	}
+
+	if mmGetAuditEntries.defaultExpectation.paramPtrs == nil {
+		mmGetAuditEntries.defaultExpectation.paramPtrs = &StorageMockGetAuditEntriesParamPtrs{}
+	}
+	mmGetAuditEntries.defaultExpectation.paramPtrs.filter = &filter
+	mmGetAuditEntries.defaultExpectation.expectationOrigins.originFilter = minimock.CallerInfo(1)
+
+	return mmGetAuditEntries
+}
+
+// ExpectLimitParam3 sets up expected param limit for Storage.GetAuditEntries
+func (mmGetAuditEntries *mStorageMockGetAuditEntries) ExpectLimitParam3(limit int) *mStorageMockGetAuditEntries {
+	if mmGetAuditEntries.mock.funcGetAuditEntries != nil {
+		mmGetAuditEntries.mock.t.Fatalf("StorageMock.GetAuditEntries mock is already set by Set")
+	}
+
+	if mmGetAuditEntries.defaultExpectation == nil {
+		mmGetAuditEntries.defaultExpectation = &StorageMockGetAuditEntriesExpectation{}
+	}
+
+	if mmGetAuditEntries.defaultExpectation.params != nil {
+		mmGetAuditEntries.mock.t.Fatalf("StorageMock.GetAuditEntries mock is already set by Expect")
+	}
+
+	if mmGetAuditEntries.defaultExpectation.paramPtrs == nil {
+		mmGetAuditEntries.defaultExpectation.paramPtrs = &StorageMockGetAuditEntriesParamPtrs{}
+	}
+	mmGetAuditEntries.defaultExpectation.paramPtrs.limit = &limit
+	mmGetAuditEntries.defaultExpectation.expectationOrigins.originLimit = minimock.CallerInfo(1)
+
+	return mmGetAuditEntries
+}
+
+// ExpectAfterIDParam4 sets up expected param afterID for Storage.GetAuditEntries
+func (mmGetAuditEntries *mStorageMockGetAuditEntries) ExpectAfterIDParam4(afterID string) *mStorageMockGetAuditEntries {
+	if mmGetAuditEntries.mock.funcGetAuditEntries != nil {
+		mmGetAuditEntries.mock.t.Fatalf("StorageMock.GetAuditEntries mock is already set by Set")
+	}
+
+	if mmGetAuditEntries.defaultExpectation == nil {
+		mmGetAuditEntries.defaultExpectation = &StorageMockGetAuditEntriesExpectation{}
+	}
+
+	if mmGetAuditEntries.defaultExpectation.params != nil {
+		mmGetAuditEntries.mock.t.Fatalf("StorageMock.GetAuditEntries mock is already set by Expect")
+	}
+
+	if mmGetAuditEntries.defaultExpectation.paramPtrs == nil {
+		mmGetAuditEntries.defaultExpectation.paramPtrs = &StorageMockGetAuditEntriesParamPtrs{}
+	}
+	mmGetAuditEntries.defaultExpectation.paramPtrs.afterID = &afterID
+	mmGetAuditEntries.defaultExpectation.expectationOrigins.originAfterID = minimock.CallerInfo(1)
+
+	return mmGetAuditEntries
+}
+
+// Inspect accepts an inspector function that has same arguments as the Storage.GetAuditEntries
+func (mmGetAuditEntries *mStorageMockGetAuditEntries) Inspect(f func(ctx context.Context, filter model.AuditLogFilter, limit int, afterID string)) *mStorageMockGetAuditEntries {
+	if mmGetAuditEntries.mock.inspectFuncGetAuditEntries != nil {
+		mmGetAuditEntries.mock.t.Fatalf("Inspect function is already set for StorageMock.GetAuditEntries")
+	}
+
+	mmGetAuditEntries.mock.inspectFuncGetAuditEntries = f
+
+	return mmGetAuditEntries
+}
+
+// Return sets up results that will be returned by Storage.GetAuditEntries
+func (mmGetAuditEntries *mStorageMockGetAuditEntries) Return(apa1 []*model.AuditEntry, err error) *StorageMock {
+	if mmGetAuditEntries.mock.funcGetAuditEntries != nil {
+		mmGetAuditEntries.mock.t.Fatalf("StorageMock.GetAuditEntries mock is already set by Set")
+	}
+
+	if mmGetAuditEntries.defaultExpectation == nil {
+		mmGetAuditEntries.defaultExpectation = &StorageMockGetAuditEntriesExpectation{mock: mmGetAuditEntries.mock}
+	}
+	mmGetAuditEntries.defaultExpectation.results = &StorageMockGetAuditEntriesResults{apa1, err}
+	mmGetAuditEntries.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetAuditEntries.mock
+}
+
+// Set uses given function f to mock the Storage.GetAuditEntries method
+func (mmGetAuditEntries *mStorageMockGetAuditEntries) Set(f func(ctx context.Context, filter model.AuditLogFilter, limit int, afterID string) (apa1 []*model.AuditEntry, err error)) *StorageMock {
+	if mmGetAuditEntries.defaultExpectation != nil {
+		mmGetAuditEntries.mock.t.Fatalf("Default expectation is already set for the Storage.GetAuditEntries method")
+	}
+
+	if len(mmGetAuditEntries.expectations) > 0 {
+		mmGetAuditEntries.mock.t.Fatalf("Some expectations are already set for the Storage.GetAuditEntries method")
+	}
+
+	mmGetAuditEntries.mock.funcGetAuditEntries = f
+	mmGetAuditEntries.mock.funcGetAuditEntriesOrigin = minimock.CallerInfo(1)
+	return mmGetAuditEntries.mock
+}
+
+// When sets expectation for the Storage.GetAuditEntries which will trigger the result defined by the following
+// Then helper
+func (mmGetAuditEntries *mStorageMockGetAuditEntries) When(ctx context.Context, filter model.AuditLogFilter, limit int, afterID string) *StorageMockGetAuditEntriesExpectation {
+	if mmGetAuditEntries.mock.funcGetAuditEntries != nil {
+		mmGetAuditEntries.mock.t.Fatalf("StorageMock.GetAuditEntries mock is already set by Set")
+	}
+
+	expectation := &StorageMockGetAuditEntriesExpectation{
+		mock:               mmGetAuditEntries.mock,
+		params:             &StorageMockGetAuditEntriesParams{ctx, filter, limit, afterID},
+		expectationOrigins: StorageMockGetAuditEntriesExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetAuditEntries.expectations = append(mmGetAuditEntries.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Storage.GetAuditEntries return parameters for the expectation previously defined by the When method
+func (e *StorageMockGetAuditEntriesExpectation) Then(apa1 []*model.AuditEntry, err error) *StorageMock {
+	e.results = &StorageMockGetAuditEntriesResults{apa1, err}
+	return e.mock
+}
+
+// Times sets number of times Storage.GetAuditEntries should be invoked
+func (mmGetAuditEntries *mStorageMockGetAuditEntries) Times(n uint64) *mStorageMockGetAuditEntries {
+	if n == 0 {
+		mmGetAuditEntries.mock.t.Fatalf("Times of StorageMock.GetAuditEntries mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetAuditEntries.expectedInvocations, n)
+	mmGetAuditEntries.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetAuditEntries
+}
+
+func (mmGetAuditEntries *mStorageMockGetAuditEntries) invocationsDone() bool {
+	if len(mmGetAuditEntries.expectations) == 0 && mmGetAuditEntries.defaultExpectation == nil && mmGetAuditEntries.mock.funcGetAuditEntries == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetAuditEntries.mock.afterGetAuditEntriesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetAuditEntries.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetAuditEntries implements mm_storage.Storage
+func (mmGetAuditEntries *StorageMock) GetAuditEntries(ctx context.Context, filter model.AuditLogFilter, limit int, afterID string) (apa1 []*model.AuditEntry, err error) {
+	mm_atomic.AddUint64(&mmGetAuditEntries.beforeGetAuditEntriesCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetAuditEntries.afterGetAuditEntriesCounter, 1)
+
+	mmGetAuditEntries.t.Helper()
+
+	if mmGetAuditEntries.inspectFuncGetAuditEntries != nil {
+		mmGetAuditEntries.inspectFuncGetAuditEntries(ctx, filter, limit, afterID)
+	}
+
+	mm_params := StorageMockGetAuditEntriesParams{ctx, filter, limit, afterID}
+
+	// Record call args
+	mmGetAuditEntries.GetAuditEntriesMock.mutex.Lock()
+	mmGetAuditEntries.GetAuditEntriesMock.callArgs = append(mmGetAuditEntries.GetAuditEntriesMock.callArgs, &mm_params)
+	mmGetAuditEntries.GetAuditEntriesMock.mutex.Unlock()
+
+	for _, e := range mmGetAuditEntries.GetAuditEntriesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.apa1, e.results.err
+		}
+	}
+
+	if mmGetAuditEntries.GetAuditEntriesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetAuditEntries.GetAuditEntriesMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetAuditEntries.GetAuditEntriesMock.defaultExpectation.params
+		mm_want_ptrs := mmGetAuditEntries.GetAuditEntriesMock.defaultExpectation.paramPtrs
+
+		mm_got := StorageMockGetAuditEntriesParams{ctx, filter, limit, afterID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetAuditEntries.t.Errorf("StorageMock.GetAuditEntries got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetAuditEntries.GetAuditEntriesMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.filter != nil && !minimock.Equal(*mm_want_ptrs.filter, mm_got.filter) {
+				mmGetAuditEntries.t.Errorf("StorageMock.GetAuditEntries got unexpected parameter filter, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetAuditEntries.GetAuditEntriesMock.defaultExpectation.expectationOrigins.originFilter, *mm_want_ptrs.filter, mm_got.filter, minimock.Diff(*mm_want_ptrs.filter, mm_got.filter))
+			}
+
+			if mm_want_ptrs.limit != nil && !minimock.Equal(*mm_want_ptrs.limit, mm_got.limit) {
+				mmGetAuditEntries.t.Errorf("StorageMock.GetAuditEntries got unexpected parameter limit, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetAuditEntries.GetAuditEntriesMock.defaultExpectation.expectationOrigins.originLimit, *mm_want_ptrs.limit, mm_got.limit, minimock.Diff(*mm_want_ptrs.limit, mm_got.limit))
+			}
+
+			if mm_want_ptrs.afterID != nil && !minimock.Equal(*mm_want_ptrs.afterID, mm_got.afterID) {
+				mmGetAuditEntries.t.Errorf("StorageMock.GetAuditEntries got unexpected parameter afterID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetAuditEntries.GetAuditEntriesMock.defaultExpectation.expectationOrigins.originAfterID, *mm_want_ptrs.afterID, mm_got.afterID, minimock.Diff(*mm_want_ptrs.afterID, mm_got.afterID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetAuditEntries.t.Errorf("StorageMock.GetAuditEntries got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetAuditEntries.GetAuditEntriesMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetAuditEntries.GetAuditEntriesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetAuditEntries.t.Fatal("No results are set for the StorageMock.GetAuditEntries")
+		}
+		return (*mm_results).apa1, (*mm_results).err
+	}
+	if mmGetAuditEntries.funcGetAuditEntries != nil {
+		return mmGetAuditEntries.funcGetAuditEntries(ctx, filter, limit, afterID)
+	}
+	mmGetAuditEntries.t.Fatalf("Unexpected call to StorageMock.GetAuditEntries. %v %v %v %v", ctx, filter, limit, afterID)
+	return
+}
+
+// GetAuditEntriesAfterCounter returns a count of finished StorageMock.GetAuditEntries invocations
+func (mmGetAuditEntries *StorageMock) GetAuditEntriesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetAuditEntries.afterGetAuditEntriesCounter)
+}
+
+// GetAuditEntriesBeforeCounter returns a count of StorageMock.GetAuditEntries invocations
+func (mmGetAuditEntries *StorageMock) GetAuditEntriesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetAuditEntries.beforeGetAuditEntriesCounter)
+}
+
+// Calls returns a list of arguments used in each call to StorageMock.GetAuditEntries.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetAuditEntries *mStorageMockGetAuditEntries) Calls() []*StorageMockGetAuditEntriesParams {
+	mmGetAuditEntries.mutex.RLock()
+
+	argCopy := make([]*StorageMockGetAuditEntriesParams, len(mmGetAuditEntries.callArgs))
+	copy(argCopy, mmGetAuditEntries.callArgs)
+
+	mmGetAuditEntries.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetAuditEntriesDone returns true if the count of the GetAuditEntries invocations corresponds
+// the number of defined expectations
+func (m *StorageMock) MinimockGetAuditEntriesDone() bool {
+	if m.GetAuditEntriesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetAuditEntriesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetAuditEntriesMock.invocationsDone()
+}
+
+// MinimockGetAuditEntriesInspect logs each unmet expectation
+func (m *StorageMock) MinimockGetAuditEntriesInspect() {
+	for _, e := range m.GetAuditEntriesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to StorageMock.GetAuditEntries at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetAuditEntriesCounter := mm_atomic.LoadUint64(&m.afterGetAuditEntriesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetAuditEntriesMock.defaultExpectation != nil && afterGetAuditEntriesCounter < 1 {
+		if m.GetAuditEntriesMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to StorageMock.GetAuditEntries at\n%s", m.GetAuditEntriesMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to StorageMock.GetAuditEntries at\n%s with params: %#v", m.GetAuditEntriesMock.defaultExpectation.expectationOrigins.origin, *m.GetAuditEntriesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetAuditEntries != nil && afterGetAuditEntriesCounter < 1 {
+		m.t.Errorf("Expected call to StorageMock.GetAuditEntries at\n%s", m.funcGetAuditEntriesOrigin)
+	}
+
+	if !m.GetAuditEntriesMock.invocationsDone() && afterGetAuditEntriesCounter > 0 {
+		m.t.Errorf("Expected %d calls to StorageMock.GetAuditEntries at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetAuditEntriesMock.expectedInvocations), m.GetAuditEntriesMock.expectedInvocationsOrigin, afterGetAuditEntriesCounter)
+	}
+}
+
+type mStorageMockGetCommentByID struct {
+	optional           bool
+	mock               *StorageMock
+	defaultExpectation *StorageMockGetCommentByIDExpectation
+	expectations       []*StorageMockGetCommentByIDExpectation
+
+	callArgs []*StorageMockGetCommentByIDParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// StorageMockGetCommentByIDExpectation specifies expectation struct of the Storage.GetCommentByID
+type StorageMockGetCommentByIDExpectation struct {
+	mock               *StorageMock
+	params             *StorageMockGetCommentByIDParams
+	paramPtrs          *StorageMockGetCommentByIDParamPtrs
+	expectationOrigins StorageMockGetCommentByIDExpectationOrigins
+	results            *StorageMockGetCommentByIDResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// StorageMockGetCommentByIDParams contains parameters of the Storage.GetCommentByID
+type StorageMockGetCommentByIDParams struct {
+	ctx context.Context
+	id  string
+}
+
+// StorageMockGetCommentByIDParamPtrs contains pointers to parameters of the Storage.GetCommentByID
+type StorageMockGetCommentByIDParamPtrs struct {
+	ctx *context.Context
+	id  *string
+}
+
+// StorageMockGetCommentByIDResults contains results of the Storage.GetCommentByID
+type StorageMockGetCommentByIDResults struct {
+	cp1 *model.Comment
+	err error
+}
+
+// StorageMockGetCommentByIDOrigins contains origins of expectations of the Storage.GetCommentByID
+type StorageMockGetCommentByIDExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originId  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetCommentByID *mStorageMockGetCommentByID) Optional() *mStorageMockGetCommentByID {
+	mmGetCommentByID.optional = true
+	return mmGetCommentByID
+}
+
+// Expect sets up expected params for Storage.GetCommentByID
+func (mmGetCommentByID *mStorageMockGetCommentByID) Expect(ctx context.Context, id string) *mStorageMockGetCommentByID {
+	if mmGetCommentByID.mock.funcGetCommentByID != nil {
+		mmGetCommentByID.mock.t.Fatalf("StorageMock.GetCommentByID mock is already set by Set")
+	}
+
+	if mmGetCommentByID.defaultExpectation == nil {
+		mmGetCommentByID.defaultExpectation = &StorageMockGetCommentByIDExpectation{}
+	}
+
+	if mmGetCommentByID.defaultExpectation.paramPtrs != nil {
+		mmGetCommentByID.mock.t.Fatalf("StorageMock.GetCommentByID mock is already set by ExpectParams functions")
+	}
+
+	mmGetCommentByID.defaultExpectation.params = &StorageMockGetCommentByIDParams{ctx, id}
+	mmGetCommentByID.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetCommentByID.expectations {
+		if minimock.Equal(e.params, mmGetCommentByID.defaultExpectation.params) {
+			mmGetCommentByID.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetCommentByID.defaultExpectation.params)
+		}
+	}
+
+	return mmGetCommentByID
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Storage.GetCommentByID
+func (mmGetCommentByID *mStorageMockGetCommentByID) ExpectCtxParam1(ctx context.Context) *mStorageMockGetCommentByID {
+	if mmGetCommentByID.mock.funcGetCommentByID != nil {
+		mmGetCommentByID.mock.t.Fatalf("StorageMock.GetCommentByID mock is already set by Set")
+	}
+
+	if mmGetCommentByID.defaultExpectation == nil {
+		mmGetCommentByID.defaultExpectation = &StorageMockGetCommentByIDExpectation{}
+	}
+
+	if mmGetCommentByID.defaultExpectation.params != nil {
+		mmGetCommentByID.mock.t.Fatalf("StorageMock.GetCommentByID mock is already set by Expect")
+	}
+
+	if mmGetCommentByID.defaultExpectation.paramPtrs == nil {
+		mmGetCommentByID.defaultExpectation.paramPtrs = &StorageMockGetCommentByIDParamPtrs{}
+	}
+	mmGetCommentByID.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetCommentByID.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetCommentByID
+}
+
+// ExpectIdParam2 sets up expected param id for Storage.GetCommentByID
+func (mmGetCommentByID *mStorageMockGetCommentByID) ExpectIdParam2(id string) *mStorageMockGetCommentByID {
+	if mmGetCommentByID.mock.funcGetCommentByID != nil {
+		mmGetCommentByID.mock.t.Fatalf("StorageMock.GetCommentByID mock is already set by Set")
+	}
+
+	if mmGetCommentByID.defaultExpectation == nil {
+		mmGetCommentByID.defaultExpectation = &StorageMockGetCommentByIDExpectation{}
+	}
+
+	if mmGetCommentByID.defaultExpectation.params != nil {
+		mmGetCommentByID.mock.t.Fatalf("StorageMock.GetCommentByID mock is already set by Expect")
+	}
+
+	if mmGetCommentByID.defaultExpectation.paramPtrs == nil {
+		mmGetCommentByID.defaultExpectation.paramPtrs = &StorageMockGetCommentByIDParamPtrs{}
+	}
+	mmGetCommentByID.defaultExpectation.paramPtrs.id = &id
+	mmGetCommentByID.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmGetCommentByID
+}
+
+// Inspect accepts an inspector function that has same arguments as the Storage.GetCommentByID
+func (mmGetCommentByID *mStorageMockGetCommentByID) Inspect(f func(ctx context.Context, id string)) *mStorageMockGetCommentByID {
+	if mmGetCommentByID.mock.inspectFuncGetCommentByID != nil {
+		mmGetCommentByID.mock.t.Fatalf("Inspect function is already set for StorageMock.GetCommentByID")
+	}
+
+	mmGetCommentByID.mock.inspectFuncGetCommentByID = f
+
+	return mmGetCommentByID
+}
+
+// Return sets up results that will be returned by Storage.GetCommentByID
+func (mmGetCommentByID *mStorageMockGetCommentByID) Return(cp1 *model.Comment, err error) *StorageMock {
+	if mmGetCommentByID.mock.funcGetCommentByID != nil {
+		mmGetCommentByID.mock.t.Fatalf("StorageMock.GetCommentByID mock is already set by Set")
+	}
+
+	if mmGetCommentByID.defaultExpectation == nil {
+		mmGetCommentByID.defaultExpectation = &StorageMockGetCommentByIDExpectation{mock: mmGetCommentByID.mock}
+	}
+	mmGetCommentByID.defaultExpectation.results = &StorageMockGetCommentByIDResults{cp1, err}
+	mmGetCommentByID.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetCommentByID.mock
+}
+
+// Set uses given function f to mock the Storage.GetCommentByID method
+func (mmGetCommentByID *mStorageMockGetCommentByID) Set(f func(ctx context.Context, id string) (cp1 *model.Comment, err error)) *StorageMock {
+	if mmGetCommentByID.defaultExpectation != nil {
+		mmGetCommentByID.mock.t.Fatalf("Default expectation is already set for the Storage.GetCommentByID method")
+	}
+
+	if len(mmGetCommentByID.expectations) > 0 {
+		mmGetCommentByID.mock.t.Fatalf("Some expectations are already set for the Storage.GetCommentByID method")
+	}
+
+	mmGetCommentByID.mock.funcGetCommentByID = f
+	mmGetCommentByID.mock.funcGetCommentByIDOrigin = minimock.CallerInfo(1)
+	return mmGetCommentByID.mock
+}
+
+// When sets expectation for the Storage.GetCommentByID which will trigger the result defined by the following
+// Then helper
+func (mmGetCommentByID *mStorageMockGetCommentByID) When(ctx context.Context, id string) *StorageMockGetCommentByIDExpectation {
+	if mmGetCommentByID.mock.funcGetCommentByID != nil {
+		mmGetCommentByID.mock.t.Fatalf("StorageMock.GetCommentByID mock is already set by Set")
+	}
+
+	expectation := &StorageMockGetCommentByIDExpectation{
+		mock:               mmGetCommentByID.mock,
+		params:             &StorageMockGetCommentByIDParams{ctx, id},
+		expectationOrigins: StorageMockGetCommentByIDExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetCommentByID.expectations = append(mmGetCommentByID.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Storage.GetCommentByID return parameters for the expectation previously defined by the When method
+func (e *StorageMockGetCommentByIDExpectation) Then(cp1 *model.Comment, err error) *StorageMock {
+	e.results = &StorageMockGetCommentByIDResults{cp1, err}
+	return e.mock
+}
+
+// Times sets number of times Storage.GetCommentByID should be invoked
+func (mmGetCommentByID *mStorageMockGetCommentByID) Times(n uint64) *mStorageMockGetCommentByID {
+	if n == 0 {
+		mmGetCommentByID.mock.t.Fatalf("Times of StorageMock.GetCommentByID mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetCommentByID.expectedInvocations, n)
+	mmGetCommentByID.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetCommentByID
+}
+
+func (mmGetCommentByID *mStorageMockGetCommentByID) invocationsDone() bool {
+	if len(mmGetCommentByID.expectations) == 0 && mmGetCommentByID.defaultExpectation == nil && mmGetCommentByID.mock.funcGetCommentByID == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetCommentByID.mock.afterGetCommentByIDCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetCommentByID.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetCommentByID implements mm_storage.Storage
+func (mmGetCommentByID *StorageMock) GetCommentByID(ctx context.Context, id string) (cp1 *model.Comment, err error) {
+	mm_atomic.AddUint64(&mmGetCommentByID.beforeGetCommentByIDCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetCommentByID.afterGetCommentByIDCounter, 1)
+
+	mmGetCommentByID.t.Helper()
+
+	if mmGetCommentByID.inspectFuncGetCommentByID != nil {
+		mmGetCommentByID.inspectFuncGetCommentByID(ctx, id)
+	}
+
+	mm_params := StorageMockGetCommentByIDParams{ctx, id}
+
+	// Record call args
+	mmGetCommentByID.GetCommentByIDMock.mutex.Lock()
+	mmGetCommentByID.GetCommentByIDMock.callArgs = append(mmGetCommentByID.GetCommentByIDMock.callArgs, &mm_params)
+	mmGetCommentByID.GetCommentByIDMock.mutex.Unlock()
+
+	for _, e := range mmGetCommentByID.GetCommentByIDMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.cp1, e.results.err
+		}
+	}
+
+	if mmGetCommentByID.GetCommentByIDMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetCommentByID.GetCommentByIDMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetCommentByID.GetCommentByIDMock.defaultExpectation.params
+		mm_want_ptrs := mmGetCommentByID.GetCommentByIDMock.defaultExpectation.paramPtrs
+
+		mm_got := StorageMockGetCommentByIDParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetCommentByID.t.Errorf("StorageMock.GetCommentByID got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetCommentByID.GetCommentByIDMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmGetCommentByID.t.Errorf("StorageMock.GetCommentByID got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetCommentByID.GetCommentByIDMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetCommentByID.t.Errorf("StorageMock.GetCommentByID got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetCommentByID.GetCommentByIDMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetCommentByID.GetCommentByIDMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetCommentByID.t.Fatal("No results are set for the StorageMock.GetCommentByID")
+		}
+		return (*mm_results).cp1, (*mm_results).err
+	}
+	if mmGetCommentByID.funcGetCommentByID != nil {
+		return mmGetCommentByID.funcGetCommentByID(ctx, id)
+	}
+	mmGetCommentByID.t.Fatalf("Unexpected call to StorageMock.GetCommentByID. %v %v", ctx, id)
+	return
+}
+
+// GetCommentByIDAfterCounter returns a count of finished StorageMock.GetCommentByID invocations
+func (mmGetCommentByID *StorageMock) GetCommentByIDAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetCommentByID.afterGetCommentByIDCounter)
+}
+
+// GetCommentByIDBeforeCounter returns a count of StorageMock.GetCommentByID invocations
+func (mmGetCommentByID *StorageMock) GetCommentByIDBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetCommentByID.beforeGetCommentByIDCounter)
+}
+
+// Calls returns a list of arguments used in each call to StorageMock.GetCommentByID.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetCommentByID *mStorageMockGetCommentByID) Calls() []*StorageMockGetCommentByIDParams {
+	mmGetCommentByID.mutex.RLock()
+
+	argCopy := make([]*StorageMockGetCommentByIDParams, len(mmGetCommentByID.callArgs))
+	copy(argCopy, mmGetCommentByID.callArgs)
+
+	mmGetCommentByID.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetCommentByIDDone returns true if the count of the GetCommentByID invocations corresponds
+// the number of defined expectations
+func (m *StorageMock) MinimockGetCommentByIDDone() bool {
+	if m.GetCommentByIDMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetCommentByIDMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetCommentByIDMock.invocationsDone()
+}
+
+// MinimockGetCommentByIDInspect logs each unmet expectation
+func (m *StorageMock) MinimockGetCommentByIDInspect() {
+	for _, e := range m.GetCommentByIDMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to StorageMock.GetCommentByID at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetCommentByIDCounter := mm_atomic.LoadUint64(&m.afterGetCommentByIDCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetCommentByIDMock.defaultExpectation != nil && afterGetCommentByIDCounter < 1 {
+		if m.GetCommentByIDMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to StorageMock.GetCommentByID at\n%s", m.GetCommentByIDMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to StorageMock.GetCommentByID at\n%s with params: %#v", m.GetCommentByIDMock.defaultExpectation.expectationOrigins.origin, *m.GetCommentByIDMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetCommentByID != nil && afterGetCommentByIDCounter < 1 {
+		m.t.Errorf("Expected call to StorageMock.GetCommentByID at\n%s", m.funcGetCommentByIDOrigin)
+	}
+
+	if !m.GetCommentByIDMock.invocationsDone() && afterGetCommentByIDCounter > 0 {
+		m.t.Errorf("Expected %d calls to StorageMock.GetCommentByID at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetCommentByIDMock.expectedInvocations), m.GetCommentByIDMock.expectedInvocationsOrigin, afterGetCommentByIDCounter)
+	}
+}
+
+type mStorageMockGetCommentsByPostID struct {
+	optional           bool
+	mock               *StorageMock
+	defaultExpectation *StorageMockGetCommentsByPostIDExpectation
+	expectations       []*StorageMockGetCommentsByPostIDExpectation
+
+	callArgs []*StorageMockGetCommentsByPostIDParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// StorageMockGetCommentsByPostIDExpectation specifies expectation struct of the Storage.GetCommentsByPostID
+type StorageMockGetCommentsByPostIDExpectation struct {
+	mock               *StorageMock
+	params             *StorageMockGetCommentsByPostIDParams
+	paramPtrs          *StorageMockGetCommentsByPostIDParamPtrs
+	expectationOrigins StorageMockGetCommentsByPostIDExpectationOrigins
+	results            *StorageMockGetCommentsByPostIDResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// StorageMockGetCommentsByPostIDParams contains parameters of the Storage.GetCommentsByPostID
+type StorageMockGetCommentsByPostIDParams struct {
+	ctx    context.Context
+	postID string
+	limit  int
+	offset int
+}
+
+// StorageMockGetCommentsByPostIDParamPtrs contains pointers to parameters of the Storage.GetCommentsByPostID
+type StorageMockGetCommentsByPostIDParamPtrs struct {
+	ctx    *context.Context
+	postID *string
+	limit  *int
+	offset *int
+}
+
+// StorageMockGetCommentsByPostIDResults contains results of the Storage.GetCommentsByPostID
+type StorageMockGetCommentsByPostIDResults struct {
+	cpa1 []*model.Comment
+	err  error
+}
+
+// StorageMockGetCommentsByPostIDOrigins contains origins of expectations of the Storage.GetCommentsByPostID
+type StorageMockGetCommentsByPostIDExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originPostID string
+	originLimit  string
+	originOffset string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetCommentsByPostID *mStorageMockGetCommentsByPostID) Optional() *mStorageMockGetCommentsByPostID {
+	mmGetCommentsByPostID.optional = true
+	return mmGetCommentsByPostID
+}
+
+// Expect sets up expected params for Storage.GetCommentsByPostID
+func (mmGetCommentsByPostID *mStorageMockGetCommentsByPostID) Expect(ctx context.Context, postID string, limit int, offset int) *mStorageMockGetCommentsByPostID {
+	if mmGetCommentsByPostID.mock.funcGetCommentsByPostID != nil {
+		mmGetCommentsByPostID.mock.t.Fatalf("StorageMock.GetCommentsByPostID mock is already set by Set")
+	}
+
+	if mmGetCommentsByPostID.defaultExpectation == nil {
+		mmGetCommentsByPostID.defaultExpectation = &StorageMockGetCommentsByPostIDExpectation{}
+	}
+
+	if mmGetCommentsByPostID.defaultExpectation.paramPtrs != nil {
+		mmGetCommentsByPostID.mock.t.Fatalf("StorageMock.GetCommentsByPostID mock is already set by ExpectParams functions")
+	}
+
+	mmGetCommentsByPostID.defaultExpectation.params = &StorageMockGetCommentsByPostIDParams{ctx, postID, limit, offset}
+	mmGetCommentsByPostID.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetCommentsByPostID.expectations {
+		if minimock.Equal(e.params, mmGetCommentsByPostID.defaultExpectation.params) {
+			mmGetCommentsByPostID.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetCommentsByPostID.defaultExpectation.params)
+		}
+	}
+
+	return mmGetCommentsByPostID
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Storage.GetCommentsByPostID
+func (mmGetCommentsByPostID *mStorageMockGetCommentsByPostID) ExpectCtxParam1(ctx context.Context) *mStorageMockGetCommentsByPostID {
+	if mmGetCommentsByPostID.mock.funcGetCommentsByPostID != nil {
+		mmGetCommentsByPostID.mock.t.Fatalf("StorageMock.GetCommentsByPostID mock is already set by Set")
+	}
+
+	if mmGetCommentsByPostID.defaultExpectation == nil {
+		mmGetCommentsByPostID.defaultExpectation = &StorageMockGetCommentsByPostIDExpectation{}
+	}
+
+	if mmGetCommentsByPostID.defaultExpectation.params != nil {
+		mmGetCommentsByPostID.mock.t.Fatalf("StorageMock.GetCommentsByPostID mock is already set by Expect")
+	}
+
+	if mmGetCommentsByPostID.defaultExpectation.paramPtrs == nil {
+		mmGetCommentsByPostID.defaultExpectation.paramPtrs = &StorageMockGetCommentsByPostIDParamPtrs{}
+	}
+	mmGetCommentsByPostID.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetCommentsByPostID.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetCommentsByPostID
+}
+
+// ExpectPostIDParam2 sets up expected param postID for Storage.GetCommentsByPostID
+func (mmGetCommentsByPostID *mStorageMockGetCommentsByPostID) ExpectPostIDParam2(postID string) *mStorageMockGetCommentsByPostID {
+	if mmGetCommentsByPostID.mock.funcGetCommentsByPostID != nil {
+		mmGetCommentsByPostID.mock.t.Fatalf("StorageMock.GetCommentsByPostID mock is already set by Set")
+	}
+
+	if mmGetCommentsByPostID.defaultExpectation == nil {
+		mmGetCommentsByPostID.defaultExpectation = &StorageMockGetCommentsByPostIDExpectation{}
+	}
+
+	if mmGetCommentsByPostID.defaultExpectation.params != nil {
+		mmGetCommentsByPostID.mock.t.Fatalf("StorageMock.GetCommentsByPostID mock is already set by Expect")
+	}
+
+	if mmGetCommentsByPostID.defaultExpectation.paramPtrs == nil {
+		mmGetCommentsByPostID.defaultExpectation.paramPtrs = &StorageMockGetCommentsByPostIDParamPtrs{}
+	}
+	mmGetCommentsByPostID.defaultExpectation.paramPtrs.postID = &postID
+	mmGetCommentsByPostID.defaultExpectation.expectationOrigins.originPostID = minimock.CallerInfo(1)
+
+	return mmGetCommentsByPostID
+}
+
+// ExpectLimitParam3 sets up expected param limit for Storage.GetCommentsByPostID
+func (mmGetCommentsByPostID *mStorageMockGetCommentsByPostID) ExpectLimitParam3(limit int) *mStorageMockGetCommentsByPostID {
+	if mmGetCommentsByPostID.mock.funcGetCommentsByPostID != nil {
+		mmGetCommentsByPostID.mock.t.Fatalf("StorageMock.GetCommentsByPostID mock is already set by Set")
+	}
+
+	if mmGetCommentsByPostID.defaultExpectation == nil {
+		mmGetCommentsByPostID.defaultExpectation = &StorageMockGetCommentsByPostIDExpectation{}
+	}
+
+	if mmGetCommentsByPostID.defaultExpectation.params != nil {
+		mmGetCommentsByPostID.mock.t.Fatalf("StorageMock.GetCommentsByPostID mock is already set by Expect")
+	}
+
+	if mmGetCommentsByPostID.defaultExpectation.paramPtrs == nil {
+		mmGetCommentsByPostID.defaultExpectation.paramPtrs = &StorageMockGetCommentsByPostIDParamPtrs{}
+	}
+	mmGetCommentsByPostID.defaultExpectation.paramPtrs.limit = &limit
+	mmGetCommentsByPostID.defaultExpectation.expectationOrigins.originLimit = minimock.CallerInfo(1)
+
+	return mmGetCommentsByPostID
+}
+
+// ExpectOffsetParam4 sets up expected param offset for Storage.GetCommentsByPostID
+func (mmGetCommentsByPostID *mStorageMockGetCommentsByPostID) ExpectOffsetParam4(offset int) *mStorageMockGetCommentsByPostID {
+	if mmGetCommentsByPostID.mock.funcGetCommentsByPostID != nil {
+		mmGetCommentsByPostID.mock.t.Fatalf("StorageMock.GetCommentsByPostID mock is already set by Set")
+	}
+
+	if mmGetCommentsByPostID.defaultExpectation == nil {
+		mmGetCommentsByPostID.defaultExpectation = &StorageMockGetCommentsByPostIDExpectation{}
+	}
+
+	if mmGetCommentsByPostID.defaultExpectation.params != nil {
+		mmGetCommentsByPostID.mock.t.Fatalf("StorageMock.GetCommentsByPostID mock is already set by Expect")
+	}
+
+	if mmGetCommentsByPostID.defaultExpectation.paramPtrs == nil {
+		mmGetCommentsByPostID.defaultExpectation.paramPtrs = &StorageMockGetCommentsByPostIDParamPtrs{}
+	}
+	mmGetCommentsByPostID.defaultExpectation.paramPtrs.offset = &offset
+	mmGetCommentsByPostID.defaultExpectation.expectationOrigins.originOffset = minimock.CallerInfo(1)
+
+	return mmGetCommentsByPostID
+}
+
+// Inspect accepts an inspector function that has same arguments as the Storage.GetCommentsByPostID
+func (mmGetCommentsByPostID *mStorageMockGetCommentsByPostID) Inspect(f func(ctx context.Context, postID string, limit int, offset int)) *mStorageMockGetCommentsByPostID {
+	if mmGetCommentsByPostID.mock.inspectFuncGetCommentsByPostID != nil {
+		mmGetCommentsByPostID.mock.t.Fatalf("Inspect function is already set for StorageMock.GetCommentsByPostID")
+	}
+
+	mmGetCommentsByPostID.mock.inspectFuncGetCommentsByPostID = f
+
+	return mmGetCommentsByPostID
+}
+
+// Return sets up results that will be returned by Storage.GetCommentsByPostID
+func (mmGetCommentsByPostID *mStorageMockGetCommentsByPostID) Return(cpa1 []*model.Comment, err error) *StorageMock {
+	if mmGetCommentsByPostID.mock.funcGetCommentsByPostID != nil {
+		mmGetCommentsByPostID.mock.t.Fatalf("StorageMock.GetCommentsByPostID mock is already set by Set")
+	}
+
+	if mmGetCommentsByPostID.defaultExpectation == nil {
+		mmGetCommentsByPostID.defaultExpectation = &StorageMockGetCommentsByPostIDExpectation{mock: mmGetCommentsByPostID.mock}
+	}
+	mmGetCommentsByPostID.defaultExpectation.results = &StorageMockGetCommentsByPostIDResults{cpa1, err}
+	mmGetCommentsByPostID.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetCommentsByPostID.mock
+}
+
+// Set uses given function f to mock the Storage.GetCommentsByPostID method
+func (mmGetCommentsByPostID *mStorageMockGetCommentsByPostID) Set(f func(ctx context.Context, postID string, limit int, offset int) (cpa1 []*model.Comment, err error)) *StorageMock {
+	if mmGetCommentsByPostID.defaultExpectation != nil {
+		mmGetCommentsByPostID.mock.t.Fatalf("Default expectation is already set for the Storage.GetCommentsByPostID method")
+	}
+
+	if len(mmGetCommentsByPostID.expectations) > 0 {
+		mmGetCommentsByPostID.mock.t.Fatalf("Some expectations are already set for the Storage.GetCommentsByPostID method")
+	}
+
+	mmGetCommentsByPostID.mock.funcGetCommentsByPostID = f
+	mmGetCommentsByPostID.mock.funcGetCommentsByPostIDOrigin = minimock.CallerInfo(1)
+	return mmGetCommentsByPostID.mock
+}
+
+// When sets expectation for the Storage.GetCommentsByPostID which will trigger the result defined by the following
+// Then helper
+func (mmGetCommentsByPostID *mStorageMockGetCommentsByPostID) When(ctx context.Context, postID string, limit int, offset int) *StorageMockGetCommentsByPostIDExpectation {
+	if mmGetCommentsByPostID.mock.funcGetCommentsByPostID != nil {
+		mmGetCommentsByPostID.mock.t.Fatalf("StorageMock.GetCommentsByPostID mock is already set by Set")
+	}
+
+	expectation := &StorageMockGetCommentsByPostIDExpectation{
+		mock:               mmGetCommentsByPostID.mock,
+		params:             &StorageMockGetCommentsByPostIDParams{ctx, postID, limit, offset},
+		expectationOrigins: StorageMockGetCommentsByPostIDExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetCommentsByPostID.expectations = append(mmGetCommentsByPostID.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Storage.GetCommentsByPostID return parameters for the expectation previously defined by the When method
+func (e *StorageMockGetCommentsByPostIDExpectation) Then(cpa1 []*model.Comment, err error) *StorageMock {
+	e.results = &StorageMockGetCommentsByPostIDResults{cpa1, err}
+	return e.mock
+}
+
+// Times sets number of times Storage.GetCommentsByPostID should be invoked
+func (mmGetCommentsByPostID *mStorageMockGetCommentsByPostID) Times(n uint64) *mStorageMockGetCommentsByPostID {
+	if n == 0 {
+		mmGetCommentsByPostID.mock.t.Fatalf("Times of StorageMock.GetCommentsByPostID mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetCommentsByPostID.expectedInvocations, n)
+	mmGetCommentsByPostID.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetCommentsByPostID
+}
+
+func (mmGetCommentsByPostID *mStorageMockGetCommentsByPostID) invocationsDone() bool {
+	if len(mmGetCommentsByPostID.expectations) == 0 && mmGetCommentsByPostID.defaultExpectation == nil && mmGetCommentsByPostID.mock.funcGetCommentsByPostID == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetCommentsByPostID.mock.afterGetCommentsByPostIDCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetCommentsByPostID.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetCommentsByPostID implements mm_storage.Storage
+func (mmGetCommentsByPostID *StorageMock) GetCommentsByPostID(ctx context.Context, postID string, limit int, offset int) (cpa1 []*model.Comment, err error) {
+	mm_atomic.AddUint64(&mmGetCommentsByPostID.beforeGetCommentsByPostIDCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetCommentsByPostID.afterGetCommentsByPostIDCounter, 1)
+
+	mmGetCommentsByPostID.t.Helper()
+
+	if mmGetCommentsByPostID.inspectFuncGetCommentsByPostID != nil {
+		mmGetCommentsByPostID.inspectFuncGetCommentsByPostID(ctx, postID, limit, offset)
+	}
+
+	mm_params := StorageMockGetCommentsByPostIDParams{ctx, postID, limit, offset}
+
+	// Record call args
+	mmGetCommentsByPostID.GetCommentsByPostIDMock.mutex.Lock()
+	mmGetCommentsByPostID.GetCommentsByPostIDMock.callArgs = append(mmGetCommentsByPostID.GetCommentsByPostIDMock.callArgs, &mm_params)
+	mmGetCommentsByPostID.GetCommentsByPostIDMock.mutex.Unlock()
+
+	for _, e := range mmGetCommentsByPostID.GetCommentsByPostIDMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.cpa1, e.results.err
+		}
+	}
+
+	if mmGetCommentsByPostID.GetCommentsByPostIDMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetCommentsByPostID.GetCommentsByPostIDMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetCommentsByPostID.GetCommentsByPostIDMock.defaultExpectation.params
+		mm_want_ptrs := mmGetCommentsByPostID.GetCommentsByPostIDMock.defaultExpectation.paramPtrs
+
+		mm_got := StorageMockGetCommentsByPostIDParams{ctx, postID, limit, offset}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetCommentsByPostID.t.Errorf("StorageMock.GetCommentsByPostID got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetCommentsByPostID.GetCommentsByPostIDMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.postID != nil && !minimock.Equal(*mm_want_ptrs.postID, mm_got.postID) {
+				mmGetCommentsByPostID.t.Errorf("StorageMock.GetCommentsByPostID got unexpected parameter postID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetCommentsByPostID.GetCommentsByPostIDMock.defaultExpectation.expectationOrigins.originPostID, *mm_want_ptrs.postID, mm_got.postID, minimock.Diff(*mm_want_ptrs.postID, mm_got.postID))
+			}
+
+			if mm_want_ptrs.limit != nil && !minimock.Equal(*mm_want_ptrs.limit, mm_got.limit) {
+				mmGetCommentsByPostID.t.Errorf("StorageMock.GetCommentsByPostID got unexpected parameter limit, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetCommentsByPostID.GetCommentsByPostIDMock.defaultExpectation.expectationOrigins.originLimit, *mm_want_ptrs.limit, mm_got.limit, minimock.Diff(*mm_want_ptrs.limit, mm_got.limit))
+			}
+
+			if mm_want_ptrs.offset != nil && !minimock.Equal(*mm_want_ptrs.offset, mm_got.offset) {
+				mmGetCommentsByPostID.t.Errorf("StorageMock.GetCommentsByPostID got unexpected parameter offset, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetCommentsByPostID.GetCommentsByPostIDMock.defaultExpectation.expectationOrigins.originOffset, *mm_want_ptrs.offset, mm_got.offset, minimock.Diff(*mm_want_ptrs.offset, mm_got.offset))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetCommentsByPostID.t.Errorf("StorageMock.GetCommentsByPostID got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetCommentsByPostID.GetCommentsByPostIDMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetCommentsByPostID.GetCommentsByPostIDMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetCommentsByPostID.t.Fatal("No results are set for the StorageMock.GetCommentsByPostID")
+		}
+		return (*mm_results).cpa1, (*mm_results).err
+	}
+	if mmGetCommentsByPostID.funcGetCommentsByPostID != nil {
+		return mmGetCommentsByPostID.funcGetCommentsByPostID(ctx, postID, limit, offset)
+	}
+	mmGetCommentsByPostID.t.Fatalf("Unexpected call to StorageMock.GetCommentsByPostID. %v %v %v %v", ctx, postID, limit, offset)
+	return
+}
+
+// GetCommentsByPostIDAfterCounter returns a count of finished StorageMock.GetCommentsByPostID invocations
+func (mmGetCommentsByPostID *StorageMock) GetCommentsByPostIDAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetCommentsByPostID.afterGetCommentsByPostIDCounter)
+}
+
+// GetCommentsByPostIDBeforeCounter returns a count of StorageMock.GetCommentsByPostID invocations
+func (mmGetCommentsByPostID *StorageMock) GetCommentsByPostIDBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetCommentsByPostID.beforeGetCommentsByPostIDCounter)
+}
+
+// Calls returns a list of arguments used in each call to StorageMock.GetCommentsByPostID.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetCommentsByPostID *mStorageMockGetCommentsByPostID) Calls() []*StorageMockGetCommentsByPostIDParams {
+	mmGetCommentsByPostID.mutex.RLock()
+
+	argCopy := make([]*StorageMockGetCommentsByPostIDParams, len(mmGetCommentsByPostID.callArgs))
+	copy(argCopy, mmGetCommentsByPostID.callArgs)
+
+	mmGetCommentsByPostID.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetCommentsByPostIDDone returns true if the count of the GetCommentsByPostID invocations corresponds
+// the number of defined expectations
+func (m *StorageMock) MinimockGetCommentsByPostIDDone() bool {
+	if m.GetCommentsByPostIDMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetCommentsByPostIDMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetCommentsByPostIDMock.invocationsDone()
+}
+
+// MinimockGetCommentsByPostIDInspect logs each unmet expectation
+func (m *StorageMock) MinimockGetCommentsByPostIDInspect() {
+	for _, e := range m.GetCommentsByPostIDMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to StorageMock.GetCommentsByPostID at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetCommentsByPostIDCounter := mm_atomic.LoadUint64(&m.afterGetCommentsByPostIDCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetCommentsByPostIDMock.defaultExpectation != nil && afterGetCommentsByPostIDCounter < 1 {
+		if m.GetCommentsByPostIDMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to StorageMock.GetCommentsByPostID at\n%s", m.GetCommentsByPostIDMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to StorageMock.GetCommentsByPostID at\n%s with params: %#v", m.GetCommentsByPostIDMock.defaultExpectation.expectationOrigins.origin, *m.GetCommentsByPostIDMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetCommentsByPostID != nil && afterGetCommentsByPostIDCounter < 1 {
+		m.t.Errorf("Expected call to StorageMock.GetCommentsByPostID at\n%s", m.funcGetCommentsByPostIDOrigin)
+	}
+
+	if !m.GetCommentsByPostIDMock.invocationsDone() && afterGetCommentsByPostIDCounter > 0 {
+		m.t.Errorf("Expected %d calls to StorageMock.GetCommentsByPostID at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetCommentsByPostIDMock.expectedInvocations), m.GetCommentsByPostIDMock.expectedInvocationsOrigin, afterGetCommentsByPostIDCounter)
+	}
+}
+
+type mStorageMockGetCommentsSince struct {
+	optional           bool
+	mock               *StorageMock
+	defaultExpectation *StorageMockGetCommentsSinceExpectation
+	expectations       []*StorageMockGetCommentsSinceExpectation
+
+	callArgs []*StorageMockGetCommentsSinceParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// StorageMockGetCommentsSinceExpectation specifies expectation struct of the Storage.GetCommentsSince
+type StorageMockGetCommentsSinceExpectation struct {
+	mock               *StorageMock
+	params             *StorageMockGetCommentsSinceParams
+	paramPtrs          *StorageMockGetCommentsSinceParamPtrs
+	expectationOrigins StorageMockGetCommentsSinceExpectationOrigins
+	results            *StorageMockGetCommentsSinceResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// StorageMockGetCommentsSinceParams contains parameters of the Storage.GetCommentsSince
+type StorageMockGetCommentsSinceParams struct {
+	ctx     context.Context
+	postID  string
+	since   time.Time
+	afterID string
+	limit   int
+}
+
+// StorageMockGetCommentsSinceParamPtrs contains pointers to parameters of the Storage.GetCommentsSince
+type StorageMockGetCommentsSinceParamPtrs struct {
+	ctx     *context.Context
+	postID  *string
+	since   *time.Time
+	afterID *string
+	limit   *int
+}
+
+// StorageMockGetCommentsSinceResults contains results of the Storage.GetCommentsSince
+type StorageMockGetCommentsSinceResults struct {
+	cpa1 []*model.Comment
+	err  error
+}
+
+// StorageMockGetCommentsSinceOrigins contains origins of expectations of the Storage.GetCommentsSince
+type StorageMockGetCommentsSinceExpectationOrigins struct {
+	origin        string
+	originCtx     string
+	originPostID  string
+	originSince   string
+	originAfterID string
+	originLimit   string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetCommentsSince *mStorageMockGetCommentsSince) Optional() *mStorageMockGetCommentsSince {
+	mmGetCommentsSince.optional = true
+	return mmGetCommentsSince
+}
+
+// Expect sets up expected params for Storage.GetCommentsSince
+func (mmGetCommentsSince *mStorageMockGetCommentsSince) Expect(ctx context.Context, postID string, since time.Time, afterID string, limit int) *mStorageMockGetCommentsSince {
+	if mmGetCommentsSince.mock.funcGetCommentsSince != nil {
+		mmGetCommentsSince.mock.t.Fatalf("StorageMock.GetCommentsSince mock is already set by Set")
+	}
+
+	if mmGetCommentsSince.defaultExpectation == nil {
+		mmGetCommentsSince.defaultExpectation = &StorageMockGetCommentsSinceExpectation{}
+	}
+
+	if mmGetCommentsSince.defaultExpectation.paramPtrs != nil {
+		mmGetCommentsSince.mock.t.Fatalf("StorageMock.GetCommentsSince mock is already set by ExpectParams functions")
+	}
+
+	mmGetCommentsSince.defaultExpectation.params = &StorageMockGetCommentsSinceParams{ctx, postID, since, afterID, limit}
+	mmGetCommentsSince.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetCommentsSince.expectations {
+		if minimock.Equal(e.params, mmGetCommentsSince.defaultExpectation.params) {
+			mmGetCommentsSince.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetCommentsSince.defaultExpectation.params)
+		}
+	}
+
+	return mmGetCommentsSince
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Storage.GetCommentsSince
+func (mmGetCommentsSince *mStorageMockGetCommentsSince) ExpectCtxParam1(ctx context.Context) *mStorageMockGetCommentsSince {
+	if mmGetCommentsSince.mock.funcGetCommentsSince != nil {
+		mmGetCommentsSince.mock.t.Fatalf("StorageMock.GetCommentsSince mock is already set by Set")
+	}
+
+	if mmGetCommentsSince.defaultExpectation == nil {
+		mmGetCommentsSince.defaultExpectation = &StorageMockGetCommentsSinceExpectation{}
+	}
+
+	if mmGetCommentsSince.defaultExpectation.params != nil {
+		mmGetCommentsSince.mock.t.Fatalf("StorageMock.GetCommentsSince mock is already set by Expect")
+	}
+
+	if mmGetCommentsSince.defaultExpectation.paramPtrs == nil {
+		mmGetCommentsSince.defaultExpectation.paramPtrs = &StorageMockGetCommentsSinceParamPtrs{}
+	}
+	mmGetCommentsSince.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetCommentsSince.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetCommentsSince
+}
+
+// ExpectPostIDParam2 sets up expected param postID for Storage.GetCommentsSince
+func (mmGetCommentsSince *mStorageMockGetCommentsSince) ExpectPostIDParam2(postID string) *mStorageMockGetCommentsSince {
+	if mmGetCommentsSince.mock.funcGetCommentsSince != nil {
+		mmGetCommentsSince.mock.t.Fatalf("StorageMock.GetCommentsSince mock is already set by Set")
+	}
+
+	if mmGetCommentsSince.defaultExpectation == nil {
+		mmGetCommentsSince.defaultExpectation = &StorageMockGetCommentsSinceExpectation{}
+	}
+
+	if mmGetCommentsSince.defaultExpectation.params != nil {
+		mmGetCommentsSince.mock.t.Fatalf("StorageMock.GetCommentsSince mock is already set by Expect")
+	}
+
+	if mmGetCommentsSince.defaultExpectation.paramPtrs == nil {
+		mmGetCommentsSince.defaultExpectation.paramPtrs = &StorageMockGetCommentsSinceParamPtrs{}
+	}
+	mmGetCommentsSince.defaultExpectation.paramPtrs.postID = &postID
+	mmGetCommentsSince.defaultExpectation.expectationOrigins.originPostID = minimock.CallerInfo(1)
+
+	return mmGetCommentsSince
+}
+
+// ExpectSinceParam3 sets up expected param since for Storage.GetCommentsSince
+func (mmGetCommentsSince *mStorageMockGetCommentsSince) ExpectSinceParam3(since time.Time) *mStorageMockGetCommentsSince {
+	if mmGetCommentsSince.mock.funcGetCommentsSince != nil {
+		mmGetCommentsSince.mock.t.Fatalf("StorageMock.GetCommentsSince mock is already set by Set")
+	}
+
+	if mmGetCommentsSince.defaultExpectation == nil {
+		mmGetCommentsSince.defaultExpectation = &StorageMockGetCommentsSinceExpectation{}
+	}
+
+	if mmGetCommentsSince.defaultExpectation.params != nil {
+		mmGetCommentsSince.mock.t.Fatalf("StorageMock.GetCommentsSince mock is already set by Expect")
+	}
+
+	if mmGetCommentsSince.defaultExpectation.paramPtrs == nil {
+		mmGetCommentsSince.defaultExpectation.paramPtrs = &StorageMockGetCommentsSinceParamPtrs{}
+	}
+	mmGetCommentsSince.defaultExpectation.paramPtrs.since = &since
+	mmGetCommentsSince.defaultExpectation.expectationOrigins.originSince = minimock.CallerInfo(1)
+
+	return mmGetCommentsSince
+}
+
+// ExpectAfterIDParam4 sets up expected param afterID for Storage.GetCommentsSince
+func (mmGetCommentsSince *mStorageMockGetCommentsSince) ExpectAfterIDParam4(afterID string) *mStorageMockGetCommentsSince {
+	if mmGetCommentsSince.mock.funcGetCommentsSince != nil {
+		mmGetCommentsSince.mock.t.Fatalf("StorageMock.GetCommentsSince mock is already set by Set")
+	}
+
+	if mmGetCommentsSince.defaultExpectation == nil {
+		mmGetCommentsSince.defaultExpectation = &StorageMockGetCommentsSinceExpectation{}
+	}
+
+	if mmGetCommentsSince.defaultExpectation.params != nil {
+		mmGetCommentsSince.mock.t.Fatalf("StorageMock.GetCommentsSince mock is already set by Expect")
+	}
+
+	if mmGetCommentsSince.defaultExpectation.paramPtrs == nil {
+		mmGetCommentsSince.defaultExpectation.paramPtrs = &StorageMockGetCommentsSinceParamPtrs{}
+	}
+	mmGetCommentsSince.defaultExpectation.paramPtrs.afterID = &afterID
+	mmGetCommentsSince.defaultExpectation.expectationOrigins.originAfterID = minimock.CallerInfo(1)
+
+	return mmGetCommentsSince
+}
+
+// ExpectLimitParam5 sets up expected param limit for Storage.GetCommentsSince
+func (mmGetCommentsSince *mStorageMockGetCommentsSince) ExpectLimitParam5(limit int) *mStorageMockGetCommentsSince {
+	if mmGetCommentsSince.mock.funcGetCommentsSince != nil {
+		mmGetCommentsSince.mock.t.Fatalf("StorageMock.GetCommentsSince mock is already set by Set")
+	}
+
+	if mmGetCommentsSince.defaultExpectation == nil {
+		mmGetCommentsSince.defaultExpectation = &StorageMockGetCommentsSinceExpectation{}
+	}
+
+	if mmGetCommentsSince.defaultExpectation.params != nil {
+		mmGetCommentsSince.mock.t.Fatalf("StorageMock.GetCommentsSince mock is already set by Expect")
+	}
+
+	if mmGetCommentsSince.defaultExpectation.paramPtrs == nil {
+		mmGetCommentsSince.defaultExpectation.paramPtrs = &StorageMockGetCommentsSinceParamPtrs{}
+	}
+	mmGetCommentsSince.defaultExpectation.paramPtrs.limit = &limit
+	mmGetCommentsSince.defaultExpectation.expectationOrigins.originLimit = minimock.CallerInfo(1)
+
+	return mmGetCommentsSince
+}
+
+// Inspect accepts an inspector function that has same arguments as the Storage.GetCommentsSince
+func (mmGetCommentsSince *mStorageMockGetCommentsSince) Inspect(f func(ctx context.Context, postID string, since time.Time, afterID string, limit int)) *mStorageMockGetCommentsSince {
+	if mmGetCommentsSince.mock.inspectFuncGetCommentsSince != nil {
+		mmGetCommentsSince.mock.t.Fatalf("Inspect function is already set for StorageMock.GetCommentsSince")
+	}
+
+	mmGetCommentsSince.mock.inspectFuncGetCommentsSince = f
+
+	return mmGetCommentsSince
+}
+
+// Return sets up results that will be returned by Storage.GetCommentsSince
+func (mmGetCommentsSince *mStorageMockGetCommentsSince) Return(cpa1 []*model.Comment, err error) *StorageMock {
+	if mmGetCommentsSince.mock.funcGetCommentsSince != nil {
+		mmGetCommentsSince.mock.t.Fatalf("StorageMock.GetCommentsSince mock is already set by Set")
+	}
+
+	if mmGetCommentsSince.defaultExpectation == nil {
+		mmGetCommentsSince.defaultExpectation = &StorageMockGetCommentsSinceExpectation{mock: mmGetCommentsSince.mock}
+	}
+	mmGetCommentsSince.defaultExpectation.results = &StorageMockGetCommentsSinceResults{cpa1, err}
+	mmGetCommentsSince.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetCommentsSince.mock
+}
+
+// Set uses given function f to mock the Storage.GetCommentsSince method
+func (mmGetCommentsSince *mStorageMockGetCommentsSince) Set(f func(ctx context.Context, postID string, since time.Time, afterID string, limit int) (cpa1 []*model.Comment, err error)) *StorageMock {
+	if mmGetCommentsSince.defaultExpectation != nil {
+		mmGetCommentsSince.mock.t.Fatalf("Default expectation is already set for the Storage.GetCommentsSince method")
+	}
+
+	if len(mmGetCommentsSince.expectations) > 0 {
+		mmGetCommentsSince.mock.t.Fatalf("Some expectations are already set for the Storage.GetCommentsSince method")
+	}
+
+	mmGetCommentsSince.mock.funcGetCommentsSince = f
+	mmGetCommentsSince.mock.funcGetCommentsSinceOrigin = minimock.CallerInfo(1)
+	return mmGetCommentsSince.mock
+}
+
+// When sets expectation for the Storage.GetCommentsSince which will trigger the result defined by the following
+// Then helper
+func (mmGetCommentsSince *mStorageMockGetCommentsSince) When(ctx context.Context, postID string, since time.Time, afterID string, limit int) *StorageMockGetCommentsSinceExpectation {
+	if mmGetCommentsSince.mock.funcGetCommentsSince != nil {
+		mmGetCommentsSince.mock.t.Fatalf("StorageMock.GetCommentsSince mock is already set by Set")
+	}
+
+	expectation := &StorageMockGetCommentsSinceExpectation{
+		mock:               mmGetCommentsSince.mock,
+		params:             &StorageMockGetCommentsSinceParams{ctx, postID, since, afterID, limit},
+		expectationOrigins: StorageMockGetCommentsSinceExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetCommentsSince.expectations = append(mmGetCommentsSince.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Storage.GetCommentsSince return parameters for the expectation previously defined by the When method
+func (e *StorageMockGetCommentsSinceExpectation) Then(cpa1 []*model.Comment, err error) *StorageMock {
+	e.results = &StorageMockGetCommentsSinceResults{cpa1, err}
+	return e.mock
+}
+
+// Times sets number of times Storage.GetCommentsSince should be invoked
+func (mmGetCommentsSince *mStorageMockGetCommentsSince) Times(n uint64) *mStorageMockGetCommentsSince {
+	if n == 0 {
+		mmGetCommentsSince.mock.t.Fatalf("Times of StorageMock.GetCommentsSince mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetCommentsSince.expectedInvocations, n)
+	mmGetCommentsSince.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetCommentsSince
+}
+
+func (mmGetCommentsSince *mStorageMockGetCommentsSince) invocationsDone() bool {
+	if len(mmGetCommentsSince.expectations) == 0 && mmGetCommentsSince.defaultExpectation == nil && mmGetCommentsSince.mock.funcGetCommentsSince == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetCommentsSince.mock.afterGetCommentsSinceCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetCommentsSince.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetCommentsSince implements mm_storage.Storage
+func (mmGetCommentsSince *StorageMock) GetCommentsSince(ctx context.Context, postID string, since time.Time, afterID string, limit int) (cpa1 []*model.Comment, err error) {
+	mm_atomic.AddUint64(&mmGetCommentsSince.beforeGetCommentsSinceCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetCommentsSince.afterGetCommentsSinceCounter, 1)
+
+	mmGetCommentsSince.t.Helper()
+
+	if mmGetCommentsSince.inspectFuncGetCommentsSince != nil {
+		mmGetCommentsSince.inspectFuncGetCommentsSince(ctx, postID, since, afterID, limit)
+	}
+
+	mm_params := StorageMockGetCommentsSinceParams{ctx, postID, since, afterID, limit}
+
+	// Record call args
+	mmGetCommentsSince.GetCommentsSinceMock.mutex.Lock()
+	mmGetCommentsSince.GetCommentsSinceMock.callArgs = append(mmGetCommentsSince.GetCommentsSinceMock.callArgs, &mm_params)
+	mmGetCommentsSince.GetCommentsSinceMock.mutex.Unlock()
+
+	for _, e := range mmGetCommentsSince.GetCommentsSinceMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.cpa1, e.results.err
+		}
+	}
+
+	if mmGetCommentsSince.GetCommentsSinceMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetCommentsSince.GetCommentsSinceMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetCommentsSince.GetCommentsSinceMock.defaultExpectation.params
+		mm_want_ptrs := mmGetCommentsSince.GetCommentsSinceMock.defaultExpectation.paramPtrs
+
+		mm_got := StorageMockGetCommentsSinceParams{ctx, postID, since, afterID, limit}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetCommentsSince.t.Errorf("StorageMock.GetCommentsSince got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetCommentsSince.GetCommentsSinceMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.postID != nil && !minimock.Equal(*mm_want_ptrs.postID, mm_got.postID) {
+				mmGetCommentsSince.t.Errorf("StorageMock.GetCommentsSince got unexpected parameter postID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetCommentsSince.GetCommentsSinceMock.defaultExpectation.expectationOrigins.originPostID, *mm_want_ptrs.postID, mm_got.postID, minimock.Diff(*mm_want_ptrs.postID, mm_got.postID))
+			}
+
+			if mm_want_ptrs.since != nil && !minimock.Equal(*mm_want_ptrs.since, mm_got.since) {
+				mmGetCommentsSince.t.Errorf("StorageMock.GetCommentsSince got unexpected parameter since, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetCommentsSince.GetCommentsSinceMock.defaultExpectation.expectationOrigins.originSince, *mm_want_ptrs.since, mm_got.since, minimock.Diff(*mm_want_ptrs.since, mm_got.since))
+			}
+
+			if mm_want_ptrs.afterID != nil && !minimock.Equal(*mm_want_ptrs.afterID, mm_got.afterID) {
+				mmGetCommentsSince.t.Errorf("StorageMock.GetCommentsSince got unexpected parameter afterID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetCommentsSince.GetCommentsSinceMock.defaultExpectation.expectationOrigins.originAfterID, *mm_want_ptrs.afterID, mm_got.afterID, minimock.Diff(*mm_want_ptrs.afterID, mm_got.afterID))
+			}
+
+			if mm_want_ptrs.limit != nil && !minimock.Equal(*mm_want_ptrs.limit, mm_got.limit) {
+				mmGetCommentsSince.t.Errorf("StorageMock.GetCommentsSince got unexpected parameter limit, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetCommentsSince.GetCommentsSinceMock.defaultExpectation.expectationOrigins.originLimit, *mm_want_ptrs.limit, mm_got.limit, minimock.Diff(*mm_want_ptrs.limit, mm_got.limit))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetCommentsSince.t.Errorf("StorageMock.GetCommentsSince got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetCommentsSince.GetCommentsSinceMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetCommentsSince.GetCommentsSinceMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetCommentsSince.t.Fatal("No results are set for the StorageMock.GetCommentsSince")
+		}
+		return (*mm_results).cpa1, (*mm_results).err
+	}
+	if mmGetCommentsSince.funcGetCommentsSince != nil {
+		return mmGetCommentsSince.funcGetCommentsSince(ctx, postID, since, afterID, limit)
+	}
+	mmGetCommentsSince.t.Fatalf("Unexpected call to StorageMock.GetCommentsSince. %v %v %v %v %v", ctx, postID, since, afterID, limit)
+	return
+}
+
+// GetCommentsSinceAfterCounter returns a count of finished StorageMock.GetCommentsSince invocations
+func (mmGetCommentsSince *StorageMock) GetCommentsSinceAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetCommentsSince.afterGetCommentsSinceCounter)
+}
+
+// GetCommentsSinceBeforeCounter returns a count of StorageMock.GetCommentsSince invocations
+func (mmGetCommentsSince *StorageMock) GetCommentsSinceBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetCommentsSince.beforeGetCommentsSinceCounter)
+}
+
+// Calls returns a list of arguments used in each call to StorageMock.GetCommentsSince.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetCommentsSince *mStorageMockGetCommentsSince) Calls() []*StorageMockGetCommentsSinceParams {
+	mmGetCommentsSince.mutex.RLock()
+
+	argCopy := make([]*StorageMockGetCommentsSinceParams, len(mmGetCommentsSince.callArgs))
+	copy(argCopy, mmGetCommentsSince.callArgs)
+
+	mmGetCommentsSince.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetCommentsSinceDone returns true if the count of the GetCommentsSince invocations corresponds
+// the number of defined expectations
+func (m *StorageMock) MinimockGetCommentsSinceDone() bool {
+	if m.GetCommentsSinceMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetCommentsSinceMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetCommentsSinceMock.invocationsDone()
+}
+
+// MinimockGetCommentsSinceInspect logs each unmet expectation
+func (m *StorageMock) MinimockGetCommentsSinceInspect() {
+	for _, e := range m.GetCommentsSinceMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to StorageMock.GetCommentsSince at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetCommentsSinceCounter := mm_atomic.LoadUint64(&m.afterGetCommentsSinceCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetCommentsSinceMock.defaultExpectation != nil && afterGetCommentsSinceCounter < 1 {
+		if m.GetCommentsSinceMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to StorageMock.GetCommentsSince at\n%s", m.GetCommentsSinceMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to StorageMock.GetCommentsSince at\n%s with params: %#v", m.GetCommentsSinceMock.defaultExpectation.expectationOrigins.origin, *m.GetCommentsSinceMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetCommentsSince != nil && afterGetCommentsSinceCounter < 1 {
+		m.t.Errorf("Expected call to StorageMock.GetCommentsSince at\n%s", m.funcGetCommentsSinceOrigin)
+	}
+
+	if !m.GetCommentsSinceMock.invocationsDone() && afterGetCommentsSinceCounter > 0 {
+		m.t.Errorf("Expected %d calls to StorageMock.GetCommentsSince at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetCommentsSinceMock.expectedInvocations), m.GetCommentsSinceMock.expectedInvocationsOrigin, afterGetCommentsSinceCounter)
+	}
+}
+
+type mStorageMockGetPostByID struct {
+	optional           bool
+	mock               *StorageMock
+	defaultExpectation *StorageMockGetPostByIDExpectation
+	expectations       []*StorageMockGetPostByIDExpectation
+
+	callArgs []*StorageMockGetPostByIDParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// StorageMockGetPostByIDExpectation specifies expectation struct of the Storage.GetPostByID
+type StorageMockGetPostByIDExpectation struct {
+	mock               *StorageMock
+	params             *StorageMockGetPostByIDParams
+	paramPtrs          *StorageMockGetPostByIDParamPtrs
+	expectationOrigins StorageMockGetPostByIDExpectationOrigins
+	results            *StorageMockGetPostByIDResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// StorageMockGetPostByIDParams contains parameters of the Storage.GetPostByID
+type StorageMockGetPostByIDParams struct {
+	ctx context.Context
+	id  string
+}
+
+// StorageMockGetPostByIDParamPtrs contains pointers to parameters of the Storage.GetPostByID
+type StorageMockGetPostByIDParamPtrs struct {
+	ctx *context.Context
+	id  *string
+}
+
+// StorageMockGetPostByIDResults contains results of the Storage.GetPostByID
+type StorageMockGetPostByIDResults struct {
+	pp1 *model.Post
+	err error
+}
+
+// StorageMockGetPostByIDOrigins contains origins of expectations of the Storage.GetPostByID
+type StorageMockGetPostByIDExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originId  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetPostByID *mStorageMockGetPostByID) Optional() *mStorageMockGetPostByID {
+	mmGetPostByID.optional = true
+	return mmGetPostByID
+}
+
+// Expect sets up expected params for Storage.GetPostByID
+func (mmGetPostByID *mStorageMockGetPostByID) Expect(ctx context.Context, id string) *mStorageMockGetPostByID {
+	if mmGetPostByID.mock.funcGetPostByID != nil {
+		mmGetPostByID.mock.t.Fatalf("StorageMock.GetPostByID mock is already set by Set")
+	}
+
+	if mmGetPostByID.defaultExpectation == nil {
+		mmGetPostByID.defaultExpectation = &StorageMockGetPostByIDExpectation{}
+	}
+
+	if mmGetPostByID.defaultExpectation.paramPtrs != nil {
+		mmGetPostByID.mock.t.Fatalf("StorageMock.GetPostByID mock is already set by ExpectParams functions")
+	}
+
+	mmGetPostByID.defaultExpectation.params = &StorageMockGetPostByIDParams{ctx, id}
+	mmGetPostByID.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetPostByID.expectations {
+		if minimock.Equal(e.params, mmGetPostByID.defaultExpectation.params) {
+			mmGetPostByID.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetPostByID.defaultExpectation.params)
+		}
+	}
+
+	return mmGetPostByID
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Storage.GetPostByID
+func (mmGetPostByID *mStorageMockGetPostByID) ExpectCtxParam1(ctx context.Context) *mStorageMockGetPostByID {
+	if mmGetPostByID.mock.funcGetPostByID != nil {
+		mmGetPostByID.mock.t.Fatalf("StorageMock.GetPostByID mock is already set by Set")
+	}
+
+	if mmGetPostByID.defaultExpectation == nil {
+		mmGetPostByID.defaultExpectation = &StorageMockGetPostByIDExpectation{}
+	}
+
+	if mmGetPostByID.defaultExpectation.params != nil {
+		mmGetPostByID.mock.t.Fatalf("StorageMock.GetPostByID mock is already set by Expect")
+	}
+
+	if mmGetPostByID.defaultExpectation.paramPtrs == nil {
+		mmGetPostByID.defaultExpectation.paramPtrs = &StorageMockGetPostByIDParamPtrs{}
+	}
+	mmGetPostByID.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetPostByID.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetPostByID
+}
+
+// ExpectIdParam2 sets up expected param id for Storage.GetPostByID
+func (mmGetPostByID *mStorageMockGetPostByID) ExpectIdParam2(id string) *mStorageMockGetPostByID {
+	if mmGetPostByID.mock.funcGetPostByID != nil {
+		mmGetPostByID.mock.t.Fatalf("StorageMock.GetPostByID mock is already set by Set")
+	}
+
+	if mmGetPostByID.defaultExpectation == nil {
+		mmGetPostByID.defaultExpectation = &StorageMockGetPostByIDExpectation{}
+	}
+
+	if mmGetPostByID.defaultExpectation.params != nil {
+		mmGetPostByID.mock.t.Fatalf("StorageMock.GetPostByID mock is already set by Expect")
+	}
+
+	if mmGetPostByID.defaultExpectation.paramPtrs == nil {
+		mmGetPostByID.defaultExpectation.paramPtrs = &StorageMockGetPostByIDParamPtrs{}
+	}
+	mmGetPostByID.defaultExpectation.paramPtrs.id = &id
+	mmGetPostByID.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmGetPostByID
+}
+
+// Inspect accepts an inspector function that has same arguments as the Storage.GetPostByID
+func (mmGetPostByID *mStorageMockGetPostByID) Inspect(f func(ctx context.Context, id string)) *mStorageMockGetPostByID {
+	if mmGetPostByID.mock.inspectFuncGetPostByID != nil {
+		mmGetPostByID.mock.t.Fatalf("Inspect function is already set for StorageMock.GetPostByID")
+	}
+
+	mmGetPostByID.mock.inspectFuncGetPostByID = f
+
+	return mmGetPostByID
+}
+
+// Return sets up results that will be returned by Storage.GetPostByID
+func (mmGetPostByID *mStorageMockGetPostByID) Return(pp1 *model.Post, err error) *StorageMock {
+	if mmGetPostByID.mock.funcGetPostByID != nil {
+		mmGetPostByID.mock.t.Fatalf("StorageMock.GetPostByID mock is already set by Set")
+	}
+
+	if mmGetPostByID.defaultExpectation == nil {
+		mmGetPostByID.defaultExpectation = &StorageMockGetPostByIDExpectation{mock: mmGetPostByID.mock}
+	}
+	mmGetPostByID.defaultExpectation.results = &StorageMockGetPostByIDResults{pp1, err}
+	mmGetPostByID.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetPostByID.mock
+}
+
+// Set uses given function f to mock the Storage.GetPostByID method
+func (mmGetPostByID *mStorageMockGetPostByID) Set(f func(ctx context.Context, id string) (pp1 *model.Post, err error)) *StorageMock {
+	if mmGetPostByID.defaultExpectation != nil {
+		mmGetPostByID.mock.t.Fatalf("Default expectation is already set for the Storage.GetPostByID method")
+	}
+
+	if len(mmGetPostByID.expectations) > 0 {
+		mmGetPostByID.mock.t.Fatalf("Some expectations are already set for the Storage.GetPostByID method")
+	}
+
+	mmGetPostByID.mock.funcGetPostByID = f
+	mmGetPostByID.mock.funcGetPostByIDOrigin = minimock.CallerInfo(1)
+	return mmGetPostByID.mock
+}
+
+// When sets expectation for the Storage.GetPostByID which will trigger the result defined by the following
+// Then helper
+func (mmGetPostByID *mStorageMockGetPostByID) When(ctx context.Context, id string) *StorageMockGetPostByIDExpectation {
+	if mmGetPostByID.mock.funcGetPostByID != nil {
+		mmGetPostByID.mock.t.Fatalf("StorageMock.GetPostByID mock is already set by Set")
+	}
+
+	expectation := &StorageMockGetPostByIDExpectation{
+		mock:               mmGetPostByID.mock,
+		params:             &StorageMockGetPostByIDParams{ctx, id},
+		expectationOrigins: StorageMockGetPostByIDExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetPostByID.expectations = append(mmGetPostByID.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Storage.GetPostByID return parameters for the expectation previously defined by the When method
+func (e *StorageMockGetPostByIDExpectation) Then(pp1 *model.Post, err error) *StorageMock {
+	e.results = &StorageMockGetPostByIDResults{pp1, err}
+	return e.mock
+}
+
+// Times sets number of times Storage.GetPostByID should be invoked
+func (mmGetPostByID *mStorageMockGetPostByID) Times(n uint64) *mStorageMockGetPostByID {
+	if n == 0 {
+		mmGetPostByID.mock.t.Fatalf("Times of StorageMock.GetPostByID mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetPostByID.expectedInvocations, n)
+	mmGetPostByID.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetPostByID
+}
+
+func (mmGetPostByID *mStorageMockGetPostByID) invocationsDone() bool {
+	if len(mmGetPostByID.expectations) == 0 && mmGetPostByID.defaultExpectation == nil && mmGetPostByID.mock.funcGetPostByID == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetPostByID.mock.afterGetPostByIDCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetPostByID.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetPostByID implements mm_storage.Storage
+func (mmGetPostByID *StorageMock) GetPostByID(ctx context.Context, id string) (pp1 *model.Post, err error) {
+	mm_atomic.AddUint64(&mmGetPostByID.beforeGetPostByIDCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetPostByID.afterGetPostByIDCounter, 1)
+
+	mmGetPostByID.t.Helper()
+
+	if mmGetPostByID.inspectFuncGetPostByID != nil {
+		mmGetPostByID.inspectFuncGetPostByID(ctx, id)
+	}
+
+	mm_params := StorageMockGetPostByIDParams{ctx, id}
+
+	// Record call args
+	mmGetPostByID.GetPostByIDMock.mutex.Lock()
+	mmGetPostByID.GetPostByIDMock.callArgs = append(mmGetPostByID.GetPostByIDMock.callArgs, &mm_params)
+	mmGetPostByID.GetPostByIDMock.mutex.Unlock()
+
+	for _, e := range mmGetPostByID.GetPostByIDMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.pp1, e.results.err
+		}
+	}
+
+	if mmGetPostByID.GetPostByIDMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetPostByID.GetPostByIDMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetPostByID.GetPostByIDMock.defaultExpectation.params
+		mm_want_ptrs := mmGetPostByID.GetPostByIDMock.defaultExpectation.paramPtrs
+
+		mm_got := StorageMockGetPostByIDParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetPostByID.t.Errorf("StorageMock.GetPostByID got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetPostByID.GetPostByIDMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmGetPostByID.t.Errorf("StorageMock.GetPostByID got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetPostByID.GetPostByIDMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetPostByID.t.Errorf("StorageMock.GetPostByID got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetPostByID.GetPostByIDMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetPostByID.GetPostByIDMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetPostByID.t.Fatal("No results are set for the StorageMock.GetPostByID")
+		}
+		return (*mm_results).pp1, (*mm_results).err
+	}
+	if mmGetPostByID.funcGetPostByID != nil {
+		return mmGetPostByID.funcGetPostByID(ctx, id)
+	}
+	mmGetPostByID.t.Fatalf("Unexpected call to StorageMock.GetPostByID. %v %v", ctx, id)
+	return
+}
+
+// GetPostByIDAfterCounter returns a count of finished StorageMock.GetPostByID invocations
+func (mmGetPostByID *StorageMock) GetPostByIDAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetPostByID.afterGetPostByIDCounter)
+}
+
+// GetPostByIDBeforeCounter returns a count of StorageMock.GetPostByID invocations
+func (mmGetPostByID *StorageMock) GetPostByIDBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetPostByID.beforeGetPostByIDCounter)
+}
+
+// Calls returns a list of arguments used in each call to StorageMock.GetPostByID.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetPostByID *mStorageMockGetPostByID) Calls() []*StorageMockGetPostByIDParams {
+	mmGetPostByID.mutex.RLock()
+
+	argCopy := make([]*StorageMockGetPostByIDParams, len(mmGetPostByID.callArgs))
+	copy(argCopy, mmGetPostByID.callArgs)
+
+	mmGetPostByID.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetPostByIDDone returns true if the count of the GetPostByID invocations corresponds
+// the number of defined expectations
+func (m *StorageMock) MinimockGetPostByIDDone() bool {
+	if m.GetPostByIDMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetPostByIDMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetPostByIDMock.invocationsDone()
+}
+
+// MinimockGetPostByIDInspect logs each unmet expectation
+func (m *StorageMock) MinimockGetPostByIDInspect() {
+	for _, e := range m.GetPostByIDMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to StorageMock.GetPostByID at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetPostByIDCounter := mm_atomic.LoadUint64(&m.afterGetPostByIDCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetPostByIDMock.defaultExpectation != nil && afterGetPostByIDCounter < 1 {
+		if m.GetPostByIDMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to StorageMock.GetPostByID at\n%s", m.GetPostByIDMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to StorageMock.GetPostByID at\n%s with params: %#v", m.GetPostByIDMock.defaultExpectation.expectationOrigins.origin, *m.GetPostByIDMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetPostByID != nil && afterGetPostByIDCounter < 1 {
+		m.t.Errorf("Expected call to StorageMock.GetPostByID at\n%s", m.funcGetPostByIDOrigin)
+	}
+
+	if !m.GetPostByIDMock.invocationsDone() && afterGetPostByIDCounter > 0 {
+		m.t.Errorf("Expected %d calls to StorageMock.GetPostByID at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetPostByIDMock.expectedInvocations), m.GetPostByIDMock.expectedInvocationsOrigin, afterGetPostByIDCounter)
+	}
+}
+
+type mStorageMockGetPostWithVisibility struct {
+	optional           bool
+	mock               *StorageMock
+	defaultExpectation *StorageMockGetPostWithVisibilityExpectation
+	expectations       []*StorageMockGetPostWithVisibilityExpectation
+
+	callArgs []*StorageMockGetPostWithVisibilityParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// StorageMockGetPostWithVisibilityExpectation specifies expectation struct of the Storage.GetPostWithVisibility
+type StorageMockGetPostWithVisibilityExpectation struct {
+	mock               *StorageMock
+	params             *StorageMockGetPostWithVisibilityParams
+	paramPtrs          *StorageMockGetPostWithVisibilityParamPtrs
+	expectationOrigins StorageMockGetPostWithVisibilityExpectationOrigins
+	results            *StorageMockGetPostWithVisibilityResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// StorageMockGetPostWithVisibilityParams contains parameters of the Storage.GetPostWithVisibility
+type StorageMockGetPostWithVisibilityParams struct {
+	ctx context.Context
+	id  string
+}
+
+// StorageMockGetPostWithVisibilityParamPtrs contains pointers to parameters of the Storage.GetPostWithVisibility
+type StorageMockGetPostWithVisibilityParamPtrs struct {
+	ctx *context.Context
+	id  *string
+}
+
+// StorageMockGetPostWithVisibilityResults contains results of the Storage.GetPostWithVisibility
+type StorageMockGetPostWithVisibilityResults struct {
+	pp1 *model.Post
+	v1  mm_storage.Visibility
+	err error
+}
+
+// StorageMockGetPostWithVisibilityOrigins contains origins of expectations of the Storage.GetPostWithVisibility
+type StorageMockGetPostWithVisibilityExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originId  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetPostWithVisibility *mStorageMockGetPostWithVisibility) Optional() *mStorageMockGetPostWithVisibility {
+	mmGetPostWithVisibility.optional = true
+	return mmGetPostWithVisibility
+}
+
+// Expect sets up expected params for Storage.GetPostWithVisibility
+func (mmGetPostWithVisibility *mStorageMockGetPostWithVisibility) Expect(ctx context.Context, id string) *mStorageMockGetPostWithVisibility {
+	if mmGetPostWithVisibility.mock.funcGetPostWithVisibility != nil {
+		mmGetPostWithVisibility.mock.t.Fatalf("StorageMock.GetPostWithVisibility mock is already set by Set")
+	}
+
+	if mmGetPostWithVisibility.defaultExpectation == nil {
+		mmGetPostWithVisibility.defaultExpectation = &StorageMockGetPostWithVisibilityExpectation{}
+	}
+
+	if mmGetPostWithVisibility.defaultExpectation.paramPtrs != nil {
+		mmGetPostWithVisibility.mock.t.Fatalf("StorageMock.GetPostWithVisibility mock is already set by ExpectParams functions")
+	}
+
+	mmGetPostWithVisibility.defaultExpectation.params = &StorageMockGetPostWithVisibilityParams{ctx, id}
+	mmGetPostWithVisibility.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetPostWithVisibility.expectations {
+		if minimock.Equal(e.params, mmGetPostWithVisibility.defaultExpectation.params) {
+			mmGetPostWithVisibility.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetPostWithVisibility.defaultExpectation.params)
+		}
+	}
+
+	return mmGetPostWithVisibility
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Storage.GetPostWithVisibility
+func (mmGetPostWithVisibility *mStorageMockGetPostWithVisibility) ExpectCtxParam1(ctx context.Context) *mStorageMockGetPostWithVisibility {
+	if mmGetPostWithVisibility.mock.funcGetPostWithVisibility != nil {
+		mmGetPostWithVisibility.mock.t.Fatalf("StorageMock.GetPostWithVisibility mock is already set by Set")
+	}
+
+	if mmGetPostWithVisibility.defaultExpectation == nil {
+		mmGetPostWithVisibility.defaultExpectation = &StorageMockGetPostWithVisibilityExpectation{}
+	}
+
+	if mmGetPostWithVisibility.defaultExpectation.params != nil {
+		mmGetPostWithVisibility.mock.t.Fatalf("StorageMock.GetPostWithVisibility mock is already set by Expect")
+	}
+
+	if mmGetPostWithVisibility.defaultExpectation.paramPtrs == nil {
+		mmGetPostWithVisibility.defaultExpectation.paramPtrs = &StorageMockGetPostWithVisibilityParamPtrs{}
+	}
+	mmGetPostWithVisibility.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetPostWithVisibility.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetPostWithVisibility
+}
+
+// ExpectIdParam2 sets up expected param id for Storage.GetPostWithVisibility
+func (mmGetPostWithVisibility *mStorageMockGetPostWithVisibility) ExpectIdParam2(id string) *mStorageMockGetPostWithVisibility {
+	if mmGetPostWithVisibility.mock.funcGetPostWithVisibility != nil {
+		mmGetPostWithVisibility.mock.t.Fatalf("StorageMock.GetPostWithVisibility mock is already set by Set")
+	}
+
+	if mmGetPostWithVisibility.defaultExpectation == nil {
+		mmGetPostWithVisibility.defaultExpectation = &StorageMockGetPostWithVisibilityExpectation{}
+	}
+
+	if mmGetPostWithVisibility.defaultExpectation.params != nil {
+		mmGetPostWithVisibility.mock.t.Fatalf("StorageMock.GetPostWithVisibility mock is already set by Expect")
+	}
+
+	if mmGetPostWithVisibility.defaultExpectation.paramPtrs == nil {
+		mmGetPostWithVisibility.defaultExpectation.paramPtrs = &StorageMockGetPostWithVisibilityParamPtrs{}
+	}
+	mmGetPostWithVisibility.defaultExpectation.paramPtrs.id = &id
+	mmGetPostWithVisibility.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmGetPostWithVisibility
+}
+
+// Inspect accepts an inspector function that has same arguments as the Storage.GetPostWithVisibility
+func (mmGetPostWithVisibility *mStorageMockGetPostWithVisibility) Inspect(f func(ctx context.Context, id string)) *mStorageMockGetPostWithVisibility {
+	if mmGetPostWithVisibility.mock.inspectFuncGetPostWithVisibility != nil {
+		mmGetPostWithVisibility.mock.t.Fatalf("Inspect function is already set for StorageMock.GetPostWithVisibility")
+	}
+
+	mmGetPostWithVisibility.mock.inspectFuncGetPostWithVisibility = f
+
+	return mmGetPostWithVisibility
+}
+
+// Return sets up results that will be returned by Storage.GetPostWithVisibility
+func (mmGetPostWithVisibility *mStorageMockGetPostWithVisibility) Return(pp1 *model.Post, v1 mm_storage.Visibility, err error) *StorageMock {
+	if mmGetPostWithVisibility.mock.funcGetPostWithVisibility != nil {
+		mmGetPostWithVisibility.mock.t.Fatalf("StorageMock.GetPostWithVisibility mock is already set by Set")
+	}
+
+	if mmGetPostWithVisibility.defaultExpectation == nil {
+		mmGetPostWithVisibility.defaultExpectation = &StorageMockGetPostWithVisibilityExpectation{mock: mmGetPostWithVisibility.mock}
+	}
+	mmGetPostWithVisibility.defaultExpectation.results = &StorageMockGetPostWithVisibilityResults{pp1, v1, err}
+	mmGetPostWithVisibility.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetPostWithVisibility.mock
+}
+
+// Set uses given function f to mock the Storage.GetPostWithVisibility method
+func (mmGetPostWithVisibility *mStorageMockGetPostWithVisibility) Set(f func(ctx context.Context, id string) (pp1 *model.Post, v1 mm_storage.Visibility, err error)) *StorageMock {
+	if mmGetPostWithVisibility.defaultExpectation != nil {
+		mmGetPostWithVisibility.mock.t.Fatalf("Default expectation is already set for the Storage.GetPostWithVisibility method")
+	}
+
+	if len(mmGetPostWithVisibility.expectations) > 0 {
+		mmGetPostWithVisibility.mock.t.Fatalf("Some expectations are already set for the Storage.GetPostWithVisibility method")
+	}
+
+	mmGetPostWithVisibility.mock.funcGetPostWithVisibility = f
+	mmGetPostWithVisibility.mock.funcGetPostWithVisibilityOrigin = minimock.CallerInfo(1)
+	return mmGetPostWithVisibility.mock
+}
+
+// When sets expectation for the Storage.GetPostWithVisibility which will trigger the result defined by the following
+// Then helper
+func (mmGetPostWithVisibility *mStorageMockGetPostWithVisibility) When(ctx context.Context, id string) *StorageMockGetPostWithVisibilityExpectation {
+	if mmGetPostWithVisibility.mock.funcGetPostWithVisibility != nil {
+		mmGetPostWithVisibility.mock.t.Fatalf("StorageMock.GetPostWithVisibility mock is already set by Set")
+	}
+
+	expectation := &StorageMockGetPostWithVisibilityExpectation{
+		mock:               mmGetPostWithVisibility.mock,
+		params:             &StorageMockGetPostWithVisibilityParams{ctx, id},
+		expectationOrigins: StorageMockGetPostWithVisibilityExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetPostWithVisibility.expectations = append(mmGetPostWithVisibility.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Storage.GetPostWithVisibility return parameters for the expectation previously defined by the When method
+func (e *StorageMockGetPostWithVisibilityExpectation) Then(pp1 *model.Post, v1 mm_storage.Visibility, err error) *StorageMock {
+	e.results = &StorageMockGetPostWithVisibilityResults{pp1, v1, err}
+	return e.mock
+}
+
+// Times sets number of times Storage.GetPostWithVisibility should be invoked
+func (mmGetPostWithVisibility *mStorageMockGetPostWithVisibility) Times(n uint64) *mStorageMockGetPostWithVisibility {
+	if n == 0 {
+		mmGetPostWithVisibility.mock.t.Fatalf("Times of StorageMock.GetPostWithVisibility mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetPostWithVisibility.expectedInvocations, n)
+	mmGetPostWithVisibility.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetPostWithVisibility
+}
+
+func (mmGetPostWithVisibility *mStorageMockGetPostWithVisibility) invocationsDone() bool {
+	if len(mmGetPostWithVisibility.expectations) == 0 && mmGetPostWithVisibility.defaultExpectation == nil && mmGetPostWithVisibility.mock.funcGetPostWithVisibility == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetPostWithVisibility.mock.afterGetPostWithVisibilityCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetPostWithVisibility.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetPostWithVisibility implements mm_storage.Storage
+func (mmGetPostWithVisibility *StorageMock) GetPostWithVisibility(ctx context.Context, id string) (pp1 *model.Post, v1 mm_storage.Visibility, err error) {
+	mm_atomic.AddUint64(&mmGetPostWithVisibility.beforeGetPostWithVisibilityCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetPostWithVisibility.afterGetPostWithVisibilityCounter, 1)
+
+	mmGetPostWithVisibility.t.Helper()
+
+	if mmGetPostWithVisibility.inspectFuncGetPostWithVisibility != nil {
+		mmGetPostWithVisibility.inspectFuncGetPostWithVisibility(ctx, id)
+	}
+
+	mm_params := StorageMockGetPostWithVisibilityParams{ctx, id}
+
+	// Record call args
+	mmGetPostWithVisibility.GetPostWithVisibilityMock.mutex.Lock()
+	mmGetPostWithVisibility.GetPostWithVisibilityMock.callArgs = append(mmGetPostWithVisibility.GetPostWithVisibilityMock.callArgs, &mm_params)
+	mmGetPostWithVisibility.GetPostWithVisibilityMock.mutex.Unlock()
+
+	for _, e := range mmGetPostWithVisibility.GetPostWithVisibilityMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.pp1, e.results.v1, e.results.err
+		}
+	}
+
+	if mmGetPostWithVisibility.GetPostWithVisibilityMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetPostWithVisibility.GetPostWithVisibilityMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetPostWithVisibility.GetPostWithVisibilityMock.defaultExpectation.params
+		mm_want_ptrs := mmGetPostWithVisibility.GetPostWithVisibilityMock.defaultExpectation.paramPtrs
+
+		mm_got := StorageMockGetPostWithVisibilityParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetPostWithVisibility.t.Errorf("StorageMock.GetPostWithVisibility got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetPostWithVisibility.GetPostWithVisibilityMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmGetPostWithVisibility.t.Errorf("StorageMock.GetPostWithVisibility got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetPostWithVisibility.GetPostWithVisibilityMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetPostWithVisibility.t.Errorf("StorageMock.GetPostWithVisibility got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetPostWithVisibility.GetPostWithVisibilityMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetPostWithVisibility.GetPostWithVisibilityMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetPostWithVisibility.t.Fatal("No results are set for the StorageMock.GetPostWithVisibility")
+		}
+		return (*mm_results).pp1, (*mm_results).v1, (*mm_results).err
+	}
+	if mmGetPostWithVisibility.funcGetPostWithVisibility != nil {
+		return mmGetPostWithVisibility.funcGetPostWithVisibility(ctx, id)
+	}
+	mmGetPostWithVisibility.t.Fatalf("Unexpected call to StorageMock.GetPostWithVisibility. %v %v", ctx, id)
+	return
+}
+
+// GetPostWithVisibilityAfterCounter returns a count of finished StorageMock.GetPostWithVisibility invocations
+func (mmGetPostWithVisibility *StorageMock) GetPostWithVisibilityAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetPostWithVisibility.afterGetPostWithVisibilityCounter)
+}
+
+// GetPostWithVisibilityBeforeCounter returns a count of StorageMock.GetPostWithVisibility invocations
+func (mmGetPostWithVisibility *StorageMock) GetPostWithVisibilityBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetPostWithVisibility.beforeGetPostWithVisibilityCounter)
+}
+
+// Calls returns a list of arguments used in each call to StorageMock.GetPostWithVisibility.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetPostWithVisibility *mStorageMockGetPostWithVisibility) Calls() []*StorageMockGetPostWithVisibilityParams {
+	mmGetPostWithVisibility.mutex.RLock()
+
+	argCopy := make([]*StorageMockGetPostWithVisibilityParams, len(mmGetPostWithVisibility.callArgs))
+	copy(argCopy, mmGetPostWithVisibility.callArgs)
+
+	mmGetPostWithVisibility.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetPostWithVisibilityDone returns true if the count of the GetPostWithVisibility invocations corresponds
+// the number of defined expectations
+func (m *StorageMock) MinimockGetPostWithVisibilityDone() bool {
+	if m.GetPostWithVisibilityMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetPostWithVisibilityMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetPostWithVisibilityMock.invocationsDone()
+}
+
+// MinimockGetPostWithVisibilityInspect logs each unmet expectation
+func (m *StorageMock) MinimockGetPostWithVisibilityInspect() {
+	for _, e := range m.GetPostWithVisibilityMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to StorageMock.GetPostWithVisibility at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetPostWithVisibilityCounter := mm_atomic.LoadUint64(&m.afterGetPostWithVisibilityCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetPostWithVisibilityMock.defaultExpectation != nil && afterGetPostWithVisibilityCounter < 1 {
+		if m.GetPostWithVisibilityMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to StorageMock.GetPostWithVisibility at\n%s", m.GetPostWithVisibilityMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to StorageMock.GetPostWithVisibility at\n%s with params: %#v", m.GetPostWithVisibilityMock.defaultExpectation.expectationOrigins.origin, *m.GetPostWithVisibilityMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetPostWithVisibility != nil && afterGetPostWithVisibilityCounter < 1 {
+		m.t.Errorf("Expected call to StorageMock.GetPostWithVisibility at\n%s", m.funcGetPostWithVisibilityOrigin)
+	}
+
+	if !m.GetPostWithVisibilityMock.invocationsDone() && afterGetPostWithVisibilityCounter > 0 {
+		m.t.Errorf("Expected %d calls to StorageMock.GetPostWithVisibility at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetPostWithVisibilityMock.expectedInvocations), m.GetPostWithVisibilityMock.expectedInvocationsOrigin, afterGetPostWithVisibilityCounter)
+	}
+}
+
+type mStorageMockGetPosts struct {
+	optional           bool
+	mock               *StorageMock
+	defaultExpectation *StorageMockGetPostsExpectation
+	expectations       []*StorageMockGetPostsExpectation
+
+	callArgs []*StorageMockGetPostsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// StorageMockGetPostsExpectation specifies expectation struct of the Storage.GetPosts
+type StorageMockGetPostsExpectation struct {
+	mock               *StorageMock
+	params             *StorageMockGetPostsParams
+	paramPtrs          *StorageMockGetPostsParamPtrs
+	expectationOrigins StorageMockGetPostsExpectationOrigins
+	results            *StorageMockGetPostsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// StorageMockGetPostsParams contains parameters of the Storage.GetPosts
+type StorageMockGetPostsParams struct {
+	ctx context.Context
+}
+
+// StorageMockGetPostsParamPtrs contains pointers to parameters of the Storage.GetPosts
+type StorageMockGetPostsParamPtrs struct {
+	ctx *context.Context
+}
+
+// StorageMockGetPostsResults contains results of the Storage.GetPosts
+type StorageMockGetPostsResults struct {
+	ppa1 []*model.Post
+	err  error
+}
+
+// StorageMockGetPostsOrigins contains origins of expectations of the Storage.GetPosts
+type StorageMockGetPostsExpectationOrigins struct {
+	origin    string
+	originCtx string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetPosts *mStorageMockGetPosts) Optional() *mStorageMockGetPosts {
+	mmGetPosts.optional = true
+	return mmGetPosts
+}
+
+// Expect sets up expected params for Storage.GetPosts
+func (mmGetPosts *mStorageMockGetPosts) Expect(ctx context.Context) *mStorageMockGetPosts {
+	if mmGetPosts.mock.funcGetPosts != nil {
+		mmGetPosts.mock.t.Fatalf("StorageMock.GetPosts mock is already set by Set")
+	}
+
+	if mmGetPosts.defaultExpectation == nil {
+		mmGetPosts.defaultExpectation = &StorageMockGetPostsExpectation{}
+	}
+
+	if mmGetPosts.defaultExpectation.paramPtrs != nil {
+		mmGetPosts.mock.t.Fatalf("StorageMock.GetPosts mock is already set by ExpectParams functions")
+	}
+
+	mmGetPosts.defaultExpectation.params = &StorageMockGetPostsParams{ctx}
+	mmGetPosts.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetPosts.expectations {
+		if minimock.Equal(e.params, mmGetPosts.defaultExpectation.params) {
+			mmGetPosts.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetPosts.defaultExpectation.params)
+		}
+	}
+
+	return mmGetPosts
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Storage.GetPosts
+func (mmGetPosts *mStorageMockGetPosts) ExpectCtxParam1(ctx context.Context) *mStorageMockGetPosts {
+	if mmGetPosts.mock.funcGetPosts != nil {
+		mmGetPosts.mock.t.Fatalf("StorageMock.GetPosts mock is already set by Set")
+	}
+
+	if mmGetPosts.defaultExpectation == nil {
+		mmGetPosts.defaultExpectation = &StorageMockGetPostsExpectation{}
+	}
+
+	if mmGetPosts.defaultExpectation.params != nil {
+		mmGetPosts.mock.t.Fatalf("StorageMock.GetPosts mock is already set by Expect")
+	}
+
+	if mmGetPosts.defaultExpectation.paramPtrs == nil {
+		mmGetPosts.defaultExpectation.paramPtrs = &StorageMockGetPostsParamPtrs{}
+	}
+	mmGetPosts.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetPosts.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetPosts
+}
+
+// Inspect accepts an inspector function that has same arguments as the Storage.GetPosts
+func (mmGetPosts *mStorageMockGetPosts) Inspect(f func(ctx context.Context)) *mStorageMockGetPosts {
+	if mmGetPosts.mock.inspectFuncGetPosts != nil {
+		mmGetPosts.mock.t.Fatalf("Inspect function is already set for StorageMock.GetPosts")
+	}
+
+	mmGetPosts.mock.inspectFuncGetPosts = f
+
+	return mmGetPosts
+}
+
+// Return sets up results that will be returned by Storage.GetPosts
+func (mmGetPosts *mStorageMockGetPosts) Return(ppa1 []*model.Post, err error) *StorageMock {
+	if mmGetPosts.mock.funcGetPosts != nil {
+		mmGetPosts.mock.t.Fatalf("StorageMock.GetPosts mock is already set by Set")
+	}
+
+	if mmGetPosts.defaultExpectation == nil {
+		mmGetPosts.defaultExpectation = &StorageMockGetPostsExpectation{mock: mmGetPosts.mock}
+	}
+	mmGetPosts.defaultExpectation.results = &StorageMockGetPostsResults{ppa1, err}
+	mmGetPosts.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetPosts.mock
+}
+
+// Set uses given function f to mock the Storage.GetPosts method
+func (mmGetPosts *mStorageMockGetPosts) Set(f func(ctx context.Context) (ppa1 []*model.Post, err error)) *StorageMock {
+	if mmGetPosts.defaultExpectation != nil {
+		mmGetPosts.mock.t.Fatalf("Default expectation is already set for the Storage.GetPosts method")
+	}
+
+	if len(mmGetPosts.expectations) > 0 {
+		mmGetPosts.mock.t.Fatalf("Some expectations are already set for the Storage.GetPosts method")
+	}
+
+	mmGetPosts.mock.funcGetPosts = f
+	mmGetPosts.mock.funcGetPostsOrigin = minimock.CallerInfo(1)
+	return mmGetPosts.mock
+}
+
+// When sets expectation for the Storage.GetPosts which will trigger the result defined by the following
+// Then helper
+func (mmGetPosts *mStorageMockGetPosts) When(ctx context.Context) *StorageMockGetPostsExpectation {
+	if mmGetPosts.mock.funcGetPosts != nil {
+		mmGetPosts.mock.t.Fatalf("StorageMock.GetPosts mock is already set by Set")
+	}
+
+	expectation := &StorageMockGetPostsExpectation{
+		mock:               mmGetPosts.mock,
+		params:             &StorageMockGetPostsParams{ctx},
+		expectationOrigins: StorageMockGetPostsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetPosts.expectations = append(mmGetPosts.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Storage.GetPosts return parameters for the expectation previously defined by the When method
+func (e *StorageMockGetPostsExpectation) Then(ppa1 []*model.Post, err error) *StorageMock {
+	e.results = &StorageMockGetPostsResults{ppa1, err}
+	return e.mock
+}
+
+// Times sets number of times Storage.GetPosts should be invoked
+func (mmGetPosts *mStorageMockGetPosts) Times(n uint64) *mStorageMockGetPosts {
+	if n == 0 {
+		mmGetPosts.mock.t.Fatalf("Times of StorageMock.GetPosts mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetPosts.expectedInvocations, n)
+	mmGetPosts.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetPosts
+}
+
+func (mmGetPosts *mStorageMockGetPosts) invocationsDone() bool {
+	if len(mmGetPosts.expectations) == 0 && mmGetPosts.defaultExpectation == nil && mmGetPosts.mock.funcGetPosts == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetPosts.mock.afterGetPostsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetPosts.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetPosts implements mm_storage.Storage
+func (mmGetPosts *StorageMock) GetPosts(ctx context.Context) (ppa1 []*model.Post, err error) {
+	mm_atomic.AddUint64(&mmGetPosts.beforeGetPostsCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetPosts.afterGetPostsCounter, 1)
+
+	mmGetPosts.t.Helper()
+
+	if mmGetPosts.inspectFuncGetPosts != nil {
+		mmGetPosts.inspectFuncGetPosts(ctx)
+	}
+
+	mm_params := StorageMockGetPostsParams{ctx}
+
+	// Record call args
+	mmGetPosts.GetPostsMock.mutex.Lock()
+	mmGetPosts.GetPostsMock.callArgs = append(mmGetPosts.GetPostsMock.callArgs, &mm_params)
+	mmGetPosts.GetPostsMock.mutex.Unlock()
+
+	for _, e := range mmGetPosts.GetPostsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ppa1, e.results.err
+		}
+	}
+
+	if mmGetPosts.GetPostsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetPosts.GetPostsMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetPosts.GetPostsMock.defaultExpectation.params
+		mm_want_ptrs := mmGetPosts.GetPostsMock.defaultExpectation.paramPtrs
+
+		mm_got := StorageMockGetPostsParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetPosts.t.Errorf("StorageMock.GetPosts got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetPosts.GetPostsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetPosts.t.Errorf("StorageMock.GetPosts got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetPosts.GetPostsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetPosts.GetPostsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetPosts.t.Fatal("No results are set for the StorageMock.GetPosts")
+		}
+		return (*mm_results).ppa1, (*mm_results).err
+	}
+	if mmGetPosts.funcGetPosts != nil {
+		return mmGetPosts.funcGetPosts(ctx)
+	}
+	mmGetPosts.t.Fatalf("Unexpected call to StorageMock.GetPosts. %v", ctx)
+	return
+}
+
+// GetPostsAfterCounter returns a count of finished StorageMock.GetPosts invocations
+func (mmGetPosts *StorageMock) GetPostsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetPosts.afterGetPostsCounter)
+}
+
+// GetPostsBeforeCounter returns a count of StorageMock.GetPosts invocations
+func (mmGetPosts *StorageMock) GetPostsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetPosts.beforeGetPostsCounter)
+}
+
+// Calls returns a list of arguments used in each call to StorageMock.GetPosts.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetPosts *mStorageMockGetPosts) Calls() []*StorageMockGetPostsParams {
+	mmGetPosts.mutex.RLock()
+
+	argCopy := make([]*StorageMockGetPostsParams, len(mmGetPosts.callArgs))
+	copy(argCopy, mmGetPosts.callArgs)
+
+	mmGetPosts.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetPostsDone returns true if the count of the GetPosts invocations corresponds
+// the number of defined expectations
+func (m *StorageMock) MinimockGetPostsDone() bool {
+	if m.GetPostsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetPostsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetPostsMock.invocationsDone()
+}
+
+// MinimockGetPostsInspect logs each unmet expectation
+func (m *StorageMock) MinimockGetPostsInspect() {
+	for _, e := range m.GetPostsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to StorageMock.GetPosts at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetPostsCounter := mm_atomic.LoadUint64(&m.afterGetPostsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetPostsMock.defaultExpectation != nil && afterGetPostsCounter < 1 {
+		if m.GetPostsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to StorageMock.GetPosts at\n%s", m.GetPostsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to StorageMock.GetPosts at\n%s with params: %#v", m.GetPostsMock.defaultExpectation.expectationOrigins.origin, *m.GetPostsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetPosts != nil && afterGetPostsCounter < 1 {
+		m.t.Errorf("Expected call to StorageMock.GetPosts at\n%s", m.funcGetPostsOrigin)
+	}
+
+	if !m.GetPostsMock.invocationsDone() && afterGetPostsCounter > 0 {
+		m.t.Errorf("Expected %d calls to StorageMock.GetPosts at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetPostsMock.expectedInvocations), m.GetPostsMock.expectedInvocationsOrigin, afterGetPostsCounter)
+	}
+}
+
+type mStorageMockGetReplies struct {
+	optional           bool
+	mock               *StorageMock
+	defaultExpectation *StorageMockGetRepliesExpectation
+	expectations       []*StorageMockGetRepliesExpectation
+
+	callArgs []*StorageMockGetRepliesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// StorageMockGetRepliesExpectation specifies expectation struct of the Storage.GetReplies
+type StorageMockGetRepliesExpectation struct {
+	mock               *StorageMock
+	params             *StorageMockGetRepliesParams
+	paramPtrs          *StorageMockGetRepliesParamPtrs
+	expectationOrigins StorageMockGetRepliesExpectationOrigins
+	results            *StorageMockGetRepliesResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// StorageMockGetRepliesParams contains parameters of the Storage.GetReplies
+type StorageMockGetRepliesParams struct {
+	ctx      context.Context
+	parentID string
+	limit    int
+	offset   int
+}
+
+// StorageMockGetRepliesParamPtrs contains pointers to parameters of the Storage.GetReplies
+type StorageMockGetRepliesParamPtrs struct {
+	ctx      *context.Context
+	parentID *string
+	limit    *int
+	offset   *int
+}
+
+// StorageMockGetRepliesResults contains results of the Storage.GetReplies
+type StorageMockGetRepliesResults struct {
+	cpa1 []*model.Comment
+	err  error
+}
+
+// StorageMockGetRepliesOrigins contains origins of expectations of the Storage.GetReplies
+type StorageMockGetRepliesExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originParentID string
+	originLimit    string
+	originOffset   string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetReplies *mStorageMockGetReplies) Optional() *mStorageMockGetReplies {
+	mmGetReplies.optional = true
+	return mmGetReplies
+}
+
+// Expect sets up expected params for Storage.GetReplies
+func (mmGetReplies *mStorageMockGetReplies) Expect(ctx context.Context, parentID string, limit int, offset int) *mStorageMockGetReplies {
+	if mmGetReplies.mock.funcGetReplies != nil {
+		mmGetReplies.mock.t.Fatalf("StorageMock.GetReplies mock is already set by Set")
+	}
+
+	if mmGetReplies.defaultExpectation == nil {
+		mmGetReplies.defaultExpectation = &StorageMockGetRepliesExpectation{}
+	}
+
+	if mmGetReplies.defaultExpectation.paramPtrs != nil {
+		mmGetReplies.mock.t.Fatalf("StorageMock.GetReplies mock is already set by ExpectParams functions")
+	}
+
+	mmGetReplies.defaultExpectation.params = &StorageMockGetRepliesParams{ctx, parentID, limit, offset}
+	mmGetReplies.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetReplies.expectations {
+		if minimock.Equal(e.params, mmGetReplies.defaultExpectation.params) {
+			mmGetReplies.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetReplies.defaultExpectation.params)
+		}
+	}
+
+	return mmGetReplies
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Storage.GetReplies
+func (mmGetReplies *mStorageMockGetReplies) ExpectCtxParam1(ctx context.Context) *mStorageMockGetReplies {
+	if mmGetReplies.mock.funcGetReplies != nil {
+		mmGetReplies.mock.t.Fatalf("StorageMock.GetReplies mock is already set by Set")
+	}
+
+	if mmGetReplies.defaultExpectation == nil {
+		mmGetReplies.defaultExpectation = &StorageMockGetRepliesExpectation{}
+	}
+
+	if mmGetReplies.defaultExpectation.params != nil {
+		mmGetReplies.mock.t.Fatalf("StorageMock.GetReplies mock is already set by Expect")
+	}
+
+	if mmGetReplies.defaultExpectation.paramPtrs == nil {
+		mmGetReplies.defaultExpectation.paramPtrs = &StorageMockGetRepliesParamPtrs{}
+	}
+	mmGetReplies.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetReplies.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetReplies
+}
+
+// ExpectParentIDParam2 sets up expected param parentID for Storage.GetReplies
+func (mmGetReplies *mStorageMockGetReplies) ExpectParentIDParam2(parentID string) *mStorageMockGetReplies {
+	if mmGetReplies.mock.funcGetReplies != nil {
+		mmGetReplies.mock.t.Fatalf("StorageMock.GetReplies mock is already set by Set")
+	}
+
+	if mmGetReplies.defaultExpectation == nil {
+		mmGetReplies.defaultExpectation = &StorageMockGetRepliesExpectation{}
+	}
+
+	if mmGetReplies.defaultExpectation.params != nil {
+		mmGetReplies.mock.t.Fatalf("StorageMock.GetReplies mock is already set by Expect")
+	}
+
+	if mmGetReplies.defaultExpectation.paramPtrs == nil {
+		mmGetReplies.defaultExpectation.paramPtrs = &StorageMockGetRepliesParamPtrs{}
+	}
+	mmGetReplies.defaultExpectation.paramPtrs.parentID = &parentID
+	mmGetReplies.defaultExpectation.expectationOrigins.originParentID = minimock.CallerInfo(1)
+
+	return mmGetReplies
+}
+
+// ExpectLimitParam3 sets up expected param limit for Storage.GetReplies
+func (mmGetReplies *mStorageMockGetReplies) ExpectLimitParam3(limit int) *mStorageMockGetReplies {
+	if mmGetReplies.mock.funcGetReplies != nil {
+		mmGetReplies.mock.t.Fatalf("StorageMock.GetReplies mock is already set by Set")
+	}
+
+	if mmGetReplies.defaultExpectation == nil {
+		mmGetReplies.defaultExpectation = &StorageMockGetRepliesExpectation{}
+	}
+
+	if mmGetReplies.defaultExpectation.params != nil {
+		mmGetReplies.mock.t.Fatalf("StorageMock.GetReplies mock is already set by Expect")
+	}
+
+	if mmGetReplies.defaultExpectation.paramPtrs == nil {
+		mmGetReplies.defaultExpectation.paramPtrs = &StorageMockGetRepliesParamPtrs{}
+	}
+	mmGetReplies.defaultExpectation.paramPtrs.limit = &limit
+	mmGetReplies.defaultExpectation.expectationOrigins.originLimit = minimock.CallerInfo(1)
+
+	return mmGetReplies
+}
+
+// ExpectOffsetParam4 sets up expected param offset for Storage.GetReplies
+func (mmGetReplies *mStorageMockGetReplies) ExpectOffsetParam4(offset int) *mStorageMockGetReplies {
+	if mmGetReplies.mock.funcGetReplies != nil {
+		mmGetReplies.mock.t.Fatalf("StorageMock.GetReplies mock is already set by Set")
+	}
+
+	if mmGetReplies.defaultExpectation == nil {
+		mmGetReplies.defaultExpectation = &StorageMockGetRepliesExpectation{}
+	}
+
+	if mmGetReplies.defaultExpectation.params != nil {
+		mmGetReplies.mock.t.Fatalf("StorageMock.GetReplies mock is already set by Expect")
+	}
+
+	if mmGetReplies.defaultExpectation.paramPtrs == nil {
+		mmGetReplies.defaultExpectation.paramPtrs = &StorageMockGetRepliesParamPtrs{}
+	}
+	mmGetReplies.defaultExpectation.paramPtrs.offset = &offset
+	mmGetReplies.defaultExpectation.expectationOrigins.originOffset = minimock.CallerInfo(1)
+
+	return mmGetReplies
+}
+
+// Inspect accepts an inspector function that has same arguments as the Storage.GetReplies
+func (mmGetReplies *mStorageMockGetReplies) Inspect(f func(ctx context.Context, parentID string, limit int, offset int)) *mStorageMockGetReplies {
+	if mmGetReplies.mock.inspectFuncGetReplies != nil {
+		mmGetReplies.mock.t.Fatalf("Inspect function is already set for StorageMock.GetReplies")
+	}
+
+	mmGetReplies.mock.inspectFuncGetReplies = f
+
+	return mmGetReplies
+}
+
+// Return sets up results that will be returned by Storage.GetReplies
+func (mmGetReplies *mStorageMockGetReplies) Return(cpa1 []*model.Comment, err error) *StorageMock {
+	if mmGetReplies.mock.funcGetReplies != nil {
+		mmGetReplies.mock.t.Fatalf("StorageMock.GetReplies mock is already set by Set")
+	}
+
+	if mmGetReplies.defaultExpectation == nil {
+		mmGetReplies.defaultExpectation = &StorageMockGetRepliesExpectation{mock: mmGetReplies.mock}
+	}
+	mmGetReplies.defaultExpectation.results = &StorageMockGetRepliesResults{cpa1, err}
+	mmGetReplies.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetReplies.mock
+}
+
+// Set uses given function f to mock the Storage.GetReplies method
+func (mmGetReplies *mStorageMockGetReplies) Set(f func(ctx context.Context, parentID string, limit int, offset int) (cpa1 []*model.Comment, err error)) *StorageMock {
+	if mmGetReplies.defaultExpectation != nil {
+		mmGetReplies.mock.t.Fatalf("Default expectation is already set for the Storage.GetReplies method")
+	}
+
+	if len(mmGetReplies.expectations) > 0 {
+		mmGetReplies.mock.t.Fatalf("Some expectations are already set for the Storage.GetReplies method")
+	}
+
+	mmGetReplies.mock.funcGetReplies = f
+	mmGetReplies.mock.funcGetRepliesOrigin = minimock.CallerInfo(1)
+	return mmGetReplies.mock
+}
+
+// When sets expectation for the Storage.GetReplies which will trigger the result defined by the following
+// Then helper
+func (mmGetReplies *mStorageMockGetReplies) When(ctx context.Context, parentID string, limit int, offset int) *StorageMockGetRepliesExpectation {
+	if mmGetReplies.mock.funcGetReplies != nil {
+		mmGetReplies.mock.t.Fatalf("StorageMock.GetReplies mock is already set by Set")
+	}
+
+	expectation := &StorageMockGetRepliesExpectation{
+		mock:               mmGetReplies.mock,
+		params:             &StorageMockGetRepliesParams{ctx, parentID, limit, offset},
+		expectationOrigins: StorageMockGetRepliesExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetReplies.expectations = append(mmGetReplies.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Storage.GetReplies return parameters for the expectation previously defined by the When method
+func (e *StorageMockGetRepliesExpectation) Then(cpa1 []*model.Comment, err error) *StorageMock {
+	e.results = &StorageMockGetRepliesResults{cpa1, err}
+	return e.mock
+}
+
+// Times sets number of times Storage.GetReplies should be invoked
+func (mmGetReplies *mStorageMockGetReplies) Times(n uint64) *mStorageMockGetReplies {
+	if n == 0 {
+		mmGetReplies.mock.t.Fatalf("Times of StorageMock.GetReplies mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetReplies.expectedInvocations, n)
+	mmGetReplies.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetReplies
+}
+
+func (mmGetReplies *mStorageMockGetReplies) invocationsDone() bool {
+	if len(mmGetReplies.expectations) == 0 && mmGetReplies.defaultExpectation == nil && mmGetReplies.mock.funcGetReplies == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetReplies.mock.afterGetRepliesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetReplies.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetReplies implements mm_storage.Storage
+func (mmGetReplies *StorageMock) GetReplies(ctx context.Context, parentID string, limit int, offset int) (cpa1 []*model.Comment, err error) {
+	mm_atomic.AddUint64(&mmGetReplies.beforeGetRepliesCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetReplies.afterGetRepliesCounter, 1)
+
+	mmGetReplies.t.Helper()
+
+	if mmGetReplies.inspectFuncGetReplies != nil {
+		mmGetReplies.inspectFuncGetReplies(ctx, parentID, limit, offset)
+	}
+
+	mm_params := StorageMockGetRepliesParams{ctx, parentID, limit, offset}
+
+	// Record call args
+	mmGetReplies.GetRepliesMock.mutex.Lock()
+	mmGetReplies.GetRepliesMock.callArgs = append(mmGetReplies.GetRepliesMock.callArgs, &mm_params)
+	mmGetReplies.GetRepliesMock.mutex.Unlock()
+
+	for _, e := range mmGetReplies.GetRepliesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.cpa1, e.results.err
+		}
+	}
+
+	if mmGetReplies.GetRepliesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetReplies.GetRepliesMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetReplies.GetRepliesMock.defaultExpectation.params
+		mm_want_ptrs := mmGetReplies.GetRepliesMock.defaultExpectation.paramPtrs
+
+		mm_got := StorageMockGetRepliesParams{ctx, parentID, limit, offset}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetReplies.t.Errorf("StorageMock.GetReplies got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetReplies.GetRepliesMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.parentID != nil && !minimock.Equal(*mm_want_ptrs.parentID, mm_got.parentID) {
+				mmGetReplies.t.Errorf("StorageMock.GetReplies got unexpected parameter parentID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetReplies.GetRepliesMock.defaultExpectation.expectationOrigins.originParentID, *mm_want_ptrs.parentID, mm_got.parentID, minimock.Diff(*mm_want_ptrs.parentID, mm_got.parentID))
+			}
+
+			if mm_want_ptrs.limit != nil && !minimock.Equal(*mm_want_ptrs.limit, mm_got.limit) {
+				mmGetReplies.t.Errorf("StorageMock.GetReplies got unexpected parameter limit, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetReplies.GetRepliesMock.defaultExpectation.expectationOrigins.originLimit, *mm_want_ptrs.limit, mm_got.limit, minimock.Diff(*mm_want_ptrs.limit, mm_got.limit))
+			}
+
+			if mm_want_ptrs.offset != nil && !minimock.Equal(*mm_want_ptrs.offset, mm_got.offset) {
+				mmGetReplies.t.Errorf("StorageMock.GetReplies got unexpected parameter offset, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetReplies.GetRepliesMock.defaultExpectation.expectationOrigins.originOffset, *mm_want_ptrs.offset, mm_got.offset, minimock.Diff(*mm_want_ptrs.offset, mm_got.offset))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetReplies.t.Errorf("StorageMock.GetReplies got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetReplies.GetRepliesMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetReplies.GetRepliesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetReplies.t.Fatal("No results are set for the StorageMock.GetReplies")
+		}
+		return (*mm_results).cpa1, (*mm_results).err
+	}
+	if mmGetReplies.funcGetReplies != nil {
+		return mmGetReplies.funcGetReplies(ctx, parentID, limit, offset)
+	}
+	mmGetReplies.t.Fatalf("Unexpected call to StorageMock.GetReplies. %v %v %v %v", ctx, parentID, limit, offset)
+	return
+}
+
+// GetRepliesAfterCounter returns a count of finished StorageMock.GetReplies invocations
+func (mmGetReplies *StorageMock) GetRepliesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetReplies.afterGetRepliesCounter)
+}
+
+// GetRepliesBeforeCounter returns a count of StorageMock.GetReplies invocations
+func (mmGetReplies *StorageMock) GetRepliesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetReplies.beforeGetRepliesCounter)
+}
+
+// Calls returns a list of arguments used in each call to StorageMock.GetReplies.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetReplies *mStorageMockGetReplies) Calls() []*StorageMockGetRepliesParams {
+	mmGetReplies.mutex.RLock()
+
+	argCopy := make([]*StorageMockGetRepliesParams, len(mmGetReplies.callArgs))
+	copy(argCopy, mmGetReplies.callArgs)
+
+	mmGetReplies.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetRepliesDone returns true if the count of the GetReplies invocations corresponds
+// the number of defined expectations
+func (m *StorageMock) MinimockGetRepliesDone() bool {
+	if m.GetRepliesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetRepliesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetRepliesMock.invocationsDone()
+}
+
+// MinimockGetRepliesInspect logs each unmet expectation
+func (m *StorageMock) MinimockGetRepliesInspect() {
+	for _, e := range m.GetRepliesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to StorageMock.GetReplies at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetRepliesCounter := mm_atomic.LoadUint64(&m.afterGetRepliesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetRepliesMock.defaultExpectation != nil && afterGetRepliesCounter < 1 {
+		if m.GetRepliesMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to StorageMock.GetReplies at\n%s", m.GetRepliesMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to StorageMock.GetReplies at\n%s with params: %#v", m.GetRepliesMock.defaultExpectation.expectationOrigins.origin, *m.GetRepliesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetReplies != nil && afterGetRepliesCounter < 1 {
+		m.t.Errorf("Expected call to StorageMock.GetReplies at\n%s", m.funcGetRepliesOrigin)
+	}
+
+	if !m.GetRepliesMock.invocationsDone() && afterGetRepliesCounter > 0 {
+		m.t.Errorf("Expected %d calls to StorageMock.GetReplies at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetRepliesMock.expectedInvocations), m.GetRepliesMock.expectedInvocationsOrigin, afterGetRepliesCounter)
+	}
+}
+
+type mStorageMockGetReportByID struct {
+	optional           bool
+	mock               *StorageMock
+	defaultExpectation *StorageMockGetReportByIDExpectation
+	expectations       []*StorageMockGetReportByIDExpectation
+
+	callArgs []*StorageMockGetReportByIDParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// StorageMockGetReportByIDExpectation specifies expectation struct of the Storage.GetReportByID
+type StorageMockGetReportByIDExpectation struct {
+	mock               *StorageMock
+	params             *StorageMockGetReportByIDParams
+	paramPtrs          *StorageMockGetReportByIDParamPtrs
+	expectationOrigins StorageMockGetReportByIDExpectationOrigins
+	results            *StorageMockGetReportByIDResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// StorageMockGetReportByIDParams contains parameters of the Storage.GetReportByID
+type StorageMockGetReportByIDParams struct {
+	ctx context.Context
+	id  string
+}
+
+// StorageMockGetReportByIDParamPtrs contains pointers to parameters of the Storage.GetReportByID
+type StorageMockGetReportByIDParamPtrs struct {
+	ctx *context.Context
+	id  *string
+}
+
+// StorageMockGetReportByIDResults contains results of the Storage.GetReportByID
+type StorageMockGetReportByIDResults struct {
+	rp1 *model.Report
+	err error
+}
+
+// StorageMockGetReportByIDOrigins contains origins of expectations of the Storage.GetReportByID
+type StorageMockGetReportByIDExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originId  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetReportByID *mStorageMockGetReportByID) Optional() *mStorageMockGetReportByID {
+	mmGetReportByID.optional = true
+	return mmGetReportByID
+}
+
+// Expect sets up expected params for Storage.GetReportByID
+func (mmGetReportByID *mStorageMockGetReportByID) Expect(ctx context.Context, id string) *mStorageMockGetReportByID {
+	if mmGetReportByID.mock.funcGetReportByID != nil {
+		mmGetReportByID.mock.t.Fatalf("StorageMock.GetReportByID mock is already set by Set")
+	}
+
+	if mmGetReportByID.defaultExpectation == nil {
+		mmGetReportByID.defaultExpectation = &StorageMockGetReportByIDExpectation{}
+	}
+
+	if mmGetReportByID.defaultExpectation.paramPtrs != nil {
+		mmGetReportByID.mock.t.Fatalf("StorageMock.GetReportByID mock is already set by ExpectParams functions")
+	}
+
+	mmGetReportByID.defaultExpectation.params = &StorageMockGetReportByIDParams{ctx, id}
+	mmGetReportByID.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetReportByID.expectations {
+		if minimock.Equal(e.params, mmGetReportByID.defaultExpectation.params) {
+			mmGetReportByID.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetReportByID.defaultExpectation.params)
+		}
+	}
+
+	return mmGetReportByID
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Storage.GetReportByID
+func (mmGetReportByID *mStorageMockGetReportByID) ExpectCtxParam1(ctx context.Context) *mStorageMockGetReportByID {
+	if mmGetReportByID.mock.funcGetReportByID != nil {
+		mmGetReportByID.mock.t.Fatalf("StorageMock.GetReportByID mock is already set by Set")
+	}
+
+	if mmGetReportByID.defaultExpectation == nil {
+		mmGetReportByID.defaultExpectation = &StorageMockGetReportByIDExpectation{}
+	}
+
+	if mmGetReportByID.defaultExpectation.params != nil {
+		mmGetReportByID.mock.t.Fatalf("StorageMock.GetReportByID mock is already set by Expect")
+	}
+
+	if mmGetReportByID.defaultExpectation.paramPtrs == nil {
+		mmGetReportByID.defaultExpectation.paramPtrs = &StorageMockGetReportByIDParamPtrs{}
+	}
+	mmGetReportByID.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetReportByID.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetReportByID
+}
+
+// ExpectIdParam2 sets up expected param id for Storage.GetReportByID
+func (mmGetReportByID *mStorageMockGetReportByID) ExpectIdParam2(id string) *mStorageMockGetReportByID {
+	if mmGetReportByID.mock.funcGetReportByID != nil {
+		mmGetReportByID.mock.t.Fatalf("StorageMock.GetReportByID mock is already set by Set")
+	}
+
+	if mmGetReportByID.defaultExpectation == nil {
+		mmGetReportByID.defaultExpectation = &StorageMockGetReportByIDExpectation{}
+	}
+
+	if mmGetReportByID.defaultExpectation.params != nil {
+		mmGetReportByID.mock.t.Fatalf("StorageMock.GetReportByID mock is already set by Expect")
+	}
+
+	if mmGetReportByID.defaultExpectation.paramPtrs == nil {
+		mmGetReportByID.defaultExpectation.paramPtrs = &StorageMockGetReportByIDParamPtrs{}
+	}
+	mmGetReportByID.defaultExpectation.paramPtrs.id = &id
+	mmGetReportByID.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmGetReportByID
+}
+
+// Inspect accepts an inspector function that has same arguments as the Storage.GetReportByID
+func (mmGetReportByID *mStorageMockGetReportByID) Inspect(f func(ctx context.Context, id string)) *mStorageMockGetReportByID {
+	if mmGetReportByID.mock.inspectFuncGetReportByID != nil {
+		mmGetReportByID.mock.t.Fatalf("Inspect function is already set for StorageMock.GetReportByID")
+	}
+
+	mmGetReportByID.mock.inspectFuncGetReportByID = f
+
+	return mmGetReportByID
+}
+
+// Return sets up results that will be returned by Storage.GetReportByID
+func (mmGetReportByID *mStorageMockGetReportByID) Return(rp1 *model.Report, err error) *StorageMock {
+	if mmGetReportByID.mock.funcGetReportByID != nil {
+		mmGetReportByID.mock.t.Fatalf("StorageMock.GetReportByID mock is already set by Set")
+	}
+
+	if mmGetReportByID.defaultExpectation == nil {
+		mmGetReportByID.defaultExpectation = &StorageMockGetReportByIDExpectation{mock: mmGetReportByID.mock}
+	}
+	mmGetReportByID.defaultExpectation.results = &StorageMockGetReportByIDResults{rp1, err}
+	mmGetReportByID.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetReportByID.mock
+}
+
+// Set uses given function f to mock the Storage.GetReportByID method
+func (mmGetReportByID *mStorageMockGetReportByID) Set(f func(ctx context.Context, id string) (rp1 *model.Report, err error)) *StorageMock {
+	if mmGetReportByID.defaultExpectation != nil {
+		mmGetReportByID.mock.t.Fatalf("Default expectation is already set for the Storage.GetReportByID method")
+	}
+
+	if len(mmGetReportByID.expectations) > 0 {
+		mmGetReportByID.mock.t.Fatalf("Some expectations are already set for the Storage.GetReportByID method")
+	}
+
+	mmGetReportByID.mock.funcGetReportByID = f
+	mmGetReportByID.mock.funcGetReportByIDOrigin = minimock.CallerInfo(1)
+	return mmGetReportByID.mock
+}
+
+// When sets expectation for the Storage.GetReportByID which will trigger the result defined by the following
+// Then helper
+func (mmGetReportByID *mStorageMockGetReportByID) When(ctx context.Context, id string) *StorageMockGetReportByIDExpectation {
+	if mmGetReportByID.mock.funcGetReportByID != nil {
+		mmGetReportByID.mock.t.Fatalf("StorageMock.GetReportByID mock is already set by Set")
+	}
+
+	expectation := &StorageMockGetReportByIDExpectation{
+		mock:               mmGetReportByID.mock,
+		params:             &StorageMockGetReportByIDParams{ctx, id},
+		expectationOrigins: StorageMockGetReportByIDExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetReportByID.expectations = append(mmGetReportByID.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Storage.GetReportByID return parameters for the expectation previously defined by the When method
+func (e *StorageMockGetReportByIDExpectation) Then(rp1 *model.Report, err error) *StorageMock {
+	e.results = &StorageMockGetReportByIDResults{rp1, err}
+	return e.mock
+}
+
+// Times sets number of times Storage.GetReportByID should be invoked
+func (mmGetReportByID *mStorageMockGetReportByID) Times(n uint64) *mStorageMockGetReportByID {
+	if n == 0 {
+		mmGetReportByID.mock.t.Fatalf("Times of StorageMock.GetReportByID mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetReportByID.expectedInvocations, n)
+	mmGetReportByID.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetReportByID
+}
+
+func (mmGetReportByID *mStorageMockGetReportByID) invocationsDone() bool {
+	if len(mmGetReportByID.expectations) == 0 && mmGetReportByID.defaultExpectation == nil && mmGetReportByID.mock.funcGetReportByID == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetReportByID.mock.afterGetReportByIDCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetReportByID.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetReportByID implements mm_storage.Storage
+func (mmGetReportByID *StorageMock) GetReportByID(ctx context.Context, id string) (rp1 *model.Report, err error) {
+	mm_atomic.AddUint64(&mmGetReportByID.beforeGetReportByIDCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetReportByID.afterGetReportByIDCounter, 1)
+
+	mmGetReportByID.t.Helper()
+
+	if mmGetReportByID.inspectFuncGetReportByID != nil {
+		mmGetReportByID.inspectFuncGetReportByID(ctx, id)
+	}
+
+	mm_params := StorageMockGetReportByIDParams{ctx, id}
+
+	// Record call args
+	mmGetReportByID.GetReportByIDMock.mutex.Lock()
+	mmGetReportByID.GetReportByIDMock.callArgs = append(mmGetReportByID.GetReportByIDMock.callArgs, &mm_params)
+	mmGetReportByID.GetReportByIDMock.mutex.Unlock()
+
+	for _, e := range mmGetReportByID.GetReportByIDMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.rp1, e.results.err
+		}
+	}
+
+	if mmGetReportByID.GetReportByIDMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetReportByID.GetReportByIDMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetReportByID.GetReportByIDMock.defaultExpectation.params
+		mm_want_ptrs := mmGetReportByID.GetReportByIDMock.defaultExpectation.paramPtrs
+
+		mm_got := StorageMockGetReportByIDParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetReportByID.t.Errorf("StorageMock.GetReportByID got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetReportByID.GetReportByIDMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmGetReportByID.t.Errorf("StorageMock.GetReportByID got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetReportByID.GetReportByIDMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetReportByID.t.Errorf("StorageMock.GetReportByID got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetReportByID.GetReportByIDMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetReportByID.GetReportByIDMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetReportByID.t.Fatal("No results are set for the StorageMock.GetReportByID")
+		}
+		return (*mm_results).rp1, (*mm_results).err
+	}
+	if mmGetReportByID.funcGetReportByID != nil {
+		return mmGetReportByID.funcGetReportByID(ctx, id)
+	}
+	mmGetReportByID.t.Fatalf("Unexpected call to StorageMock.GetReportByID. %v %v", ctx, id)
+	return
+}
+
+// GetReportByIDAfterCounter returns a count of finished StorageMock.GetReportByID invocations
+func (mmGetReportByID *StorageMock) GetReportByIDAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetReportByID.afterGetReportByIDCounter)
+}
+
+// GetReportByIDBeforeCounter returns a count of StorageMock.GetReportByID invocations
+func (mmGetReportByID *StorageMock) GetReportByIDBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetReportByID.beforeGetReportByIDCounter)
+}
+
+// Calls returns a list of arguments used in each call to StorageMock.GetReportByID.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetReportByID *mStorageMockGetReportByID) Calls() []*StorageMockGetReportByIDParams {
+	mmGetReportByID.mutex.RLock()
+
+	argCopy := make([]*StorageMockGetReportByIDParams, len(mmGetReportByID.callArgs))
+	copy(argCopy, mmGetReportByID.callArgs)
+
+	mmGetReportByID.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetReportByIDDone returns true if the count of the GetReportByID invocations corresponds
+// the number of defined expectations
+func (m *StorageMock) MinimockGetReportByIDDone() bool {
+	if m.GetReportByIDMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetReportByIDMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetReportByIDMock.invocationsDone()
+}
+
+// MinimockGetReportByIDInspect logs each unmet expectation
+func (m *StorageMock) MinimockGetReportByIDInspect() {
+	for _, e := range m.GetReportByIDMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to StorageMock.GetReportByID at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetReportByIDCounter := mm_atomic.LoadUint64(&m.afterGetReportByIDCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetReportByIDMock.defaultExpectation != nil && afterGetReportByIDCounter < 1 {
+		if m.GetReportByIDMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to StorageMock.GetReportByID at\n%s", m.GetReportByIDMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to StorageMock.GetReportByID at\n%s with params: %#v", m.GetReportByIDMock.defaultExpectation.expectationOrigins.origin, *m.GetReportByIDMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetReportByID != nil && afterGetReportByIDCounter < 1 {
+		m.t.Errorf("Expected call to StorageMock.GetReportByID at\n%s", m.funcGetReportByIDOrigin)
+	}
+
+	if !m.GetReportByIDMock.invocationsDone() && afterGetReportByIDCounter > 0 {
+		m.t.Errorf("Expected %d calls to StorageMock.GetReportByID at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetReportByIDMock.expectedInvocations), m.GetReportByIDMock.expectedInvocationsOrigin, afterGetReportByIDCounter)
+	}
+}
+
+type mStorageMockGetReports struct {
+	optional           bool
+	mock               *StorageMock
+	defaultExpectation *StorageMockGetReportsExpectation
+	expectations       []*StorageMockGetReportsExpectation
+
+	callArgs []*StorageMockGetReportsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// StorageMockGetReportsExpectation specifies expectation struct of the Storage.GetReports
+type StorageMockGetReportsExpectation struct {
+	mock               *StorageMock
+	params             *StorageMockGetReportsParams
+	paramPtrs          *StorageMockGetReportsParamPtrs
+	expectationOrigins StorageMockGetReportsExpectationOrigins
+	results            *StorageMockGetReportsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// StorageMockGetReportsParams contains parameters of the Storage.GetReports
+type StorageMockGetReportsParams struct {
+	ctx     context.Context
+	status  model.ReportStatus
+	limit   int
+	afterID string
+}
+
+// StorageMockGetReportsParamPtrs contains pointers to parameters of the Storage.GetReports
+type StorageMockGetReportsParamPtrs struct {
+	ctx     *context.Context
+	status  *model.ReportStatus
+	limit   *int
+	afterID *string
+}
+
+// StorageMockGetReportsResults contains results of the Storage.GetReports
+type StorageMockGetReportsResults struct {
+	rpa1 []*model.Report
+	err  error
+}
+
+// StorageMockGetReportsOrigins contains origins of expectations of the Storage.GetReports
+type StorageMockGetReportsExpectationOrigins struct {
+	origin        string
+	originCtx     string
+	originStatus  string
+	originLimit   string
+	originAfterID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetReports *mStorageMockGetReports) Optional() *mStorageMockGetReports {
+	mmGetReports.optional = true
+	return mmGetReports
+}
+
+// Expect sets up expected params for Storage.GetReports
+func (mmGetReports *mStorageMockGetReports) Expect(ctx context.Context, status model.ReportStatus, limit int, afterID string) *mStorageMockGetReports {
+	if mmGetReports.mock.funcGetReports != nil {
+		mmGetReports.mock.t.Fatalf("StorageMock.GetReports mock is already set by Set")
+	}
+
+	if mmGetReports.defaultExpectation == nil {
+		mmGetReports.defaultExpectation = &StorageMockGetReportsExpectation{}
+	}
+
+	if mmGetReports.defaultExpectation.paramPtrs != nil {
+		mmGetReports.mock.t.Fatalf("StorageMock.GetReports mock is already set by ExpectParams functions")
+	}
+
+	mmGetReports.defaultExpectation.params = &StorageMockGetReportsParams{ctx, status, limit, afterID}
+	mmGetReports.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetReports.expectations {
+		if minimock.Equal(e.params, mmGetReports.defaultExpectation.params) {
+			mmGetReports.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetReports.defaultExpectation.params)
+		}
+	}
+
+	return mmGetReports
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Storage.GetReports
+func (mmGetReports *mStorageMockGetReports) ExpectCtxParam1(ctx context.Context) *mStorageMockGetReports {
+	if mmGetReports.mock.funcGetReports != nil {
+		mmGetReports.mock.t.Fatalf("StorageMock.GetReports mock is already set by Set")
+	}
+
+	if mmGetReports.defaultExpectation == nil {
+		mmGetReports.defaultExpectation = &StorageMockGetReportsExpectation{}
+	}
+
+	if mmGetReports.defaultExpectation.params != nil {
+		mmGetReports.mock.t.Fatalf("StorageMock.GetReports mock is already set by Expect")
+	}
+
+	if mmGetReports.defaultExpectation.paramPtrs == nil {
+		mmGetReports.defaultExpectation.paramPtrs = &StorageMockGetReportsParamPtrs{}
+	}
+	mmGetReports.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetReports.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetReports
+}
+
+// ExpectStatusParam2 sets up expected param status for Storage.GetReports
+func (mmGetReports *mStorageMockGetReports) ExpectStatusParam2(status model.ReportStatus) *mStorageMockGetReports {
+	if mmGetReports.mock.funcGetReports != nil {
+		mmGetReports.mock.t.Fatalf("StorageMock.GetReports mock is already set by Set")
+	}
+
+	if mmGetReports.defaultExpectation == nil {
+		mmGetReports.defaultExpectation = &StorageMockGetReportsExpectation{}
+	}
+
+	if mmGetReports.defaultExpectation.params != nil {
+		mmGetReports.mock.t.Fatalf("StorageMock.GetReports mock is already set by Expect")
+	}
+
+	if mmGetReports.defaultExpectation.paramPtrs == nil {
+		mmGetReports.defaultExpectation.paramPtrs = &StorageMockGetReportsParamPtrs{}
+	}
+	mmGetReports.defaultExpectation.paramPtrs.status = &status
+	mmGetReports.defaultExpectation.expectationOrigins.originStatus = minimock.CallerInfo(1)
+
+	return mmGetReports
+}
+
+// ExpectLimitParam3 sets up expected param limit for Storage.GetReports
+func (mmGetReports *mStorageMockGetReports) ExpectLimitParam3(limit int) *mStorageMockGetReports {
+	if mmGetReports.mock.funcGetReports != nil {
+		mmGetReports.mock.t.Fatalf("StorageMock.GetReports mock is already set by Set")
+	}
+
+	if mmGetReports.defaultExpectation == nil {
+		mmGetReports.defaultExpectation = &StorageMockGetReportsExpectation{}
+	}
+
+	if mmGetReports.defaultExpectation.params != nil {
+		mmGetReports.mock.t.Fatalf("StorageMock.GetReports mock is already set by Expect")
+	}
+
+	if mmGetReports.defaultExpectation.paramPtrs == nil {
+		mmGetReports.defaultExpectation.paramPtrs = &StorageMockGetReportsParamPtrs{}
+	}
+	mmGetReports.defaultExpectation.paramPtrs.limit = &limit
+	mmGetReports.defaultExpectation.expectationOrigins.originLimit = minimock.CallerInfo(1)
+
+	return mmGetReports
+}
+
+// ExpectAfterIDParam4 sets up expected param afterID for Storage.GetReports
+func (mmGetReports *mStorageMockGetReports) ExpectAfterIDParam4(afterID string) *mStorageMockGetReports {
+	if mmGetReports.mock.funcGetReports != nil {
+		mmGetReports.mock.t.Fatalf("StorageMock.GetReports mock is already set by Set")
+	}
+
+	if mmGetReports.defaultExpectation == nil {
+		mmGetReports.defaultExpectation = &StorageMockGetReportsExpectation{}
+	}
+
+	if mmGetReports.defaultExpectation.params != nil {
+		mmGetReports.mock.t.Fatalf("StorageMock.GetReports mock is already set by Expect")
+	}
+
+	if mmGetReports.defaultExpectation.paramPtrs == nil {
+		mmGetReports.defaultExpectation.paramPtrs = &StorageMockGetReportsParamPtrs{}
+	}
+	mmGetReports.defaultExpectation.paramPtrs.afterID = &afterID
+	mmGetReports.defaultExpectation.expectationOrigins.originAfterID = minimock.CallerInfo(1)
+
+	return mmGetReports
+}
+
+// Inspect accepts an inspector function that has same arguments as the Storage.GetReports
+func (mmGetReports *mStorageMockGetReports) Inspect(f func(ctx context.Context, status model.ReportStatus, limit int, afterID string)) *mStorageMockGetReports {
+	if mmGetReports.mock.inspectFuncGetReports != nil {
+		mmGetReports.mock.t.Fatalf("Inspect function is already set for StorageMock.GetReports")
+	}
+
+	mmGetReports.mock.inspectFuncGetReports = f
+
+	return mmGetReports
+}
+
+// Return sets up results that will be returned by Storage.GetReports
+func (mmGetReports *mStorageMockGetReports) Return(rpa1 []*model.Report, err error) *StorageMock {
+	if mmGetReports.mock.funcGetReports != nil {
+		mmGetReports.mock.t.Fatalf("StorageMock.GetReports mock is already set by Set")
+	}
+
+	if mmGetReports.defaultExpectation == nil {
+		mmGetReports.defaultExpectation = &StorageMockGetReportsExpectation{mock: mmGetReports.mock}
+	}
+	mmGetReports.defaultExpectation.results = &StorageMockGetReportsResults{rpa1, err}
+	mmGetReports.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetReports.mock
+}
+
+// Set uses given function f to mock the Storage.GetReports method
+func (mmGetReports *mStorageMockGetReports) Set(f func(ctx context.Context, status model.ReportStatus, limit int, afterID string) (rpa1 []*model.Report, err error)) *StorageMock {
+	if mmGetReports.defaultExpectation != nil {
+		mmGetReports.mock.t.Fatalf("Default expectation is already set for the Storage.GetReports method")
+	}
+
+	if len(mmGetReports.expectations) > 0 {
+		mmGetReports.mock.t.Fatalf("Some expectations are already set for the Storage.GetReports method")
+	}
+
+	mmGetReports.mock.funcGetReports = f
+	mmGetReports.mock.funcGetReportsOrigin = minimock.CallerInfo(1)
+	return mmGetReports.mock
+}
+
+// When sets expectation for the Storage.GetReports which will trigger the result defined by the following
+// Then helper
+func (mmGetReports *mStorageMockGetReports) When(ctx context.Context, status model.ReportStatus, limit int, afterID string) *StorageMockGetReportsExpectation {
+	if mmGetReports.mock.funcGetReports != nil {
+		mmGetReports.mock.t.Fatalf("StorageMock.GetReports mock is already set by Set")
+	}
+
+	expectation := &StorageMockGetReportsExpectation{
+		mock:               mmGetReports.mock,
+		params:             &StorageMockGetReportsParams{ctx, status, limit, afterID},
+		expectationOrigins: StorageMockGetReportsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetReports.expectations = append(mmGetReports.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Storage.GetReports return parameters for the expectation previously defined by the When method
+func (e *StorageMockGetReportsExpectation) Then(rpa1 []*model.Report, err error) *StorageMock {
+	e.results = &StorageMockGetReportsResults{rpa1, err}
+	return e.mock
+}
+
+// Times sets number of times Storage.GetReports should be invoked
+func (mmGetReports *mStorageMockGetReports) Times(n uint64) *mStorageMockGetReports {
+	if n == 0 {
+		mmGetReports.mock.t.Fatalf("Times of StorageMock.GetReports mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetReports.expectedInvocations, n)
+	mmGetReports.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetReports
+}
+
+func (mmGetReports *mStorageMockGetReports) invocationsDone() bool {
+	if len(mmGetReports.expectations) == 0 && mmGetReports.defaultExpectation == nil && mmGetReports.mock.funcGetReports == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetReports.mock.afterGetReportsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetReports.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetReports implements mm_storage.Storage
+func (mmGetReports *StorageMock) GetReports(ctx context.Context, status model.ReportStatus, limit int, afterID string) (rpa1 []*model.Report, err error) {
+	mm_atomic.AddUint64(&mmGetReports.beforeGetReportsCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetReports.afterGetReportsCounter, 1)
+
+	mmGetReports.t.Helper()
+
+	if mmGetReports.inspectFuncGetReports != nil {
+		mmGetReports.inspectFuncGetReports(ctx, status, limit, afterID)
+	}
+
+	mm_params := StorageMockGetReportsParams{ctx, status, limit, afterID}
+
+	// Record call args
+	mmGetReports.GetReportsMock.mutex.Lock()
+	mmGetReports.GetReportsMock.callArgs = append(mmGetReports.GetReportsMock.callArgs, &mm_params)
+	mmGetReports.GetReportsMock.mutex.Unlock()
+
+	for _, e := range mmGetReports.GetReportsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.rpa1, e.results.err
+		}
+	}
+
+	if mmGetReports.GetReportsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetReports.GetReportsMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetReports.GetReportsMock.defaultExpectation.params
+		mm_want_ptrs := mmGetReports.GetReportsMock.defaultExpectation.paramPtrs
+
+		mm_got := StorageMockGetReportsParams{ctx, status, limit, afterID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetReports.t.Errorf("StorageMock.GetReports got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetReports.GetReportsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.status != nil && !minimock.Equal(*mm_want_ptrs.status, mm_got.status) {
+				mmGetReports.t.Errorf("StorageMock.GetReports got unexpected parameter status, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetReports.GetReportsMock.defaultExpectation.expectationOrigins.originStatus, *mm_want_ptrs.status, mm_got.status, minimock.Diff(*mm_want_ptrs.status, mm_got.status))
+			}
+
+			if mm_want_ptrs.limit != nil && !minimock.Equal(*mm_want_ptrs.limit, mm_got.limit) {
+				mmGetReports.t.Errorf("StorageMock.GetReports got unexpected parameter limit, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetReports.GetReportsMock.defaultExpectation.expectationOrigins.originLimit, *mm_want_ptrs.limit, mm_got.limit, minimock.Diff(*mm_want_ptrs.limit, mm_got.limit))
+			}
+
+			if mm_want_ptrs.afterID != nil && !minimock.Equal(*mm_want_ptrs.afterID, mm_got.afterID) {
+				mmGetReports.t.Errorf("StorageMock.GetReports got unexpected parameter afterID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetReports.GetReportsMock.defaultExpectation.expectationOrigins.originAfterID, *mm_want_ptrs.afterID, mm_got.afterID, minimock.Diff(*mm_want_ptrs.afterID, mm_got.afterID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetReports.t.Errorf("StorageMock.GetReports got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetReports.GetReportsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetReports.GetReportsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetReports.t.Fatal("No results are set for the StorageMock.GetReports")
+		}
+		return (*mm_results).rpa1, (*mm_results).err
+	}
+	if mmGetReports.funcGetReports != nil {
+		return mmGetReports.funcGetReports(ctx, status, limit, afterID)
+	}
+	mmGetReports.t.Fatalf("Unexpected call to StorageMock.GetReports. %v %v %v %v", ctx, status, limit, afterID)
+	return
+}
+
+// GetReportsAfterCounter returns a count of finished StorageMock.GetReports invocations
+func (mmGetReports *StorageMock) GetReportsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetReports.afterGetReportsCounter)
+}
+
+// GetReportsBeforeCounter returns a count of StorageMock.GetReports invocations
+func (mmGetReports *StorageMock) GetReportsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetReports.beforeGetReportsCounter)
+}
+
+// Calls returns a list of arguments used in each call to StorageMock.GetReports.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetReports *mStorageMockGetReports) Calls() []*StorageMockGetReportsParams {
+	mmGetReports.mutex.RLock()
+
+	argCopy := make([]*StorageMockGetReportsParams, len(mmGetReports.callArgs))
+	copy(argCopy, mmGetReports.callArgs)
+
+	mmGetReports.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetReportsDone returns true if the count of the GetReports invocations corresponds
+// the number of defined expectations
+func (m *StorageMock) MinimockGetReportsDone() bool {
+	if m.GetReportsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetReportsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetReportsMock.invocationsDone()
+}
+
+// MinimockGetReportsInspect logs each unmet expectation
+func (m *StorageMock) MinimockGetReportsInspect() {
+	for _, e := range m.GetReportsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to StorageMock.GetReports at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetReportsCounter := mm_atomic.LoadUint64(&m.afterGetReportsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetReportsMock.defaultExpectation != nil && afterGetReportsCounter < 1 {
+		if m.GetReportsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to StorageMock.GetReports at\n%s", m.GetReportsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to StorageMock.GetReports at\n%s with params: %#v", m.GetReportsMock.defaultExpectation.expectationOrigins.origin, *m.GetReportsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetReports != nil && afterGetReportsCounter < 1 {
+		m.t.Errorf("Expected call to StorageMock.GetReports at\n%s", m.funcGetReportsOrigin)
+	}
+
+	if !m.GetReportsMock.invocationsDone() && afterGetReportsCounter > 0 {
+		m.t.Errorf("Expected %d calls to StorageMock.GetReports at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetReportsMock.expectedInvocations), m.GetReportsMock.expectedInvocationsOrigin, afterGetReportsCounter)
+	}
+}
+
+type mStorageMockPing struct {
+	optional           bool
+	mock               *StorageMock
+	defaultExpectation *StorageMockPingExpectation
+	expectations       []*StorageMockPingExpectation
+
+	callArgs []*StorageMockPingParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// StorageMockPingExpectation specifies expectation struct of the Storage.Ping
+type StorageMockPingExpectation struct {
+	mock               *StorageMock
+	params             *StorageMockPingParams
+	paramPtrs          *StorageMockPingParamPtrs
+	expectationOrigins StorageMockPingExpectationOrigins
+	results            *StorageMockPingResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// StorageMockPingParams contains parameters of the Storage.Ping
+type StorageMockPingParams struct {
+	ctx context.Context
+}
+
+// StorageMockPingParamPtrs contains pointers to parameters of the Storage.Ping
+type StorageMockPingParamPtrs struct {
+	ctx *context.Context
+}
+
+// StorageMockPingResults contains results of the Storage.Ping
+type StorageMockPingResults struct {
+	err error
+}
+
+// StorageMockPingOrigins contains origins of expectations of the Storage.Ping
+type StorageMockPingExpectationOrigins struct {
+	origin    string
+	originCtx string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmPing *mStorageMockPing) Optional() *mStorageMockPing {
+	mmPing.optional = true
+	return mmPing
+}
+
+// Expect sets up expected params for Storage.Ping
+func (mmPing *mStorageMockPing) Expect(ctx context.Context) *mStorageMockPing {
+	if mmPing.mock.funcPing != nil {
+		mmPing.mock.t.Fatalf("StorageMock.Ping mock is already set by Set")
+	}
+
+	if mmPing.defaultExpectation == nil {
+		mmPing.defaultExpectation = &StorageMockPingExpectation{}
+	}
+
+	if mmPing.defaultExpectation.paramPtrs != nil {
+		mmPing.mock.t.Fatalf("StorageMock.Ping mock is already set by ExpectParams functions")
+	}
+
+	mmPing.defaultExpectation.params = &StorageMockPingParams{ctx}
+	mmPing.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmPing.expectations {
+		if minimock.Equal(e.params, mmPing.defaultExpectation.params) {
+			mmPing.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmPing.defaultExpectation.params)
+		}
+	}
+
+	return mmPing
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Storage.Ping
+func (mmPing *mStorageMockPing) ExpectCtxParam1(ctx context.Context) *mStorageMockPing {
+	if mmPing.mock.funcPing != nil {
+		mmPing.mock.t.Fatalf("StorageMock.Ping mock is already set by Set")
+	}
+
+	if mmPing.defaultExpectation == nil {
+		mmPing.defaultExpectation = &StorageMockPingExpectation{}
+	}
+
+	if mmPing.defaultExpectation.params != nil {
+		mmPing.mock.t.Fatalf("StorageMock.Ping mock is already set by Expect")
+	}
+
+	if mmPing.defaultExpectation.paramPtrs == nil {
+		mmPing.defaultExpectation.paramPtrs = &StorageMockPingParamPtrs{}
+	}
+	mmPing.defaultExpectation.paramPtrs.ctx = &ctx
+	mmPing.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmPing
+}
+
+// Inspect accepts an inspector function that has same arguments as the Storage.Ping
+func (mmPing *mStorageMockPing) Inspect(f func(ctx context.Context)) *mStorageMockPing {
+	if mmPing.mock.inspectFuncPing != nil {
+		mmPing.mock.t.Fatalf("Inspect function is already set for StorageMock.Ping")
+	}
+
+	mmPing.mock.inspectFuncPing = f
+
+	return mmPing
+}
+
+// Return sets up results that will be returned by Storage.Ping
+func (mmPing *mStorageMockPing) Return(err error) *StorageMock {
+	if mmPing.mock.funcPing != nil {
+		mmPing.mock.t.Fatalf("StorageMock.Ping mock is already set by Set")
+	}
+
+	if mmPing.defaultExpectation == nil {
+		mmPing.defaultExpectation = &StorageMockPingExpectation{mock: mmPing.mock}
+	}
+	mmPing.defaultExpectation.results = &StorageMockPingResults{err}
+	mmPing.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmPing.mock
+}
+
+// Set uses given function f to mock the Storage.Ping method
+func (mmPing *mStorageMockPing) Set(f func(ctx context.Context) (err error)) *StorageMock {
+	if mmPing.defaultExpectation != nil {
+		mmPing.mock.t.Fatalf("Default expectation is already set for the Storage.Ping method")
+	}
+
+	if len(mmPing.expectations) > 0 {
+		mmPing.mock.t.Fatalf("Some expectations are already set for the Storage.Ping method")
+	}
+
+	mmPing.mock.funcPing = f
+	mmPing.mock.funcPingOrigin = minimock.CallerInfo(1)
+	return mmPing.mock
+}
+
+// When sets expectation for the Storage.Ping which will trigger the result defined by the following
+// Then helper
+func (mmPing *mStorageMockPing) When(ctx context.Context) *StorageMockPingExpectation {
+	if mmPing.mock.funcPing != nil {
+		mmPing.mock.t.Fatalf("StorageMock.Ping mock is already set by Set")
+	}
+
+	expectation := &StorageMockPingExpectation{
+		mock:               mmPing.mock,
+		params:             &StorageMockPingParams{ctx},
+		expectationOrigins: StorageMockPingExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmPing.expectations = append(mmPing.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Storage.Ping return parameters for the expectation previously defined by the When method
+func (e *StorageMockPingExpectation) Then(err error) *StorageMock {
+	e.results = &StorageMockPingResults{err}
+	return e.mock
+}
+
+// Times sets number of times Storage.Ping should be invoked
+func (mmPing *mStorageMockPing) Times(n uint64) *mStorageMockPing {
+	if n == 0 {
+		mmPing.mock.t.Fatalf("Times of StorageMock.Ping mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmPing.expectedInvocations, n)
+	mmPing.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmPing
+}
+
+func (mmPing *mStorageMockPing) invocationsDone() bool {
+	if len(mmPing.expectations) == 0 && mmPing.defaultExpectation == nil && mmPing.mock.funcPing == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmPing.mock.afterPingCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmPing.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Ping implements mm_storage.Storage
+func (mmPing *StorageMock) Ping(ctx context.Context) (err error) {
+	mm_atomic.AddUint64(&mmPing.beforePingCounter, 1)
+	defer mm_atomic.AddUint64(&mmPing.afterPingCounter, 1)
+
+	mmPing.t.Helper()
+
+	if mmPing.inspectFuncPing != nil {
+		mmPing.inspectFuncPing(ctx)
+	}
+
+	mm_params := StorageMockPingParams{ctx}
+
+	// Record call args
+	mmPing.PingMock.mutex.Lock()
+	mmPing.PingMock.callArgs = append(mmPing.PingMock.callArgs, &mm_params)
+	mmPing.PingMock.mutex.Unlock()
+
+	for _, e := range mmPing.PingMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmPing.PingMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmPing.PingMock.defaultExpectation.Counter, 1)
+		mm_want := mmPing.PingMock.defaultExpectation.params
+		mm_want_ptrs := mmPing.PingMock.defaultExpectation.paramPtrs
+
+		mm_got := StorageMockPingParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmPing.t.Errorf("StorageMock.Ping got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPing.PingMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmPing.t.Errorf("StorageMock.Ping got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmPing.PingMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmPing.PingMock.defaultExpectation.results
+		if mm_results == nil {
+			mmPing.t.Fatal("No results are set for the StorageMock.Ping")
+		}
+		return (*mm_results).err
+	}
+	if mmPing.funcPing != nil {
+		return mmPing.funcPing(ctx)
+	}
+	mmPing.t.Fatalf("Unexpected call to StorageMock.Ping. %v", ctx)
+	return
+}
+
+// PingAfterCounter returns a count of finished StorageMock.Ping invocations
+func (mmPing *StorageMock) PingAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPing.afterPingCounter)
+}
+
+// PingBeforeCounter returns a count of StorageMock.Ping invocations
+func (mmPing *StorageMock) PingBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPing.beforePingCounter)
+}
+
+// Calls returns a list of arguments used in each call to StorageMock.Ping.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmPing *mStorageMockPing) Calls() []*StorageMockPingParams {
+	mmPing.mutex.RLock()
+
+	argCopy := make([]*StorageMockPingParams, len(mmPing.callArgs))
+	copy(argCopy, mmPing.callArgs)
+
+	mmPing.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockPingDone returns true if the count of the Ping invocations corresponds
+// the number of defined expectations
+func (m *StorageMock) MinimockPingDone() bool {
+	if m.PingMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.PingMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.PingMock.invocationsDone()
+}
+
+// MinimockPingInspect logs each unmet expectation
+func (m *StorageMock) MinimockPingInspect() {
+	for _, e := range m.PingMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to StorageMock.Ping at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterPingCounter := mm_atomic.LoadUint64(&m.afterPingCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.PingMock.defaultExpectation != nil && afterPingCounter < 1 {
+		if m.PingMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to StorageMock.Ping at\n%s", m.PingMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to StorageMock.Ping at\n%s with params: %#v", m.PingMock.defaultExpectation.expectationOrigins.origin, *m.PingMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcPing != nil && afterPingCounter < 1 {
+		m.t.Errorf("Expected call to StorageMock.Ping at\n%s", m.funcPingOrigin)
+	}
+
+	if !m.PingMock.invocationsDone() && afterPingCounter > 0 {
+		m.t.Errorf("Expected %d calls to StorageMock.Ping at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.PingMock.expectedInvocations), m.PingMock.expectedInvocationsOrigin, afterPingCounter)
+	}
+}
+
+type mStorageMockPublishComment struct {
+	optional           bool
+	mock               *StorageMock
+	defaultExpectation *StorageMockPublishCommentExpectation
+	expectations       []*StorageMockPublishCommentExpectation
+
+	callArgs []*StorageMockPublishCommentParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// StorageMockPublishCommentExpectation specifies expectation struct of the Storage.PublishComment
+type StorageMockPublishCommentExpectation struct {
+	mock               *StorageMock
+	params             *StorageMockPublishCommentParams
+	paramPtrs          *StorageMockPublishCommentParamPtrs
+	expectationOrigins StorageMockPublishCommentExpectationOrigins
+	results            *StorageMockPublishCommentResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// StorageMockPublishCommentParams contains parameters of the Storage.PublishComment
+type StorageMockPublishCommentParams struct {
+	ctx context.Context
+	id  string
+}
+
+// StorageMockPublishCommentParamPtrs contains pointers to parameters of the Storage.PublishComment
+type StorageMockPublishCommentParamPtrs struct {
+	ctx *context.Context
+	id  *string
+}
+
+// StorageMockPublishCommentResults contains results of the Storage.PublishComment
+type StorageMockPublishCommentResults struct {
+	err error
+}
+
+// StorageMockPublishCommentOrigins contains origins of expectations of the Storage.PublishComment
+type StorageMockPublishCommentExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originId  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmPublishComment *mStorageMockPublishComment) Optional() *mStorageMockPublishComment {
+	mmPublishComment.optional = true
+	return mmPublishComment
+}
+
+// Expect sets up expected params for Storage.PublishComment
+func (mmPublishComment *mStorageMockPublishComment) Expect(ctx context.Context, id string) *mStorageMockPublishComment {
+	if mmPublishComment.mock.funcPublishComment != nil {
+		mmPublishComment.mock.t.Fatalf("StorageMock.PublishComment mock is already set by Set")
+	}
+
+	if mmPublishComment.defaultExpectation == nil {
+		mmPublishComment.defaultExpectation = &StorageMockPublishCommentExpectation{}
+	}
+
+	if mmPublishComment.defaultExpectation.paramPtrs != nil {
+		mmPublishComment.mock.t.Fatalf("StorageMock.PublishComment mock is already set by ExpectParams functions")
+	}
+
+	mmPublishComment.defaultExpectation.params = &StorageMockPublishCommentParams{ctx, id}
+	mmPublishComment.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmPublishComment.expectations {
+		if minimock.Equal(e.params, mmPublishComment.defaultExpectation.params) {
+			mmPublishComment.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmPublishComment.defaultExpectation.params)
+		}
+	}
+
+	return mmPublishComment
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Storage.PublishComment
+func (mmPublishComment *mStorageMockPublishComment) ExpectCtxParam1(ctx context.Context) *mStorageMockPublishComment {
+	if mmPublishComment.mock.funcPublishComment != nil {
+		mmPublishComment.mock.t.Fatalf("StorageMock.PublishComment mock is already set by Set")
+	}
+
+	if mmPublishComment.defaultExpectation == nil {
+		mmPublishComment.defaultExpectation = &StorageMockPublishCommentExpectation{}
+	}
+
+	if mmPublishComment.defaultExpectation.params != nil {
+		mmPublishComment.mock.t.Fatalf("StorageMock.PublishComment mock is already set by Expect")
+	}
+
+	if mmPublishComment.defaultExpectation.paramPtrs == nil {
+		mmPublishComment.defaultExpectation.paramPtrs = &StorageMockPublishCommentParamPtrs{}
+	}
+	mmPublishComment.defaultExpectation.paramPtrs.ctx = &ctx
+	mmPublishComment.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmPublishComment
+}
+
+// ExpectIdParam2 sets up expected param id for Storage.PublishComment
+func (mmPublishComment *mStorageMockPublishComment) ExpectIdParam2(id string) *mStorageMockPublishComment {
+	if mmPublishComment.mock.funcPublishComment != nil {
+		mmPublishComment.mock.t.Fatalf("StorageMock.PublishComment mock is already set by Set")
+	}
+
+	if mmPublishComment.defaultExpectation == nil {
+		mmPublishComment.defaultExpectation = &StorageMockPublishCommentExpectation{}
+	}
+
+	if mmPublishComment.defaultExpectation.params != nil {
+		mmPublishComment.mock.t.Fatalf("StorageMock.PublishComment mock is already set by Expect")
+	}
+
+	if mmPublishComment.defaultExpectation.paramPtrs == nil {
+		mmPublishComment.defaultExpectation.paramPtrs = &StorageMockPublishCommentParamPtrs{}
+	}
+	mmPublishComment.defaultExpectation.paramPtrs.id = &id
+	mmPublishComment.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmPublishComment
+}
+
+// Inspect accepts an inspector function that has same arguments as the Storage.PublishComment
+func (mmPublishComment *mStorageMockPublishComment) Inspect(f func(ctx context.Context, id string)) *mStorageMockPublishComment {
+	if mmPublishComment.mock.inspectFuncPublishComment != nil {
+		mmPublishComment.mock.t.Fatalf("Inspect function is already set for StorageMock.PublishComment")
+	}
+
+	mmPublishComment.mock.inspectFuncPublishComment = f
+
+	return mmPublishComment
+}
+
+// Return sets up results that will be returned by Storage.PublishComment
+func (mmPublishComment *mStorageMockPublishComment) Return(err error) *StorageMock {
+	if mmPublishComment.mock.funcPublishComment != nil {
+		mmPublishComment.mock.t.Fatalf("StorageMock.PublishComment mock is already set by Set")
+	}
+
+	if mmPublishComment.defaultExpectation == nil {
+		mmPublishComment.defaultExpectation = &StorageMockPublishCommentExpectation{mock: mmPublishComment.mock}
+	}
+	mmPublishComment.defaultExpectation.results = &StorageMockPublishCommentResults{err}
+	mmPublishComment.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmPublishComment.mock
+}
+
+// Set uses given function f to mock the Storage.PublishComment method
+func (mmPublishComment *mStorageMockPublishComment) Set(f func(ctx context.Context, id string) (err error)) *StorageMock {
+	if mmPublishComment.defaultExpectation != nil {
+		mmPublishComment.mock.t.Fatalf("Default expectation is already set for the Storage.PublishComment method")
+	}
+
+	if len(mmPublishComment.expectations) > 0 {
+		mmPublishComment.mock.t.Fatalf("Some expectations are already set for the Storage.PublishComment method")
+	}
+
+	mmPublishComment.mock.funcPublishComment = f
+	mmPublishComment.mock.funcPublishCommentOrigin = minimock.CallerInfo(1)
+	return mmPublishComment.mock
+}
+
+// When sets expectation for the Storage.PublishComment which will trigger the result defined by the following
+// Then helper
+func (mmPublishComment *mStorageMockPublishComment) When(ctx context.Context, id string) *StorageMockPublishCommentExpectation {
+	if mmPublishComment.mock.funcPublishComment != nil {
+		mmPublishComment.mock.t.Fatalf("StorageMock.PublishComment mock is already set by Set")
+	}
+
+	expectation := &StorageMockPublishCommentExpectation{
+		mock:               mmPublishComment.mock,
+		params:             &StorageMockPublishCommentParams{ctx, id},
+		expectationOrigins: StorageMockPublishCommentExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmPublishComment.expectations = append(mmPublishComment.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Storage.PublishComment return parameters for the expectation previously defined by the When method
+func (e *StorageMockPublishCommentExpectation) Then(err error) *StorageMock {
+	e.results = &StorageMockPublishCommentResults{err}
+	return e.mock
+}
+
+// Times sets number of times Storage.PublishComment should be invoked
+func (mmPublishComment *mStorageMockPublishComment) Times(n uint64) *mStorageMockPublishComment {
+	if n == 0 {
+		mmPublishComment.mock.t.Fatalf("Times of StorageMock.PublishComment mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmPublishComment.expectedInvocations, n)
+	mmPublishComment.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmPublishComment
+}
+
+func (mmPublishComment *mStorageMockPublishComment) invocationsDone() bool {
+	if len(mmPublishComment.expectations) == 0 && mmPublishComment.defaultExpectation == nil && mmPublishComment.mock.funcPublishComment == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmPublishComment.mock.afterPublishCommentCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmPublishComment.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// PublishComment implements mm_storage.Storage
+func (mmPublishComment *StorageMock) PublishComment(ctx context.Context, id string) (err error) {
+	mm_atomic.AddUint64(&mmPublishComment.beforePublishCommentCounter, 1)
+	defer mm_atomic.AddUint64(&mmPublishComment.afterPublishCommentCounter, 1)
+
+	mmPublishComment.t.Helper()
+
+	if mmPublishComment.inspectFuncPublishComment != nil {
+		mmPublishComment.inspectFuncPublishComment(ctx, id)
+	}
+
+	mm_params := StorageMockPublishCommentParams{ctx, id}
+
+	// Record call args
+	mmPublishComment.PublishCommentMock.mutex.Lock()
+	mmPublishComment.PublishCommentMock.callArgs = append(mmPublishComment.PublishCommentMock.callArgs, &mm_params)
+	mmPublishComment.PublishCommentMock.mutex.Unlock()
+
+	for _, e := range mmPublishComment.PublishCommentMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmPublishComment.PublishCommentMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmPublishComment.PublishCommentMock.defaultExpectation.Counter, 1)
+		mm_want := mmPublishComment.PublishCommentMock.defaultExpectation.params
+		mm_want_ptrs := mmPublishComment.PublishCommentMock.defaultExpectation.paramPtrs
+
+		mm_got := StorageMockPublishCommentParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmPublishComment.t.Errorf("StorageMock.PublishComment got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPublishComment.PublishCommentMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmPublishComment.t.Errorf("StorageMock.PublishComment got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPublishComment.PublishCommentMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmPublishComment.t.Errorf("StorageMock.PublishComment got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmPublishComment.PublishCommentMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmPublishComment.PublishCommentMock.defaultExpectation.results
+		if mm_results == nil {
+			mmPublishComment.t.Fatal("No results are set for the StorageMock.PublishComment")
+		}
+		return (*mm_results).err
+	}
+	if mmPublishComment.funcPublishComment != nil {
+		return mmPublishComment.funcPublishComment(ctx, id)
+	}
+	mmPublishComment.t.Fatalf("Unexpected call to StorageMock.PublishComment. %v %v", ctx, id)
+	return
+}
+
+// PublishCommentAfterCounter returns a count of finished StorageMock.PublishComment invocations
+func (mmPublishComment *StorageMock) PublishCommentAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPublishComment.afterPublishCommentCounter)
+}
+
+// PublishCommentBeforeCounter returns a count of StorageMock.PublishComment invocations
+func (mmPublishComment *StorageMock) PublishCommentBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPublishComment.beforePublishCommentCounter)
+}
+
+// Calls returns a list of arguments used in each call to StorageMock.PublishComment.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmPublishComment *mStorageMockPublishComment) Calls() []*StorageMockPublishCommentParams {
+	mmPublishComment.mutex.RLock()
+
+	argCopy := make([]*StorageMockPublishCommentParams, len(mmPublishComment.callArgs))
+	copy(argCopy, mmPublishComment.callArgs)
+
+	mmPublishComment.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockPublishCommentDone returns true if the count of the PublishComment invocations corresponds
+// the number of defined expectations
+func (m *StorageMock) MinimockPublishCommentDone() bool {
+	if m.PublishCommentMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.PublishCommentMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.PublishCommentMock.invocationsDone()
+}
+
+// MinimockPublishCommentInspect logs each unmet expectation
+func (m *StorageMock) MinimockPublishCommentInspect() {
+	for _, e := range m.PublishCommentMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to StorageMock.PublishComment at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterPublishCommentCounter := mm_atomic.LoadUint64(&m.afterPublishCommentCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.PublishCommentMock.defaultExpectation != nil && afterPublishCommentCounter < 1 {
+		if m.PublishCommentMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to StorageMock.PublishComment at\n%s", m.PublishCommentMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to StorageMock.PublishComment at\n%s with params: %#v", m.PublishCommentMock.defaultExpectation.expectationOrigins.origin, *m.PublishCommentMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcPublishComment != nil && afterPublishCommentCounter < 1 {
+		m.t.Errorf("Expected call to StorageMock.PublishComment at\n%s", m.funcPublishCommentOrigin)
+	}
+
+	if !m.PublishCommentMock.invocationsDone() && afterPublishCommentCounter > 0 {
+		m.t.Errorf("Expected %d calls to StorageMock.PublishComment at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.PublishCommentMock.expectedInvocations), m.PublishCommentMock.expectedInvocationsOrigin, afterPublishCommentCounter)
+	}
+}
+
+type mStorageMockPublishPost struct {
+	optional           bool
+	mock               *StorageMock
+	defaultExpectation *StorageMockPublishPostExpectation
+	expectations       []*StorageMockPublishPostExpectation
+
+	callArgs []*StorageMockPublishPostParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// StorageMockPublishPostExpectation specifies expectation struct of the Storage.PublishPost
+type StorageMockPublishPostExpectation struct {
+	mock               *StorageMock
+	params             *StorageMockPublishPostParams
+	paramPtrs          *StorageMockPublishPostParamPtrs
+	expectationOrigins StorageMockPublishPostExpectationOrigins
+	results            *StorageMockPublishPostResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// StorageMockPublishPostParams contains parameters of the Storage.PublishPost
+type StorageMockPublishPostParams struct {
+	ctx context.Context
+	id  string
+}
+
+// StorageMockPublishPostParamPtrs contains pointers to parameters of the Storage.PublishPost
+type StorageMockPublishPostParamPtrs struct {
+	ctx *context.Context
+	id  *string
+}
+
+// StorageMockPublishPostResults contains results of the Storage.PublishPost
+type StorageMockPublishPostResults struct {
+	err error
+}
+
+// StorageMockPublishPostOrigins contains origins of expectations of the Storage.PublishPost
+type StorageMockPublishPostExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originId  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmPublishPost *mStorageMockPublishPost) Optional() *mStorageMockPublishPost {
+	mmPublishPost.optional = true
+	return mmPublishPost
+}
+
+// Expect sets up expected params for Storage.PublishPost
+func (mmPublishPost *mStorageMockPublishPost) Expect(ctx context.Context, id string) *mStorageMockPublishPost {
+	if mmPublishPost.mock.funcPublishPost != nil {
+		mmPublishPost.mock.t.Fatalf("StorageMock.PublishPost mock is already set by Set")
+	}
+
+	if mmPublishPost.defaultExpectation == nil {
+		mmPublishPost.defaultExpectation = &StorageMockPublishPostExpectation{}
+	}
+
+	if mmPublishPost.defaultExpectation.paramPtrs != nil {
+		mmPublishPost.mock.t.Fatalf("StorageMock.PublishPost mock is already set by ExpectParams functions")
+	}
+
+	mmPublishPost.defaultExpectation.params = &StorageMockPublishPostParams{ctx, id}
+	mmPublishPost.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmPublishPost.expectations {
+		if minimock.Equal(e.params, mmPublishPost.defaultExpectation.params) {
+			mmPublishPost.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmPublishPost.defaultExpectation.params)
+		}
+	}
+
+	return mmPublishPost
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Storage.PublishPost
+func (mmPublishPost *mStorageMockPublishPost) ExpectCtxParam1(ctx context.Context) *mStorageMockPublishPost {
+	if mmPublishPost.mock.funcPublishPost != nil {
+		mmPublishPost.mock.t.Fatalf("StorageMock.PublishPost mock is already set by Set")
+	}
+
+	if mmPublishPost.defaultExpectation == nil {
+		mmPublishPost.defaultExpectation = &StorageMockPublishPostExpectation{}
+	}
+
+	if mmPublishPost.defaultExpectation.params != nil {
+		mmPublishPost.mock.t.Fatalf("StorageMock.PublishPost mock is already set by Expect")
+	}
+
+	if mmPublishPost.defaultExpectation.paramPtrs == nil {
+		mmPublishPost.defaultExpectation.paramPtrs = &StorageMockPublishPostParamPtrs{}
+	}
+	mmPublishPost.defaultExpectation.paramPtrs.ctx = &ctx
+	mmPublishPost.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmPublishPost
+}
+
+// ExpectIdParam2 sets up expected param id for Storage.PublishPost
+func (mmPublishPost *mStorageMockPublishPost) ExpectIdParam2(id string) *mStorageMockPublishPost {
+	if mmPublishPost.mock.funcPublishPost != nil {
+		mmPublishPost.mock.t.Fatalf("StorageMock.PublishPost mock is already set by Set")
+	}
+
+	if mmPublishPost.defaultExpectation == nil {
+		mmPublishPost.defaultExpectation = &StorageMockPublishPostExpectation{}
+	}
+
+	if mmPublishPost.defaultExpectation.params != nil {
+		mmPublishPost.mock.t.Fatalf("StorageMock.PublishPost mock is already set by Expect")
+	}
+
+	if mmPublishPost.defaultExpectation.paramPtrs == nil {
+		mmPublishPost.defaultExpectation.paramPtrs = &StorageMockPublishPostParamPtrs{}
+	}
+	mmPublishPost.defaultExpectation.paramPtrs.id = &id
+	mmPublishPost.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmPublishPost
+}
+
+// Inspect accepts an inspector function that has same arguments as the Storage.PublishPost
+func (mmPublishPost *mStorageMockPublishPost) Inspect(f func(ctx context.Context, id string)) *mStorageMockPublishPost {
+	if mmPublishPost.mock.inspectFuncPublishPost != nil {
+		mmPublishPost.mock.t.Fatalf("Inspect function is already set for StorageMock.PublishPost")
+	}
+
+	mmPublishPost.mock.inspectFuncPublishPost = f
+
+	return mmPublishPost
+}
+
+// Return sets up results that will be returned by Storage.PublishPost
+func (mmPublishPost *mStorageMockPublishPost) Return(err error) *StorageMock {
+	if mmPublishPost.mock.funcPublishPost != nil {
+		mmPublishPost.mock.t.Fatalf("StorageMock.PublishPost mock is already set by Set")
+	}
+
+	if mmPublishPost.defaultExpectation == nil {
+		mmPublishPost.defaultExpectation = &StorageMockPublishPostExpectation{mock: mmPublishPost.mock}
+	}
+	mmPublishPost.defaultExpectation.results = &StorageMockPublishPostResults{err}
+	mmPublishPost.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmPublishPost.mock
+}
+
+// Set uses given function f to mock the Storage.PublishPost method
+func (mmPublishPost *mStorageMockPublishPost) Set(f func(ctx context.Context, id string) (err error)) *StorageMock {
+	if mmPublishPost.defaultExpectation != nil {
+		mmPublishPost.mock.t.Fatalf("Default expectation is already set for the Storage.PublishPost method")
+	}
+
+	if len(mmPublishPost.expectations) > 0 {
+		mmPublishPost.mock.t.Fatalf("Some expectations are already set for the Storage.PublishPost method")
+	}
+
+	mmPublishPost.mock.funcPublishPost = f
+	mmPublishPost.mock.funcPublishPostOrigin = minimock.CallerInfo(1)
+	return mmPublishPost.mock
+}
+
+// When sets expectation for the Storage.PublishPost which will trigger the result defined by the following
+// Then helper
+func (mmPublishPost *mStorageMockPublishPost) When(ctx context.Context, id string) *StorageMockPublishPostExpectation {
+	if mmPublishPost.mock.funcPublishPost != nil {
+		mmPublishPost.mock.t.Fatalf("StorageMock.PublishPost mock is already set by Set")
+	}
+
+	expectation := &StorageMockPublishPostExpectation{
+		mock:               mmPublishPost.mock,
+		params:             &StorageMockPublishPostParams{ctx, id},
+		expectationOrigins: StorageMockPublishPostExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmPublishPost.expectations = append(mmPublishPost.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Storage.PublishPost return parameters for the expectation previously defined by the When method
+func (e *StorageMockPublishPostExpectation) Then(err error) *StorageMock {
+	e.results = &StorageMockPublishPostResults{err}
+	return e.mock
+}
+
+// Times sets number of times Storage.PublishPost should be invoked
+func (mmPublishPost *mStorageMockPublishPost) Times(n uint64) *mStorageMockPublishPost {
+	if n == 0 {
+		mmPublishPost.mock.t.Fatalf("Times of StorageMock.PublishPost mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmPublishPost.expectedInvocations, n)
+	mmPublishPost.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmPublishPost
+}
+
+func (mmPublishPost *mStorageMockPublishPost) invocationsDone() bool {
+	if len(mmPublishPost.expectations) == 0 && mmPublishPost.defaultExpectation == nil && mmPublishPost.mock.funcPublishPost == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmPublishPost.mock.afterPublishPostCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmPublishPost.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// PublishPost implements mm_storage.Storage
+func (mmPublishPost *StorageMock) PublishPost(ctx context.Context, id string) (err error) {
+	mm_atomic.AddUint64(&mmPublishPost.beforePublishPostCounter, 1)
+	defer mm_atomic.AddUint64(&mmPublishPost.afterPublishPostCounter, 1)
+
+	mmPublishPost.t.Helper()
+
+	if mmPublishPost.inspectFuncPublishPost != nil {
+		mmPublishPost.inspectFuncPublishPost(ctx, id)
+	}
+
+	mm_params := StorageMockPublishPostParams{ctx, id}
+
+	// Record call args
+	mmPublishPost.PublishPostMock.mutex.Lock()
+	mmPublishPost.PublishPostMock.callArgs = append(mmPublishPost.PublishPostMock.callArgs, &mm_params)
+	mmPublishPost.PublishPostMock.mutex.Unlock()
+
+	for _, e := range mmPublishPost.PublishPostMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmPublishPost.PublishPostMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmPublishPost.PublishPostMock.defaultExpectation.Counter, 1)
+		mm_want := mmPublishPost.PublishPostMock.defaultExpectation.params
+		mm_want_ptrs := mmPublishPost.PublishPostMock.defaultExpectation.paramPtrs
+
+		mm_got := StorageMockPublishPostParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmPublishPost.t.Errorf("StorageMock.PublishPost got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPublishPost.PublishPostMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmPublishPost.t.Errorf("StorageMock.PublishPost got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPublishPost.PublishPostMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmPublishPost.t.Errorf("StorageMock.PublishPost got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmPublishPost.PublishPostMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmPublishPost.PublishPostMock.defaultExpectation.results
+		if mm_results == nil {
+			mmPublishPost.t.Fatal("No results are set for the StorageMock.PublishPost")
+		}
+		return (*mm_results).err
+	}
+	if mmPublishPost.funcPublishPost != nil {
+		return mmPublishPost.funcPublishPost(ctx, id)
+	}
+	mmPublishPost.t.Fatalf("Unexpected call to StorageMock.PublishPost. %v %v", ctx, id)
+	return
+}
+
+// PublishPostAfterCounter returns a count of finished StorageMock.PublishPost invocations
+func (mmPublishPost *StorageMock) PublishPostAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPublishPost.afterPublishPostCounter)
+}
+
+// PublishPostBeforeCounter returns a count of StorageMock.PublishPost invocations
+func (mmPublishPost *StorageMock) PublishPostBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPublishPost.beforePublishPostCounter)
+}
+
+// Calls returns a list of arguments used in each call to StorageMock.PublishPost.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmPublishPost *mStorageMockPublishPost) Calls() []*StorageMockPublishPostParams {
+	mmPublishPost.mutex.RLock()
+
+	argCopy := make([]*StorageMockPublishPostParams, len(mmPublishPost.callArgs))
+	copy(argCopy, mmPublishPost.callArgs)
+
+	mmPublishPost.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockPublishPostDone returns true if the count of the PublishPost invocations corresponds
+// the number of defined expectations
+func (m *StorageMock) MinimockPublishPostDone() bool {
+	if m.PublishPostMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.PublishPostMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.PublishPostMock.invocationsDone()
+}
+
+// MinimockPublishPostInspect logs each unmet expectation
+func (m *StorageMock) MinimockPublishPostInspect() {
+	for _, e := range m.PublishPostMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to StorageMock.PublishPost at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterPublishPostCounter := mm_atomic.LoadUint64(&m.afterPublishPostCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.PublishPostMock.defaultExpectation != nil && afterPublishPostCounter < 1 {
+		if m.PublishPostMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to StorageMock.PublishPost at\n%s", m.PublishPostMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to StorageMock.PublishPost at\n%s with params: %#v", m.PublishPostMock.defaultExpectation.expectationOrigins.origin, *m.PublishPostMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcPublishPost != nil && afterPublishPostCounter < 1 {
+		m.t.Errorf("Expected call to StorageMock.PublishPost at\n%s", m.funcPublishPostOrigin)
+	}
+
+	if !m.PublishPostMock.invocationsDone() && afterPublishPostCounter > 0 {
+		m.t.Errorf("Expected %d calls to StorageMock.PublishPost at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.PublishPostMock.expectedInvocations), m.PublishPostMock.expectedInvocationsOrigin, afterPublishPostCounter)
+	}
+}
+
+type mStorageMockReleaseIdempotencyKey struct {
+	optional           bool
+	mock               *StorageMock
+	defaultExpectation *StorageMockReleaseIdempotencyKeyExpectation
+	expectations       []*StorageMockReleaseIdempotencyKeyExpectation
+
+	callArgs []*StorageMockReleaseIdempotencyKeyParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// StorageMockReleaseIdempotencyKeyExpectation specifies expectation struct of the Storage.ReleaseIdempotencyKey
+type StorageMockReleaseIdempotencyKeyExpectation struct {
+	mock               *StorageMock
+	params             *StorageMockReleaseIdempotencyKeyParams
+	paramPtrs          *StorageMockReleaseIdempotencyKeyParamPtrs
+	expectationOrigins StorageMockReleaseIdempotencyKeyExpectationOrigins
+	results            *StorageMockReleaseIdempotencyKeyResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// StorageMockReleaseIdempotencyKeyParams contains parameters of the Storage.ReleaseIdempotencyKey
+type StorageMockReleaseIdempotencyKeyParams struct {
+	ctx      context.Context
+	key      string
+	resultID string
+}
+
+// StorageMockReleaseIdempotencyKeyParamPtrs contains pointers to parameters of the Storage.ReleaseIdempotencyKey
+type StorageMockReleaseIdempotencyKeyParamPtrs struct {
+	ctx      *context.Context
+	key      *string
+	resultID *string
+}
+
+// StorageMockReleaseIdempotencyKeyResults contains results of the Storage.ReleaseIdempotencyKey
+type StorageMockReleaseIdempotencyKeyResults struct {
+	err error
+}
+
+// StorageMockReleaseIdempotencyKeyOrigins contains origins of expectations of the Storage.ReleaseIdempotencyKey
+type StorageMockReleaseIdempotencyKeyExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originKey      string
+	originResultID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmReleaseIdempotencyKey *mStorageMockReleaseIdempotencyKey) Optional() *mStorageMockReleaseIdempotencyKey {
+	mmReleaseIdempotencyKey.optional = true
+	return mmReleaseIdempotencyKey
+}
+
+// Expect sets up expected params for Storage.ReleaseIdempotencyKey
+func (mmReleaseIdempotencyKey *mStorageMockReleaseIdempotencyKey) Expect(ctx context.Context, key string, resultID string) *mStorageMockReleaseIdempotencyKey {
+	if mmReleaseIdempotencyKey.mock.funcReleaseIdempotencyKey != nil {
+		mmReleaseIdempotencyKey.mock.t.Fatalf("StorageMock.ReleaseIdempotencyKey mock is already set by Set")
+	}
+
+	if mmReleaseIdempotencyKey.defaultExpectation == nil {
+		mmReleaseIdempotencyKey.defaultExpectation = &StorageMockReleaseIdempotencyKeyExpectation{}
+	}
+
+	if mmReleaseIdempotencyKey.defaultExpectation.paramPtrs != nil {
+		mmReleaseIdempotencyKey.mock.t.Fatalf("StorageMock.ReleaseIdempotencyKey mock is already set by ExpectParams functions")
+	}
+
+	mmReleaseIdempotencyKey.defaultExpectation.params = &StorageMockReleaseIdempotencyKeyParams{ctx, key, resultID}
+	mmReleaseIdempotencyKey.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmReleaseIdempotencyKey.expectations {
+		if minimock.Equal(e.params, mmReleaseIdempotencyKey.defaultExpectation.params) {
+			mmReleaseIdempotencyKey.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmReleaseIdempotencyKey.defaultExpectation.params)
+		}
+	}
+
+	return mmReleaseIdempotencyKey
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Storage.ReleaseIdempotencyKey
+func (mmReleaseIdempotencyKey *mStorageMockReleaseIdempotencyKey) ExpectCtxParam1(ctx context.Context) *mStorageMockReleaseIdempotencyKey {
+	if mmReleaseIdempotencyKey.mock.funcReleaseIdempotencyKey != nil {
+		mmReleaseIdempotencyKey.mock.t.Fatalf("StorageMock.ReleaseIdempotencyKey mock is already set by Set")
+	}
+
+	if mmReleaseIdempotencyKey.defaultExpectation == nil {
+		mmReleaseIdempotencyKey.defaultExpectation = &StorageMockReleaseIdempotencyKeyExpectation{}
+	}
+
+	if mmReleaseIdempotencyKey.defaultExpectation.params != nil {
+		mmReleaseIdempotencyKey.mock.t.Fatalf("StorageMock.ReleaseIdempotencyKey mock is already set by Expect")
+	}
+
+	if mmReleaseIdempotencyKey.defaultExpectation.paramPtrs == nil {
+		mmReleaseIdempotencyKey.defaultExpectation.paramPtrs = &StorageMockReleaseIdempotencyKeyParamPtrs{}
+	}
+	mmReleaseIdempotencyKey.defaultExpectation.paramPtrs.ctx = &ctx
+	mmReleaseIdempotencyKey.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmReleaseIdempotencyKey
+}
+
+// ExpectKeyParam2 sets up expected param key for Storage.ReleaseIdempotencyKey
+func (mmReleaseIdempotencyKey *mStorageMockReleaseIdempotencyKey) ExpectKeyParam2(key string) *mStorageMockReleaseIdempotencyKey {
+	if mmReleaseIdempotencyKey.mock.funcReleaseIdempotencyKey != nil {
+		mmReleaseIdempotencyKey.mock.t.Fatalf("StorageMock.ReleaseIdempotencyKey mock is already set by Set")
+	}
+
+	if mmReleaseIdempotencyKey.defaultExpectation == nil {
+		mmReleaseIdempotencyKey.defaultExpectation = &StorageMockReleaseIdempotencyKeyExpectation{}
+	}
+
+	if mmReleaseIdempotencyKey.defaultExpectation.params != nil {
+		mmReleaseIdempotencyKey.mock.t.Fatalf("StorageMock.ReleaseIdempotencyKey mock is already set by Expect")
+	}
+
+	if mmReleaseIdempotencyKey.defaultExpectation.paramPtrs == nil {
+		mmReleaseIdempotencyKey.defaultExpectation.paramPtrs = &StorageMockReleaseIdempotencyKeyParamPtrs{}
+	}
+	mmReleaseIdempotencyKey.defaultExpectation.paramPtrs.key = &key
+	mmReleaseIdempotencyKey.defaultExpectation.expectationOrigins.originKey = minimock.CallerInfo(1)
+
+	return mmReleaseIdempotencyKey
+}
+
+// ExpectResultIDParam3 sets up expected param resultID for Storage.ReleaseIdempotencyKey
+func (mmReleaseIdempotencyKey *mStorageMockReleaseIdempotencyKey) ExpectResultIDParam3(resultID string) *mStorageMockReleaseIdempotencyKey {
+	if mmReleaseIdempotencyKey.mock.funcReleaseIdempotencyKey != nil {
+		mmReleaseIdempotencyKey.mock.t.Fatalf("StorageMock.ReleaseIdempotencyKey mock is already set by Set")
+	}
+
+	if mmReleaseIdempotencyKey.defaultExpectation == nil {
+		mmReleaseIdempotencyKey.defaultExpectation = &StorageMockReleaseIdempotencyKeyExpectation{}
+	}
+
+	if mmReleaseIdempotencyKey.defaultExpectation.params != nil {
+		mmReleaseIdempotencyKey.mock.t.Fatalf("StorageMock.ReleaseIdempotencyKey mock is already set by Expect")
+	}
+
+	if mmReleaseIdempotencyKey.defaultExpectation.paramPtrs == nil {
+		mmReleaseIdempotencyKey.defaultExpectation.paramPtrs = &StorageMockReleaseIdempotencyKeyParamPtrs{}
+	}
+	mmReleaseIdempotencyKey.defaultExpectation.paramPtrs.resultID = &resultID
+	mmReleaseIdempotencyKey.defaultExpectation.expectationOrigins.originResultID = minimock.CallerInfo(1)
+
+	return mmReleaseIdempotencyKey
+}
+
+// Inspect accepts an inspector function that has same arguments as the Storage.ReleaseIdempotencyKey
+func (mmReleaseIdempotencyKey *mStorageMockReleaseIdempotencyKey) Inspect(f func(ctx context.Context, key string, resultID string)) *mStorageMockReleaseIdempotencyKey {
+	if mmReleaseIdempotencyKey.mock.inspectFuncReleaseIdempotencyKey != nil {
+		mmReleaseIdempotencyKey.mock.t.Fatalf("Inspect function is already set for StorageMock.ReleaseIdempotencyKey")
+	}
+
+	mmReleaseIdempotencyKey.mock.inspectFuncReleaseIdempotencyKey = f
+
+	return mmReleaseIdempotencyKey
+}
+
+// Return sets up results that will be returned by Storage.ReleaseIdempotencyKey
+func (mmReleaseIdempotencyKey *mStorageMockReleaseIdempotencyKey) Return(err error) *StorageMock {
+	if mmReleaseIdempotencyKey.mock.funcReleaseIdempotencyKey != nil {
+		mmReleaseIdempotencyKey.mock.t.Fatalf("StorageMock.ReleaseIdempotencyKey mock is already set by Set")
+	}
+
+	if mmReleaseIdempotencyKey.defaultExpectation == nil {
+		mmReleaseIdempotencyKey.defaultExpectation = &StorageMockReleaseIdempotencyKeyExpectation{mock: mmReleaseIdempotencyKey.mock}
+	}
+	mmReleaseIdempotencyKey.defaultExpectation.results = &StorageMockReleaseIdempotencyKeyResults{err}
+	mmReleaseIdempotencyKey.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmReleaseIdempotencyKey.mock
+}
+
+// Set uses given function f to mock the Storage.ReleaseIdempotencyKey method
+func (mmReleaseIdempotencyKey *mStorageMockReleaseIdempotencyKey) Set(f func(ctx context.Context, key string, resultID string) (err error)) *StorageMock {
+	if mmReleaseIdempotencyKey.defaultExpectation != nil {
+		mmReleaseIdempotencyKey.mock.t.Fatalf("Default expectation is already set for the Storage.ReleaseIdempotencyKey method")
+	}
+
+	if len(mmReleaseIdempotencyKey.expectations) > 0 {
+		mmReleaseIdempotencyKey.mock.t.Fatalf("Some expectations are already set for the Storage.ReleaseIdempotencyKey method")
+	}
+
+	mmReleaseIdempotencyKey.mock.funcReleaseIdempotencyKey = f
+	mmReleaseIdempotencyKey.mock.funcReleaseIdempotencyKeyOrigin = minimock.CallerInfo(1)
+	return mmReleaseIdempotencyKey.mock
+}
+
+// When sets expectation for the Storage.ReleaseIdempotencyKey which will trigger the result defined by the following
+// Then helper
+func (mmReleaseIdempotencyKey *mStorageMockReleaseIdempotencyKey) When(ctx context.Context, key string, resultID string) *StorageMockReleaseIdempotencyKeyExpectation {
+	if mmReleaseIdempotencyKey.mock.funcReleaseIdempotencyKey != nil {
+		mmReleaseIdempotencyKey.mock.t.Fatalf("StorageMock.ReleaseIdempotencyKey mock is already set by Set")
+	}
+
+	expectation := &StorageMockReleaseIdempotencyKeyExpectation{
+		mock:               mmReleaseIdempotencyKey.mock,
+		params:             &StorageMockReleaseIdempotencyKeyParams{ctx, key, resultID},
+		expectationOrigins: StorageMockReleaseIdempotencyKeyExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmReleaseIdempotencyKey.expectations = append(mmReleaseIdempotencyKey.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Storage.ReleaseIdempotencyKey return parameters for the expectation previously defined by the When method
+func (e *StorageMockReleaseIdempotencyKeyExpectation) Then(err error) *StorageMock {
+	e.results = &StorageMockReleaseIdempotencyKeyResults{err}
+	return e.mock
+}
+
+// Times sets number of times Storage.ReleaseIdempotencyKey should be invoked
+func (mmReleaseIdempotencyKey *mStorageMockReleaseIdempotencyKey) Times(n uint64) *mStorageMockReleaseIdempotencyKey {
+	if n == 0 {
+		mmReleaseIdempotencyKey.mock.t.Fatalf("Times of StorageMock.ReleaseIdempotencyKey mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmReleaseIdempotencyKey.expectedInvocations, n)
+	mmReleaseIdempotencyKey.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmReleaseIdempotencyKey
+}
+
+func (mmReleaseIdempotencyKey *mStorageMockReleaseIdempotencyKey) invocationsDone() bool {
+	if len(mmReleaseIdempotencyKey.expectations) == 0 && mmReleaseIdempotencyKey.defaultExpectation == nil && mmReleaseIdempotencyKey.mock.funcReleaseIdempotencyKey == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmReleaseIdempotencyKey.mock.afterReleaseIdempotencyKeyCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmReleaseIdempotencyKey.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ReleaseIdempotencyKey implements mm_storage.Storage
+func (mmReleaseIdempotencyKey *StorageMock) ReleaseIdempotencyKey(ctx context.Context, key string, resultID string) (err error) {
+	mm_atomic.AddUint64(&mmReleaseIdempotencyKey.beforeReleaseIdempotencyKeyCounter, 1)
+	defer mm_atomic.AddUint64(&mmReleaseIdempotencyKey.afterReleaseIdempotencyKeyCounter, 1)
+
+	mmReleaseIdempotencyKey.t.Helper()
+
+	if mmReleaseIdempotencyKey.inspectFuncReleaseIdempotencyKey != nil {
+		mmReleaseIdempotencyKey.inspectFuncReleaseIdempotencyKey(ctx, key, resultID)
+	}
+
+	mm_params := StorageMockReleaseIdempotencyKeyParams{ctx, key, resultID}
+
+	// Record call args
+	mmReleaseIdempotencyKey.ReleaseIdempotencyKeyMock.mutex.Lock()
+	mmReleaseIdempotencyKey.ReleaseIdempotencyKeyMock.callArgs = append(mmReleaseIdempotencyKey.ReleaseIdempotencyKeyMock.callArgs, &mm_params)
+	mmReleaseIdempotencyKey.ReleaseIdempotencyKeyMock.mutex.Unlock()
+
+	for _, e := range mmReleaseIdempotencyKey.ReleaseIdempotencyKeyMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmReleaseIdempotencyKey.ReleaseIdempotencyKeyMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmReleaseIdempotencyKey.ReleaseIdempotencyKeyMock.defaultExpectation.Counter, 1)
+		mm_want := mmReleaseIdempotencyKey.ReleaseIdempotencyKeyMock.defaultExpectation.params
+		mm_want_ptrs := mmReleaseIdempotencyKey.ReleaseIdempotencyKeyMock.defaultExpectation.paramPtrs
+
+		mm_got := StorageMockReleaseIdempotencyKeyParams{ctx, key, resultID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmReleaseIdempotencyKey.t.Errorf("StorageMock.ReleaseIdempotencyKey got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmReleaseIdempotencyKey.ReleaseIdempotencyKeyMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.key != nil && !minimock.Equal(*mm_want_ptrs.key, mm_got.key) {
+				mmReleaseIdempotencyKey.t.Errorf("StorageMock.ReleaseIdempotencyKey got unexpected parameter key, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmReleaseIdempotencyKey.ReleaseIdempotencyKeyMock.defaultExpectation.expectationOrigins.originKey, *mm_want_ptrs.key, mm_got.key, minimock.Diff(*mm_want_ptrs.key, mm_got.key))
+			}
+
+			if mm_want_ptrs.resultID != nil && !minimock.Equal(*mm_want_ptrs.resultID, mm_got.resultID) {
+				mmReleaseIdempotencyKey.t.Errorf("StorageMock.ReleaseIdempotencyKey got unexpected parameter resultID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmReleaseIdempotencyKey.ReleaseIdempotencyKeyMock.defaultExpectation.expectationOrigins.originResultID, *mm_want_ptrs.resultID, mm_got.resultID, minimock.Diff(*mm_want_ptrs.resultID, mm_got.resultID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmReleaseIdempotencyKey.t.Errorf("StorageMock.ReleaseIdempotencyKey got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmReleaseIdempotencyKey.ReleaseIdempotencyKeyMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmReleaseIdempotencyKey.ReleaseIdempotencyKeyMock.defaultExpectation.results
+		if mm_results == nil {
+			mmReleaseIdempotencyKey.t.Fatal("No results are set for the StorageMock.ReleaseIdempotencyKey")
+		}
+		return (*mm_results).err
+	}
+	if mmReleaseIdempotencyKey.funcReleaseIdempotencyKey != nil {
+		return mmReleaseIdempotencyKey.funcReleaseIdempotencyKey(ctx, key, resultID)
+	}
+	mmReleaseIdempotencyKey.t.Fatalf("Unexpected call to StorageMock.ReleaseIdempotencyKey. %v %v %v", ctx, key, resultID)
+	return
+}
+
+// ReleaseIdempotencyKeyAfterCounter returns a count of finished StorageMock.ReleaseIdempotencyKey invocations
+func (mmReleaseIdempotencyKey *StorageMock) ReleaseIdempotencyKeyAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmReleaseIdempotencyKey.afterReleaseIdempotencyKeyCounter)
+}
+
+// ReleaseIdempotencyKeyBeforeCounter returns a count of StorageMock.ReleaseIdempotencyKey invocations
+func (mmReleaseIdempotencyKey *StorageMock) ReleaseIdempotencyKeyBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmReleaseIdempotencyKey.beforeReleaseIdempotencyKeyCounter)
+}
+
+// Calls returns a list of arguments used in each call to StorageMock.ReleaseIdempotencyKey.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmReleaseIdempotencyKey *mStorageMockReleaseIdempotencyKey) Calls() []*StorageMockReleaseIdempotencyKeyParams {
+	mmReleaseIdempotencyKey.mutex.RLock()
+
+	argCopy := make([]*StorageMockReleaseIdempotencyKeyParams, len(mmReleaseIdempotencyKey.callArgs))
+	copy(argCopy, mmReleaseIdempotencyKey.callArgs)
+
+	mmReleaseIdempotencyKey.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockReleaseIdempotencyKeyDone returns true if the count of the ReleaseIdempotencyKey invocations corresponds
+// the number of defined expectations
+func (m *StorageMock) MinimockReleaseIdempotencyKeyDone() bool {
+	if m.ReleaseIdempotencyKeyMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ReleaseIdempotencyKeyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ReleaseIdempotencyKeyMock.invocationsDone()
+}
+
+// MinimockReleaseIdempotencyKeyInspect logs each unmet expectation
+func (m *StorageMock) MinimockReleaseIdempotencyKeyInspect() {
+	for _, e := range m.ReleaseIdempotencyKeyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to StorageMock.ReleaseIdempotencyKey at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterReleaseIdempotencyKeyCounter := mm_atomic.LoadUint64(&m.afterReleaseIdempotencyKeyCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ReleaseIdempotencyKeyMock.defaultExpectation != nil && afterReleaseIdempotencyKeyCounter < 1 {
+		if m.ReleaseIdempotencyKeyMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to StorageMock.ReleaseIdempotencyKey at\n%s", m.ReleaseIdempotencyKeyMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to StorageMock.ReleaseIdempotencyKey at\n%s with params: %#v", m.ReleaseIdempotencyKeyMock.defaultExpectation.expectationOrigins.origin, *m.ReleaseIdempotencyKeyMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcReleaseIdempotencyKey != nil && afterReleaseIdempotencyKeyCounter < 1 {
+		m.t.Errorf("Expected call to StorageMock.ReleaseIdempotencyKey at\n%s", m.funcReleaseIdempotencyKeyOrigin)
+	}
+
+	if !m.ReleaseIdempotencyKeyMock.invocationsDone() && afterReleaseIdempotencyKeyCounter > 0 {
+		m.t.Errorf("Expected %d calls to StorageMock.ReleaseIdempotencyKey at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ReleaseIdempotencyKeyMock.expectedInvocations), m.ReleaseIdempotencyKeyMock.expectedInvocationsOrigin, afterReleaseIdempotencyKeyCounter)
+	}
+}
+
+type mStorageMockRemoveComment struct {
+	optional           bool
+	mock               *StorageMock
+	defaultExpectation *StorageMockRemoveCommentExpectation
+	expectations       []*StorageMockRemoveCommentExpectation
+
+	callArgs []*StorageMockRemoveCommentParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// StorageMockRemoveCommentExpectation specifies expectation struct of the Storage.RemoveComment
+type StorageMockRemoveCommentExpectation struct {
+	mock               *StorageMock
+	params             *StorageMockRemoveCommentParams
+	paramPtrs          *StorageMockRemoveCommentParamPtrs
+	expectationOrigins StorageMockRemoveCommentExpectationOrigins
+	results            *StorageMockRemoveCommentResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// StorageMockRemoveCommentParams contains parameters of the Storage.RemoveComment
+type StorageMockRemoveCommentParams struct {
+	ctx context.Context
+	id  string
+}
+
+// StorageMockRemoveCommentParamPtrs contains pointers to parameters of the Storage.RemoveComment
+type StorageMockRemoveCommentParamPtrs struct {
+	ctx *context.Context
+	id  *string
+}
+
+// StorageMockRemoveCommentResults contains results of the Storage.RemoveComment
+type StorageMockRemoveCommentResults struct {
+	err error
+}
+
+// StorageMockRemoveCommentOrigins contains origins of expectations of the Storage.RemoveComment
+type StorageMockRemoveCommentExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originId  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRemoveComment *mStorageMockRemoveComment) Optional() *mStorageMockRemoveComment {
+	mmRemoveComment.optional = true
+	return mmRemoveComment
+}
+
+// Expect sets up expected params for Storage.RemoveComment
+func (mmRemoveComment *mStorageMockRemoveComment) Expect(ctx context.Context, id string) *mStorageMockRemoveComment {
+	if mmRemoveComment.mock.funcRemoveComment != nil {
+		mmRemoveComment.mock.t.Fatalf("StorageMock.RemoveComment mock is already set by Set")
+	}
+
+	if mmRemoveComment.defaultExpectation == nil {
+		mmRemoveComment.defaultExpectation = &StorageMockRemoveCommentExpectation{}
+	}
+
+	if mmRemoveComment.defaultExpectation.paramPtrs != nil {
+		mmRemoveComment.mock.t.Fatalf("StorageMock.RemoveComment mock is already set by ExpectParams functions")
+	}
+
+	mmRemoveComment.defaultExpectation.params = &StorageMockRemoveCommentParams{ctx, id}
+	mmRemoveComment.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmRemoveComment.expectations {
+		if minimock.Equal(e.params, mmRemoveComment.defaultExpectation.params) {
+			mmRemoveComment.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRemoveComment.defaultExpectation.params)
+		}
+	}
+
+	return mmRemoveComment
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Storage.RemoveComment
+func (mmRemoveComment *mStorageMockRemoveComment) ExpectCtxParam1(ctx context.Context) *mStorageMockRemoveComment {
+	if mmRemoveComment.mock.funcRemoveComment != nil {
+		mmRemoveComment.mock.t.Fatalf("StorageMock.RemoveComment mock is already set by Set")
+	}
+
+	if mmRemoveComment.defaultExpectation == nil {
+		mmRemoveComment.defaultExpectation = &StorageMockRemoveCommentExpectation{}
+	}
+
+	if mmRemoveComment.defaultExpectation.params != nil {
+		mmRemoveComment.mock.t.Fatalf("StorageMock.RemoveComment mock is already set by Expect")
+	}
+
+	if mmRemoveComment.defaultExpectation.paramPtrs == nil {
+		mmRemoveComment.defaultExpectation.paramPtrs = &StorageMockRemoveCommentParamPtrs{}
+	}
+	mmRemoveComment.defaultExpectation.paramPtrs.ctx = &ctx
+	mmRemoveComment.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmRemoveComment
+}
+
+// ExpectIdParam2 sets up expected param id for Storage.RemoveComment
+func (mmRemoveComment *mStorageMockRemoveComment) ExpectIdParam2(id string) *mStorageMockRemoveComment {
+	if mmRemoveComment.mock.funcRemoveComment != nil {
+		mmRemoveComment.mock.t.Fatalf("StorageMock.RemoveComment mock is already set by Set")
+	}
+
+	if mmRemoveComment.defaultExpectation == nil {
+		mmRemoveComment.defaultExpectation = &StorageMockRemoveCommentExpectation{}
+	}
+
+	if mmRemoveComment.defaultExpectation.params != nil {
+		mmRemoveComment.mock.t.Fatalf("StorageMock.RemoveComment mock is already set by Expect")
+	}
+
+	if mmRemoveComment.defaultExpectation.paramPtrs == nil {
+		mmRemoveComment.defaultExpectation.paramPtrs = &StorageMockRemoveCommentParamPtrs{}
+	}
+	mmRemoveComment.defaultExpectation.paramPtrs.id = &id
+	mmRemoveComment.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmRemoveComment
+}
+
+// Inspect accepts an inspector function that has same arguments as the Storage.RemoveComment
+func (mmRemoveComment *mStorageMockRemoveComment) Inspect(f func(ctx context.Context, id string)) *mStorageMockRemoveComment {
+	if mmRemoveComment.mock.inspectFuncRemoveComment != nil {
+		mmRemoveComment.mock.t.Fatalf("Inspect function is already set for StorageMock.RemoveComment")
+	}
+
+	mmRemoveComment.mock.inspectFuncRemoveComment = f
+
+	return mmRemoveComment
+}
+
+// Return sets up results that will be returned by Storage.RemoveComment
+func (mmRemoveComment *mStorageMockRemoveComment) Return(err error) *StorageMock {
+	if mmRemoveComment.mock.funcRemoveComment != nil {
+		mmRemoveComment.mock.t.Fatalf("StorageMock.RemoveComment mock is already set by Set")
+	}
+
+	if mmRemoveComment.defaultExpectation == nil {
+		mmRemoveComment.defaultExpectation = &StorageMockRemoveCommentExpectation{mock: mmRemoveComment.mock}
+	}
+	mmRemoveComment.defaultExpectation.results = &StorageMockRemoveCommentResults{err}
+	mmRemoveComment.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmRemoveComment.mock
+}
+
+// Set uses given function f to mock the Storage.RemoveComment method
+func (mmRemoveComment *mStorageMockRemoveComment) Set(f func(ctx context.Context, id string) (err error)) *StorageMock {
+	if mmRemoveComment.defaultExpectation != nil {
+		mmRemoveComment.mock.t.Fatalf("Default expectation is already set for the Storage.RemoveComment method")
+	}
+
+	if len(mmRemoveComment.expectations) > 0 {
+		mmRemoveComment.mock.t.Fatalf("Some expectations are already set for the Storage.RemoveComment method")
+	}
+
+	mmRemoveComment.mock.funcRemoveComment = f
+	mmRemoveComment.mock.funcRemoveCommentOrigin = minimock.CallerInfo(1)
+	return mmRemoveComment.mock
+}
+
+// When sets expectation for the Storage.RemoveComment which will trigger the result defined by the following
+// Then helper
+func (mmRemoveComment *mStorageMockRemoveComment) When(ctx context.Context, id string) *StorageMockRemoveCommentExpectation {
+	if mmRemoveComment.mock.funcRemoveComment != nil {
+		mmRemoveComment.mock.t.Fatalf("StorageMock.RemoveComment mock is already set by Set")
+	}
+
+	expectation := &StorageMockRemoveCommentExpectation{
+		mock:               mmRemoveComment.mock,
+		params:             &StorageMockRemoveCommentParams{ctx, id},
+		expectationOrigins: StorageMockRemoveCommentExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmRemoveComment.expectations = append(mmRemoveComment.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Storage.RemoveComment return parameters for the expectation previously defined by the When method
+func (e *StorageMockRemoveCommentExpectation) Then(err error) *StorageMock {
+	e.results = &StorageMockRemoveCommentResults{err}
+	return e.mock
+}
+
+// Times sets number of times Storage.RemoveComment should be invoked
+func (mmRemoveComment *mStorageMockRemoveComment) Times(n uint64) *mStorageMockRemoveComment {
+	if n == 0 {
+		mmRemoveComment.mock.t.Fatalf("Times of StorageMock.RemoveComment mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRemoveComment.expectedInvocations, n)
+	mmRemoveComment.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmRemoveComment
+}
+
+func (mmRemoveComment *mStorageMockRemoveComment) invocationsDone() bool {
+	if len(mmRemoveComment.expectations) == 0 && mmRemoveComment.defaultExpectation == nil && mmRemoveComment.mock.funcRemoveComment == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRemoveComment.mock.afterRemoveCommentCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRemoveComment.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// RemoveComment implements mm_storage.Storage
+func (mmRemoveComment *StorageMock) RemoveComment(ctx context.Context, id string) (err error) {
+	mm_atomic.AddUint64(&mmRemoveComment.beforeRemoveCommentCounter, 1)
+	defer mm_atomic.AddUint64(&mmRemoveComment.afterRemoveCommentCounter, 1)
+
+	mmRemoveComment.t.Helper()
+
+	if mmRemoveComment.inspectFuncRemoveComment != nil {
+		mmRemoveComment.inspectFuncRemoveComment(ctx, id)
+	}
+
+	mm_params := StorageMockRemoveCommentParams{ctx, id}
+
+	// Record call args
+	mmRemoveComment.RemoveCommentMock.mutex.Lock()
+	mmRemoveComment.RemoveCommentMock.callArgs = append(mmRemoveComment.RemoveCommentMock.callArgs, &mm_params)
+	mmRemoveComment.RemoveCommentMock.mutex.Unlock()
+
+	for _, e := range mmRemoveComment.RemoveCommentMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmRemoveComment.RemoveCommentMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRemoveComment.RemoveCommentMock.defaultExpectation.Counter, 1)
+		mm_want := mmRemoveComment.RemoveCommentMock.defaultExpectation.params
+		mm_want_ptrs := mmRemoveComment.RemoveCommentMock.defaultExpectation.paramPtrs
+
+		mm_got := StorageMockRemoveCommentParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRemoveComment.t.Errorf("StorageMock.RemoveComment got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRemoveComment.RemoveCommentMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmRemoveComment.t.Errorf("StorageMock.RemoveComment got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRemoveComment.RemoveCommentMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRemoveComment.t.Errorf("StorageMock.RemoveComment got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmRemoveComment.RemoveCommentMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRemoveComment.RemoveCommentMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRemoveComment.t.Fatal("No results are set for the StorageMock.RemoveComment")
+		}
+		return (*mm_results).err
+	}
+	if mmRemoveComment.funcRemoveComment != nil {
+		return mmRemoveComment.funcRemoveComment(ctx, id)
+	}
+	mmRemoveComment.t.Fatalf("Unexpected call to StorageMock.RemoveComment. %v %v", ctx, id)
+	return
+}
+
+// RemoveCommentAfterCounter returns a count of finished StorageMock.RemoveComment invocations
+func (mmRemoveComment *StorageMock) RemoveCommentAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRemoveComment.afterRemoveCommentCounter)
+}
+
+// RemoveCommentBeforeCounter returns a count of StorageMock.RemoveComment invocations
+func (mmRemoveComment *StorageMock) RemoveCommentBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRemoveComment.beforeRemoveCommentCounter)
+}
+
+// Calls returns a list of arguments used in each call to StorageMock.RemoveComment.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRemoveComment *mStorageMockRemoveComment) Calls() []*StorageMockRemoveCommentParams {
+	mmRemoveComment.mutex.RLock()
+
+	argCopy := make([]*StorageMockRemoveCommentParams, len(mmRemoveComment.callArgs))
+	copy(argCopy, mmRemoveComment.callArgs)
+
+	mmRemoveComment.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRemoveCommentDone returns true if the count of the RemoveComment invocations corresponds
+// the number of defined expectations
+func (m *StorageMock) MinimockRemoveCommentDone() bool {
+	if m.RemoveCommentMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RemoveCommentMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RemoveCommentMock.invocationsDone()
+}
+
+// MinimockRemoveCommentInspect logs each unmet expectation
+func (m *StorageMock) MinimockRemoveCommentInspect() {
+	for _, e := range m.RemoveCommentMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to StorageMock.RemoveComment at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterRemoveCommentCounter := mm_atomic.LoadUint64(&m.afterRemoveCommentCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RemoveCommentMock.defaultExpectation != nil && afterRemoveCommentCounter < 1 {
+		if m.RemoveCommentMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to StorageMock.RemoveComment at\n%s", m.RemoveCommentMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to StorageMock.RemoveComment at\n%s with params: %#v", m.RemoveCommentMock.defaultExpectation.expectationOrigins.origin, *m.RemoveCommentMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRemoveComment != nil && afterRemoveCommentCounter < 1 {
+		m.t.Errorf("Expected call to StorageMock.RemoveComment at\n%s", m.funcRemoveCommentOrigin)
+	}
+
+	if !m.RemoveCommentMock.invocationsDone() && afterRemoveCommentCounter > 0 {
+		m.t.Errorf("Expected %d calls to StorageMock.RemoveComment at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.RemoveCommentMock.expectedInvocations), m.RemoveCommentMock.expectedInvocationsOrigin, afterRemoveCommentCounter)
+	}
+}
+
+type mStorageMockRemovePost struct {
+	optional           bool
+	mock               *StorageMock
+	defaultExpectation *StorageMockRemovePostExpectation
+	expectations       []*StorageMockRemovePostExpectation
+
+	callArgs []*StorageMockRemovePostParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// StorageMockRemovePostExpectation specifies expectation struct of the Storage.RemovePost
+type StorageMockRemovePostExpectation struct {
+	mock               *StorageMock
+	params             *StorageMockRemovePostParams
+	paramPtrs          *StorageMockRemovePostParamPtrs
+	expectationOrigins StorageMockRemovePostExpectationOrigins
+	results            *StorageMockRemovePostResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// StorageMockRemovePostParams contains parameters of the Storage.RemovePost
+type StorageMockRemovePostParams struct {
+	ctx context.Context
+	id  string
+}
+
+// StorageMockRemovePostParamPtrs contains pointers to parameters of the Storage.RemovePost
+type StorageMockRemovePostParamPtrs struct {
+	ctx *context.Context
+	id  *string
+}
+
+// StorageMockRemovePostResults contains results of the Storage.RemovePost
+type StorageMockRemovePostResults struct {
+	err error
+}
+
+// StorageMockRemovePostOrigins contains origins of expectations of the Storage.RemovePost
+type StorageMockRemovePostExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originId  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRemovePost *mStorageMockRemovePost) Optional() *mStorageMockRemovePost {
+	mmRemovePost.optional = true
+	return mmRemovePost
+}
+
+// Expect sets up expected params for Storage.RemovePost
+func (mmRemovePost *mStorageMockRemovePost) Expect(ctx context.Context, id string) *mStorageMockRemovePost {
+	if mmRemovePost.mock.funcRemovePost != nil {
+		mmRemovePost.mock.t.Fatalf("StorageMock.RemovePost mock is already set by Set")
+	}
+
+	if mmRemovePost.defaultExpectation == nil {
+		mmRemovePost.defaultExpectation = &StorageMockRemovePostExpectation{}
+	}
+
+	if mmRemovePost.defaultExpectation.paramPtrs != nil {
+		mmRemovePost.mock.t.Fatalf("StorageMock.RemovePost mock is already set by ExpectParams functions")
+	}
+
+	mmRemovePost.defaultExpectation.params = &StorageMockRemovePostParams{ctx, id}
+	mmRemovePost.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmRemovePost.expectations {
+		if minimock.Equal(e.params, mmRemovePost.defaultExpectation.params) {
+			mmRemovePost.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRemovePost.defaultExpectation.params)
+		}
+	}
+
+	return mmRemovePost
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Storage.RemovePost
+func (mmRemovePost *mStorageMockRemovePost) ExpectCtxParam1(ctx context.Context) *mStorageMockRemovePost {
+	if mmRemovePost.mock.funcRemovePost != nil {
+		mmRemovePost.mock.t.Fatalf("StorageMock.RemovePost mock is already set by Set")
+	}
+
+	if mmRemovePost.defaultExpectation == nil {
+		mmRemovePost.defaultExpectation = &StorageMockRemovePostExpectation{}
+	}
+
+	if mmRemovePost.defaultExpectation.params != nil {
+		mmRemovePost.mock.t.Fatalf("StorageMock.RemovePost mock is already set by Expect")
+	}
+
+	if mmRemovePost.defaultExpectation.paramPtrs == nil {
+		mmRemovePost.defaultExpectation.paramPtrs = &StorageMockRemovePostParamPtrs{}
+	}
+	mmRemovePost.defaultExpectation.paramPtrs.ctx = &ctx
+	mmRemovePost.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmRemovePost
+}
+
+// ExpectIdParam2 sets up expected param id for Storage.RemovePost
+func (mmRemovePost *mStorageMockRemovePost) ExpectIdParam2(id string) *mStorageMockRemovePost {
+	if mmRemovePost.mock.funcRemovePost != nil {
+		mmRemovePost.mock.t.Fatalf("StorageMock.RemovePost mock is already set by Set")
+	}
+
+	if mmRemovePost.defaultExpectation == nil {
+		mmRemovePost.defaultExpectation = &StorageMockRemovePostExpectation{}
+	}
+
+	if mmRemovePost.defaultExpectation.params != nil {
+		mmRemovePost.mock.t.Fatalf("StorageMock.RemovePost mock is already set by Expect")
+	}
+
+	if mmRemovePost.defaultExpectation.paramPtrs == nil {
+		mmRemovePost.defaultExpectation.paramPtrs = &StorageMockRemovePostParamPtrs{}
+	}
+	mmRemovePost.defaultExpectation.paramPtrs.id = &id
+	mmRemovePost.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmRemovePost
+}
+
+// Inspect accepts an inspector function that has same arguments as the Storage.RemovePost
+func (mmRemovePost *mStorageMockRemovePost) Inspect(f func(ctx context.Context, id string)) *mStorageMockRemovePost {
+	if mmRemovePost.mock.inspectFuncRemovePost != nil {
+		mmRemovePost.mock.t.Fatalf("Inspect function is already set for StorageMock.RemovePost")
+	}
+
+	mmRemovePost.mock.inspectFuncRemovePost = f
+
+	return mmRemovePost
+}
+
+// Return sets up results that will be returned by Storage.RemovePost
+func (mmRemovePost *mStorageMockRemovePost) Return(err error) *StorageMock {
+	if mmRemovePost.mock.funcRemovePost != nil {
+		mmRemovePost.mock.t.Fatalf("StorageMock.RemovePost mock is already set by Set")
+	}
+
+	if mmRemovePost.defaultExpectation == nil {
+		mmRemovePost.defaultExpectation = &StorageMockRemovePostExpectation{mock: mmRemovePost.mock}
+	}
+	mmRemovePost.defaultExpectation.results = &StorageMockRemovePostResults{err}
+	mmRemovePost.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmRemovePost.mock
+}
+
+// Set uses given function f to mock the Storage.RemovePost method
+func (mmRemovePost *mStorageMockRemovePost) Set(f func(ctx context.Context, id string) (err error)) *StorageMock {
+	if mmRemovePost.defaultExpectation != nil {
+		mmRemovePost.mock.t.Fatalf("Default expectation is already set for the Storage.RemovePost method")
+	}
+
+	if len(mmRemovePost.expectations) > 0 {
+		mmRemovePost.mock.t.Fatalf("Some expectations are already set for the Storage.RemovePost method")
+	}
+
+	mmRemovePost.mock.funcRemovePost = f
+	mmRemovePost.mock.funcRemovePostOrigin = minimock.CallerInfo(1)
+	return mmRemovePost.mock
+}
+
+// When sets expectation for the Storage.RemovePost which will trigger the result defined by the following
+// Then helper
+func (mmRemovePost *mStorageMockRemovePost) When(ctx context.Context, id string) *StorageMockRemovePostExpectation {
+	if mmRemovePost.mock.funcRemovePost != nil {
+		mmRemovePost.mock.t.Fatalf("StorageMock.RemovePost mock is already set by Set")
+	}
+
+	expectation := &StorageMockRemovePostExpectation{
+		mock:               mmRemovePost.mock,
+		params:             &StorageMockRemovePostParams{ctx, id},
+		expectationOrigins: StorageMockRemovePostExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmRemovePost.expectations = append(mmRemovePost.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Storage.RemovePost return parameters for the expectation previously defined by the When method
+func (e *StorageMockRemovePostExpectation) Then(err error) *StorageMock {
+	e.results = &StorageMockRemovePostResults{err}
+	return e.mock
+}
+
+// Times sets number of times Storage.RemovePost should be invoked
+func (mmRemovePost *mStorageMockRemovePost) Times(n uint64) *mStorageMockRemovePost {
+	if n == 0 {
+		mmRemovePost.mock.t.Fatalf("Times of StorageMock.RemovePost mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRemovePost.expectedInvocations, n)
+	mmRemovePost.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmRemovePost
+}
+
+func (mmRemovePost *mStorageMockRemovePost) invocationsDone() bool {
+	if len(mmRemovePost.expectations) == 0 && mmRemovePost.defaultExpectation == nil && mmRemovePost.mock.funcRemovePost == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRemovePost.mock.afterRemovePostCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRemovePost.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// RemovePost implements mm_storage.Storage
+func (mmRemovePost *StorageMock) RemovePost(ctx context.Context, id string) (err error) {
+	mm_atomic.AddUint64(&mmRemovePost.beforeRemovePostCounter, 1)
+	defer mm_atomic.AddUint64(&mmRemovePost.afterRemovePostCounter, 1)
+
+	mmRemovePost.t.Helper()
+
+	if mmRemovePost.inspectFuncRemovePost != nil {
+		mmRemovePost.inspectFuncRemovePost(ctx, id)
+	}
+
+	mm_params := StorageMockRemovePostParams{ctx, id}
+
+	// Record call args
+	mmRemovePost.RemovePostMock.mutex.Lock()
+	mmRemovePost.RemovePostMock.callArgs = append(mmRemovePost.RemovePostMock.callArgs, &mm_params)
+	mmRemovePost.RemovePostMock.mutex.Unlock()
+
+	for _, e := range mmRemovePost.RemovePostMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmRemovePost.RemovePostMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRemovePost.RemovePostMock.defaultExpectation.Counter, 1)
+		mm_want := mmRemovePost.RemovePostMock.defaultExpectation.params
+		mm_want_ptrs := mmRemovePost.RemovePostMock.defaultExpectation.paramPtrs
+
+		mm_got := StorageMockRemovePostParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRemovePost.t.Errorf("StorageMock.RemovePost got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRemovePost.RemovePostMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmRemovePost.t.Errorf("StorageMock.RemovePost got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRemovePost.RemovePostMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRemovePost.t.Errorf("StorageMock.RemovePost got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmRemovePost.RemovePostMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRemovePost.RemovePostMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRemovePost.t.Fatal("No results are set for the StorageMock.RemovePost")
+		}
+		return (*mm_results).err
+	}
+	if mmRemovePost.funcRemovePost != nil {
+		return mmRemovePost.funcRemovePost(ctx, id)
+	}
+	mmRemovePost.t.Fatalf("Unexpected call to StorageMock.RemovePost. %v %v", ctx, id)
+	return
+}
+
+// RemovePostAfterCounter returns a count of finished StorageMock.RemovePost invocations
+func (mmRemovePost *StorageMock) RemovePostAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRemovePost.afterRemovePostCounter)
+}
+
+// RemovePostBeforeCounter returns a count of StorageMock.RemovePost invocations
+func (mmRemovePost *StorageMock) RemovePostBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRemovePost.beforeRemovePostCounter)
+}
+
+// Calls returns a list of arguments used in each call to StorageMock.RemovePost.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRemovePost *mStorageMockRemovePost) Calls() []*StorageMockRemovePostParams {
+	mmRemovePost.mutex.RLock()
+
+	argCopy := make([]*StorageMockRemovePostParams, len(mmRemovePost.callArgs))
+	copy(argCopy, mmRemovePost.callArgs)
+
+	mmRemovePost.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRemovePostDone returns true if the count of the RemovePost invocations corresponds
+// the number of defined expectations
+func (m *StorageMock) MinimockRemovePostDone() bool {
+	if m.RemovePostMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RemovePostMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RemovePostMock.invocationsDone()
+}
+
+// MinimockRemovePostInspect logs each unmet expectation
+func (m *StorageMock) MinimockRemovePostInspect() {
+	for _, e := range m.RemovePostMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to StorageMock.RemovePost at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterRemovePostCounter := mm_atomic.LoadUint64(&m.afterRemovePostCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RemovePostMock.defaultExpectation != nil && afterRemovePostCounter < 1 {
+		if m.RemovePostMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to StorageMock.RemovePost at\n%s", m.RemovePostMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to StorageMock.RemovePost at\n%s with params: %#v", m.RemovePostMock.defaultExpectation.expectationOrigins.origin, *m.RemovePostMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRemovePost != nil && afterRemovePostCounter < 1 {
+		m.t.Errorf("Expected call to StorageMock.RemovePost at\n%s", m.funcRemovePostOrigin)
+	}
+
+	if !m.RemovePostMock.invocationsDone() && afterRemovePostCounter > 0 {
+		m.t.Errorf("Expected %d calls to StorageMock.RemovePost at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.RemovePostMock.expectedInvocations), m.RemovePostMock.expectedInvocationsOrigin, afterRemovePostCounter)
+	}
+}
+
+type mStorageMockReopenReport struct {
+	optional           bool
+	mock               *StorageMock
+	defaultExpectation *StorageMockReopenReportExpectation
+	expectations       []*StorageMockReopenReportExpectation
+
+	callArgs []*StorageMockReopenReportParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// StorageMockReopenReportExpectation specifies expectation struct of the Storage.ReopenReport
+type StorageMockReopenReportExpectation struct {
+	mock               *StorageMock
+	params             *StorageMockReopenReportParams
+	paramPtrs          *StorageMockReopenReportParamPtrs
+	expectationOrigins StorageMockReopenReportExpectationOrigins
+	results            *StorageMockReopenReportResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// StorageMockReopenReportParams contains parameters of the Storage.ReopenReport
+type StorageMockReopenReportParams struct {
+	ctx    context.Context
+	id     string
+	from   model.ReportStatus
+	change *model.ReportStatusChange
+}
+
+// StorageMockReopenReportParamPtrs contains pointers to parameters of the Storage.ReopenReport
+type StorageMockReopenReportParamPtrs struct {
+	ctx    *context.Context
+	id     *string
+	from   *model.ReportStatus
+	change **model.ReportStatusChange
+}
+
+// StorageMockReopenReportResults contains results of the Storage.ReopenReport
+type StorageMockReopenReportResults struct {
+	err error
+}
+
+// StorageMockReopenReportOrigins contains origins of expectations of the Storage.ReopenReport
+type StorageMockReopenReportExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originId     string
+	originFrom   string
+	originChange string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmReopenReport *mStorageMockReopenReport) Optional() *mStorageMockReopenReport {
+	mmReopenReport.optional = true
+	return mmReopenReport
+}
+
+// Expect sets up expected params for Storage.ReopenReport
+func (mmReopenReport *mStorageMockReopenReport) Expect(ctx context.Context, id string, from model.ReportStatus, change *model.ReportStatusChange) *mStorageMockReopenReport {
+	if mmReopenReport.mock.funcReopenReport != nil {
+		mmReopenReport.mock.t.Fatalf("StorageMock.ReopenReport mock is already set by Set")
+	}
+
+	if mmReopenReport.defaultExpectation == nil {
+		mmReopenReport.defaultExpectation = &StorageMockReopenReportExpectation{}
+	}
+
+	if mmReopenReport.defaultExpectation.paramPtrs != nil {
+		mmReopenReport.mock.t.Fatalf("StorageMock.ReopenReport mock is already set by ExpectParams functions")
+	}
+
+	mmReopenReport.defaultExpectation.params = &StorageMockReopenReportParams{ctx, id, from, change}
+	mmReopenReport.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmReopenReport.expectations {
+		if minimock.Equal(e.params, mmReopenReport.defaultExpectation.params) {
+			mmReopenReport.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmReopenReport.defaultExpectation.params)
+		}
+	}
+
+	return mmReopenReport
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Storage.ReopenReport
+func (mmReopenReport *mStorageMockReopenReport) ExpectCtxParam1(ctx context.Context) *mStorageMockReopenReport {
+	if mmReopenReport.mock.funcReopenReport != nil {
+		mmReopenReport.mock.t.Fatalf("StorageMock.ReopenReport mock is already set by Set")
+	}
+
+	if mmReopenReport.defaultExpectation == nil {
+		mmReopenReport.defaultExpectation = &StorageMockReopenReportExpectation{}
+	}
+
+	if mmReopenReport.defaultExpectation.params != nil {
+		mmReopenReport.mock.t.Fatalf("StorageMock.ReopenReport mock is already set by Expect")
+	}
+
+	if mmReopenReport.defaultExpectation.paramPtrs == nil {
+		mmReopenReport.defaultExpectation.paramPtrs = &StorageMockReopenReportParamPtrs{}
+	}
+	mmReopenReport.defaultExpectation.paramPtrs.ctx = &ctx
+	mmReopenReport.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmReopenReport
+}
+
+// ExpectIdParam2 sets up expected param id for Storage.ReopenReport
+func (mmReopenReport *mStorageMockReopenReport) ExpectIdParam2(id string) *mStorageMockReopenReport {
+	if mmReopenReport.mock.funcReopenReport != nil {
+		mmReopenReport.mock.t.Fatalf("StorageMock.ReopenReport mock is already set by Set")
+	}
+
+	if mmReopenReport.defaultExpectation == nil {
+		mmReopenReport.defaultExpectation = &StorageMockReopenReportExpectation{}
+	}
+
+	if mmReopenReport.defaultExpectation.params != nil {
+		mmReopenReport.mock.t.Fatalf("StorageMock.ReopenReport mock is already set by Expect")
+	}
+
+	if mmReopenReport.defaultExpectation.paramPtrs == nil {
+		mmReopenReport.defaultExpectation.paramPtrs = &StorageMockReopenReportParamPtrs{}
+	}
+	mmReopenReport.defaultExpectation.paramPtrs.id = &id
+	mmReopenReport.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmReopenReport
+}
+
+// ExpectFromParam3 sets up expected param from for Storage.ReopenReport
+func (mmReopenReport *mStorageMockReopenReport) ExpectFromParam3(from model.ReportStatus) *mStorageMockReopenReport {
+	if mmReopenReport.mock.funcReopenReport != nil {
+		mmReopenReport.mock.t.Fatalf("StorageMock.ReopenReport mock is already set by Set")
+	}
+
+	if mmReopenReport.defaultExpectation == nil {
+		mmReopenReport.defaultExpectation = &StorageMockReopenReportExpectation{}
+	}
+
+	if mmReopenReport.defaultExpectation.params != nil {
+		mmReopenReport.mock.t.Fatalf("StorageMock.ReopenReport mock is already set by Expect")
+	}
+
+	if mmReopenReport.defaultExpectation.paramPtrs == nil {
+		mmReopenReport.defaultExpectation.paramPtrs = &StorageMockReopenReportParamPtrs{}
+	}
+	mmReopenReport.defaultExpectation.paramPtrs.from = &from
+	mmReopenReport.defaultExpectation.expectationOrigins.originFrom = minimock.CallerInfo(1)
+
+	return mmReopenReport
+}
+
+// ExpectChangeParam4 sets up expected param change for Storage.ReopenReport
+func (mmReopenReport *mStorageMockReopenReport) ExpectChangeParam4(change *model.ReportStatusChange) *mStorageMockReopenReport {
+	if mmReopenReport.mock.funcReopenReport != nil {
+		mmReopenReport.mock.t.Fatalf("StorageMock.ReopenReport mock is already set by Set")
+	}
+
+	if mmReopenReport.defaultExpectation == nil {
+		mmReopenReport.defaultExpectation = &StorageMockReopenReportExpectation{}
+	}
+
+	if mmReopenReport.defaultExpectation.params != nil {
+		mmReopenReport.mock.t.Fatalf("StorageMock.ReopenReport mock is already set by Expect")
+	}
+
+	if mmReopenReport.defaultExpectation.paramPtrs == nil {
+		mmReopenReport.defaultExpectation.paramPtrs = &StorageMockReopenReportParamPtrs{}
+	}
+	mmReopenReport.defaultExpectation.paramPtrs.change = &change
+	mmReopenReport.defaultExpectation.expectationOrigins.originChange = minimock.CallerInfo(1)
+
+	return mmReopenReport
+}
+
+// Inspect accepts an inspector function that has same arguments as the Storage.ReopenReport
+func (mmReopenReport *mStorageMockReopenReport) Inspect(f func(ctx context.Context, id string, from model.ReportStatus, change *model.ReportStatusChange)) *mStorageMockReopenReport {
+	if mmReopenReport.mock.inspectFuncReopenReport != nil {
+		mmReopenReport.mock.t.Fatalf("Inspect function is already set for StorageMock.ReopenReport")
+	}
+
+	mmReopenReport.mock.inspectFuncReopenReport = f
+
+	return mmReopenReport
+}
+
+// Return sets up results that will be returned by Storage.ReopenReport
+func (mmReopenReport *mStorageMockReopenReport) Return(err error) *StorageMock {
+	if mmReopenReport.mock.funcReopenReport != nil {
+		mmReopenReport.mock.t.Fatalf("StorageMock.ReopenReport mock is already set by Set")
+	}
+
+	if mmReopenReport.defaultExpectation == nil {
+		mmReopenReport.defaultExpectation = &StorageMockReopenReportExpectation{mock: mmReopenReport.mock}
+	}
+	mmReopenReport.defaultExpectation.results = &StorageMockReopenReportResults{err}
+	mmReopenReport.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmReopenReport.mock
+}
+
+// Set uses given function f to mock the Storage.ReopenReport method
+func (mmReopenReport *mStorageMockReopenReport) Set(f func(ctx context.Context, id string, from model.ReportStatus, change *model.ReportStatusChange) (err error)) *StorageMock {
+	if mmReopenReport.defaultExpectation != nil {
+		mmReopenReport.mock.t.Fatalf("Default expectation is already set for the Storage.ReopenReport method")
+	}
+
+	if len(mmReopenReport.expectations) > 0 {
+		mmReopenReport.mock.t.Fatalf("Some expectations are already set for the Storage.ReopenReport method")
+	}
+
+	mmReopenReport.mock.funcReopenReport = f
+	mmReopenReport.mock.funcReopenReportOrigin = minimock.CallerInfo(1)
+	return mmReopenReport.mock
+}
+
+// When sets expectation for the Storage.ReopenReport which will trigger the result defined by the following
+// Then helper
+func (mmReopenReport *mStorageMockReopenReport) When(ctx context.Context, id string, from model.ReportStatus, change *model.ReportStatusChange) *StorageMockReopenReportExpectation {
+	if mmReopenReport.mock.funcReopenReport != nil {
+		mmReopenReport.mock.t.Fatalf("StorageMock.ReopenReport mock is already set by Set")
+	}
+
+	expectation := &StorageMockReopenReportExpectation{
+		mock:               mmReopenReport.mock,
+		params:             &StorageMockReopenReportParams{ctx, id, from, change},
+		expectationOrigins: StorageMockReopenReportExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmReopenReport.expectations = append(mmReopenReport.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Storage.ReopenReport return parameters for the expectation previously defined by the When method
+func (e *StorageMockReopenReportExpectation) Then(err error) *StorageMock {
+	e.results = &StorageMockReopenReportResults{err}
+	return e.mock
+}
+
+// Times sets number of times Storage.ReopenReport should be invoked
+func (mmReopenReport *mStorageMockReopenReport) Times(n uint64) *mStorageMockReopenReport {
+	if n == 0 {
+		mmReopenReport.mock.t.Fatalf("Times of StorageMock.ReopenReport mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmReopenReport.expectedInvocations, n)
+	mmReopenReport.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmReopenReport
+}
+
+func (mmReopenReport *mStorageMockReopenReport) invocationsDone() bool {
+	if len(mmReopenReport.expectations) == 0 && mmReopenReport.defaultExpectation == nil && mmReopenReport.mock.funcReopenReport == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmReopenReport.mock.afterReopenReportCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmReopenReport.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ReopenReport implements mm_storage.Storage
+func (mmReopenReport *StorageMock) ReopenReport(ctx context.Context, id string, from model.ReportStatus, change *model.ReportStatusChange) (err error) {
+	mm_atomic.AddUint64(&mmReopenReport.beforeReopenReportCounter, 1)
+	defer mm_atomic.AddUint64(&mmReopenReport.afterReopenReportCounter, 1)
+
+	mmReopenReport.t.Helper()
+
+	if mmReopenReport.inspectFuncReopenReport != nil {
+		mmReopenReport.inspectFuncReopenReport(ctx, id, from, change)
+	}
+
+	mm_params := StorageMockReopenReportParams{ctx, id, from, change}
+
+	// Record call args
+	mmReopenReport.ReopenReportMock.mutex.Lock()
+	mmReopenReport.ReopenReportMock.callArgs = append(mmReopenReport.ReopenReportMock.callArgs, &mm_params)
+	mmReopenReport.ReopenReportMock.mutex.Unlock()
+
+	for _, e := range mmReopenReport.ReopenReportMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmReopenReport.ReopenReportMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmReopenReport.ReopenReportMock.defaultExpectation.Counter, 1)
+		mm_want := mmReopenReport.ReopenReportMock.defaultExpectation.params
+		mm_want_ptrs := mmReopenReport.ReopenReportMock.defaultExpectation.paramPtrs
+
+		mm_got := StorageMockReopenReportParams{ctx, id, from, change}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmReopenReport.t.Errorf("StorageMock.ReopenReport got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmReopenReport.ReopenReportMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmReopenReport.t.Errorf("StorageMock.ReopenReport got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmReopenReport.ReopenReportMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+			if mm_want_ptrs.from != nil && !minimock.Equal(*mm_want_ptrs.from, mm_got.from) {
+				mmReopenReport.t.Errorf("StorageMock.ReopenReport got unexpected parameter from, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmReopenReport.ReopenReportMock.defaultExpectation.expectationOrigins.originFrom, *mm_want_ptrs.from, mm_got.from, minimock.Diff(*mm_want_ptrs.from, mm_got.from))
+			}
+
+			if mm_want_ptrs.change != nil && !minimock.Equal(*mm_want_ptrs.change, mm_got.change) {
+				mmReopenReport.t.Errorf("StorageMock.ReopenReport got unexpected parameter change, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmReopenReport.ReopenReportMock.defaultExpectation.expectationOrigins.originChange, *mm_want_ptrs.change, mm_got.change, minimock.Diff(*mm_want_ptrs.change, mm_got.change))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmReopenReport.t.Errorf("StorageMock.ReopenReport got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmReopenReport.ReopenReportMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmReopenReport.ReopenReportMock.defaultExpectation.results
+		if mm_results == nil {
+			mmReopenReport.t.Fatal("No results are set for the StorageMock.ReopenReport")
+		}
+		return (*mm_results).err
+	}
+	if mmReopenReport.funcReopenReport != nil {
+		return mmReopenReport.funcReopenReport(ctx, id, from, change)
+	}
+	mmReopenReport.t.Fatalf("Unexpected call to StorageMock.ReopenReport. %v %v %v %v", ctx, id, from, change)
+	return
+}
+
+// ReopenReportAfterCounter returns a count of finished StorageMock.ReopenReport invocations
+func (mmReopenReport *StorageMock) ReopenReportAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmReopenReport.afterReopenReportCounter)
+}
+
+// ReopenReportBeforeCounter returns a count of StorageMock.ReopenReport invocations
+func (mmReopenReport *StorageMock) ReopenReportBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmReopenReport.beforeReopenReportCounter)
+}
+
+// Calls returns a list of arguments used in each call to StorageMock.ReopenReport.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmReopenReport *mStorageMockReopenReport) Calls() []*StorageMockReopenReportParams {
+	mmReopenReport.mutex.RLock()
+
+	argCopy := make([]*StorageMockReopenReportParams, len(mmReopenReport.callArgs))
+	copy(argCopy, mmReopenReport.callArgs)
+
+	mmReopenReport.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockReopenReportDone returns true if the count of the ReopenReport invocations corresponds
+// the number of defined expectations
+func (m *StorageMock) MinimockReopenReportDone() bool {
+	if m.ReopenReportMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ReopenReportMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ReopenReportMock.invocationsDone()
+}
+
+// MinimockReopenReportInspect logs each unmet expectation
+func (m *StorageMock) MinimockReopenReportInspect() {
+	for _, e := range m.ReopenReportMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to StorageMock.ReopenReport at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterReopenReportCounter := mm_atomic.LoadUint64(&m.afterReopenReportCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ReopenReportMock.defaultExpectation != nil && afterReopenReportCounter < 1 {
+		if m.ReopenReportMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to StorageMock.ReopenReport at\n%s", m.ReopenReportMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to StorageMock.ReopenReport at\n%s with params: %#v", m.ReopenReportMock.defaultExpectation.expectationOrigins.origin, *m.ReopenReportMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcReopenReport != nil && afterReopenReportCounter < 1 {
+		m.t.Errorf("Expected call to StorageMock.ReopenReport at\n%s", m.funcReopenReportOrigin)
+	}
+
+	if !m.ReopenReportMock.invocationsDone() && afterReopenReportCounter > 0 {
+		m.t.Errorf("Expected %d calls to StorageMock.ReopenReport at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ReopenReportMock.expectedInvocations), m.ReopenReportMock.expectedInvocationsOrigin, afterReopenReportCounter)
+	}
+}
+
+type mStorageMockToggleComments struct {
+	optional           bool
+	mock               *StorageMock
+	defaultExpectation *StorageMockToggleCommentsExpectation
+	expectations       []*StorageMockToggleCommentsExpectation
+
+	callArgs []*StorageMockToggleCommentsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// StorageMockToggleCommentsExpectation specifies expectation struct of the Storage.ToggleComments
+type StorageMockToggleCommentsExpectation struct {
+	mock               *StorageMock
+	params             *StorageMockToggleCommentsParams
+	paramPtrs          *StorageMockToggleCommentsParamPtrs
+	expectationOrigins StorageMockToggleCommentsExpectationOrigins
+	results            *StorageMockToggleCommentsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// StorageMockToggleCommentsParams contains parameters of the Storage.ToggleComments
+type StorageMockToggleCommentsParams struct {
+	ctx     context.Context
+	postID  string
+	enabled bool
+	author  string
+}
+
+// StorageMockToggleCommentsParamPtrs contains pointers to parameters of the Storage.ToggleComments
+type StorageMockToggleCommentsParamPtrs struct {
+	ctx     *context.Context
+	postID  *string
+	enabled *bool
+	author  *string
+}
+
+// StorageMockToggleCommentsResults contains results of the Storage.ToggleComments
+type StorageMockToggleCommentsResults struct {
+	pp1 *model.Post
+	err error
+}
+
+// StorageMockToggleCommentsOrigins contains origins of expectations of the Storage.ToggleComments
+type StorageMockToggleCommentsExpectationOrigins struct {
+	origin        string
+	originCtx     string
+	originPostID  string
+	originEnabled string
+	originAuthor  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmToggleComments *mStorageMockToggleComments) Optional() *mStorageMockToggleComments {
+	mmToggleComments.optional = true
+	return mmToggleComments
+}
+
+// Expect sets up expected params for Storage.ToggleComments
+func (mmToggleComments *mStorageMockToggleComments) Expect(ctx context.Context, postID string, enabled bool, author string) *mStorageMockToggleComments {
+	if mmToggleComments.mock.funcToggleComments != nil {
+		mmToggleComments.mock.t.Fatalf("StorageMock.ToggleComments mock is already set by Set")
+	}
+
+	if mmToggleComments.defaultExpectation == nil {
+		mmToggleComments.defaultExpectation = &StorageMockToggleCommentsExpectation{}
+	}
+
+	if mmToggleComments.defaultExpectation.paramPtrs != nil {
+		mmToggleComments.mock.t.Fatalf("StorageMock.ToggleComments mock is already set by ExpectParams functions")
+	}
+
+	mmToggleComments.defaultExpectation.params = &StorageMockToggleCommentsParams{ctx, postID, enabled, author}
+	mmToggleComments.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmToggleComments.expectations {
+		if minimock.Equal(e.params, mmToggleComments.defaultExpectation.params) {
+			mmToggleComments.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmToggleComments.defaultExpectation.params)
+		}
+	}
+
+	return mmToggleComments
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Storage.ToggleComments
+func (mmToggleComments *mStorageMockToggleComments) ExpectCtxParam1(ctx context.Context) *mStorageMockToggleComments {
+	if mmToggleComments.mock.funcToggleComments != nil {
+		mmToggleComments.mock.t.Fatalf("StorageMock.ToggleComments mock is already set by Set")
+	}
+
+	if mmToggleComments.defaultExpectation == nil {
+		mmToggleComments.defaultExpectation = &StorageMockToggleCommentsExpectation{}
+	}
+
+	if mmToggleComments.defaultExpectation.params != nil {
+		mmToggleComments.mock.t.Fatalf("StorageMock.ToggleComments mock is already set by Expect")
+	}
+
+	if mmToggleComments.defaultExpectation.paramPtrs == nil {
+		mmToggleComments.defaultExpectation.paramPtrs = &StorageMockToggleCommentsParamPtrs{}
+	}
+	mmToggleComments.defaultExpectation.paramPtrs.ctx = &ctx
+	mmToggleComments.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmToggleComments
+}
+
+// ExpectPostIDParam2 sets up expected param postID for Storage.ToggleComments
+func (mmToggleComments *mStorageMockToggleComments) ExpectPostIDParam2(postID string) *mStorageMockToggleComments {
+	if mmToggleComments.mock.funcToggleComments != nil {
+		mmToggleComments.mock.t.Fatalf("StorageMock.ToggleComments mock is already set by Set")
+	}
+
+	if mmToggleComments.defaultExpectation == nil {
+		mmToggleComments.defaultExpectation = &StorageMockToggleCommentsExpectation{}
+	}
+
+	if mmToggleComments.defaultExpectation.params != nil {
+		mmToggleComments.mock.t.Fatalf("StorageMock.ToggleComments mock is already set by Expect")
+	}
+
+	if mmToggleComments.defaultExpectation.paramPtrs == nil {
+		mmToggleComments.defaultExpectation.paramPtrs = &StorageMockToggleCommentsParamPtrs{}
+	}
+	mmToggleComments.defaultExpectation.paramPtrs.postID = &postID
+	mmToggleComments.defaultExpectation.expectationOrigins.originPostID = minimock.CallerInfo(1)
+
+	return mmToggleComments
+}
+
+// ExpectEnabledParam3 sets up expected param enabled for Storage.ToggleComments
+func (mmToggleComments *mStorageMockToggleComments) ExpectEnabledParam3(enabled bool) *mStorageMockToggleComments {
+	if mmToggleComments.mock.funcToggleComments != nil {
+		mmToggleComments.mock.t.Fatalf("StorageMock.ToggleComments mock is already set by Set")
+	}
+
+	if mmToggleComments.defaultExpectation == nil {
+		mmToggleComments.defaultExpectation = &StorageMockToggleCommentsExpectation{}
+	}
+
+	if mmToggleComments.defaultExpectation.params != nil {
+		mmToggleComments.mock.t.Fatalf("StorageMock.ToggleComments mock is already set by Expect")
+	}
+
+	if mmToggleComments.defaultExpectation.paramPtrs == nil {
+		mmToggleComments.defaultExpectation.paramPtrs = &StorageMockToggleCommentsParamPtrs{}
+	}
+	mmToggleComments.defaultExpectation.paramPtrs.enabled = &enabled
+	mmToggleComments.defaultExpectation.expectationOrigins.originEnabled = minimock.CallerInfo(1)
+
+	return mmToggleComments
+}
+
+// ExpectAuthorParam4 sets up expected param author for Storage.ToggleComments
+func (mmToggleComments *mStorageMockToggleComments) ExpectAuthorParam4(author string) *mStorageMockToggleComments {
+	if mmToggleComments.mock.funcToggleComments != nil {
+		mmToggleComments.mock.t.Fatalf("StorageMock.ToggleComments mock is already set by Set")
+	}
+
+	if mmToggleComments.defaultExpectation == nil {
+		mmToggleComments.defaultExpectation = &StorageMockToggleCommentsExpectation{}
+	}
+
+	if mmToggleComments.defaultExpectation.params != nil {
+		mmToggleComments.mock.t.Fatalf("StorageMock.ToggleComments mock is already set by Expect")
+	}
+
+	if mmToggleComments.defaultExpectation.paramPtrs == nil {
+		mmToggleComments.defaultExpectation.paramPtrs = &StorageMockToggleCommentsParamPtrs{}
+	}
+	mmToggleComments.defaultExpectation.paramPtrs.author = &author
+	mmToggleComments.defaultExpectation.expectationOrigins.originAuthor = minimock.CallerInfo(1)
+
+	return mmToggleComments
+}
+
+// Inspect accepts an inspector function that has same arguments as the Storage.ToggleComments
+func (mmToggleComments *mStorageMockToggleComments) Inspect(f func(ctx context.Context, postID string, enabled bool, author string)) *mStorageMockToggleComments {
+	if mmToggleComments.mock.inspectFuncToggleComments != nil {
+		mmToggleComments.mock.t.Fatalf("Inspect function is already set for StorageMock.ToggleComments")
+	}
+
+	mmToggleComments.mock.inspectFuncToggleComments = f
+
+	return mmToggleComments
+}
+
+// Return sets up results that will be returned by Storage.ToggleComments
+func (mmToggleComments *mStorageMockToggleComments) Return(pp1 *model.Post, err error) *StorageMock {
+	if mmToggleComments.mock.funcToggleComments != nil {
+		mmToggleComments.mock.t.Fatalf("StorageMock.ToggleComments mock is already set by Set")
+	}
+
+	if mmToggleComments.defaultExpectation == nil {
+		mmToggleComments.defaultExpectation = &StorageMockToggleCommentsExpectation{mock: mmToggleComments.mock}
+	}
+	mmToggleComments.defaultExpectation.results = &StorageMockToggleCommentsResults{pp1, err}
+	mmToggleComments.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmToggleComments.mock
+}
+
+// Set uses given function f to mock the Storage.ToggleComments method
+func (mmToggleComments *mStorageMockToggleComments) Set(f func(ctx context.Context, postID string, enabled bool, author string) (pp1 *model.Post, err error)) *StorageMock {
+	if mmToggleComments.defaultExpectation != nil {
+		mmToggleComments.mock.t.Fatalf("Default expectation is already set for the Storage.ToggleComments method")
+	}
+
+	if len(mmToggleComments.expectations) > 0 {
+		mmToggleComments.mock.t.Fatalf("Some expectations are already set for the Storage.ToggleComments method")
+	}
+
+	mmToggleComments.mock.funcToggleComments = f
+	mmToggleComments.mock.funcToggleCommentsOrigin = minimock.CallerInfo(1)
+	return mmToggleComments.mock
+}
+
+// When sets expectation for the Storage.ToggleComments which will trigger the result defined by the following
+// Then helper
+func (mmToggleComments *mStorageMockToggleComments) When(ctx context.Context, postID string, enabled bool, author string) *StorageMockToggleCommentsExpectation {
+	if mmToggleComments.mock.funcToggleComments != nil {
+		mmToggleComments.mock.t.Fatalf("StorageMock.ToggleComments mock is already set by Set")
+	}
+
+	expectation := &StorageMockToggleCommentsExpectation{
+		mock:               mmToggleComments.mock,
+		params:             &StorageMockToggleCommentsParams{ctx, postID, enabled, author},
+		expectationOrigins: StorageMockToggleCommentsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmToggleComments.expectations = append(mmToggleComments.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Storage.ToggleComments return parameters for the expectation previously defined by the When method
+func (e *StorageMockToggleCommentsExpectation) Then(pp1 *model.Post, err error) *StorageMock {
+	e.results = &StorageMockToggleCommentsResults{pp1, err}
+	return e.mock
+}
+
+// Times sets number of times Storage.ToggleComments should be invoked
+func (mmToggleComments *mStorageMockToggleComments) Times(n uint64) *mStorageMockToggleComments {
+	if n == 0 {
+		mmToggleComments.mock.t.Fatalf("Times of StorageMock.ToggleComments mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmToggleComments.expectedInvocations, n)
+	mmToggleComments.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmToggleComments
+}
+
+func (mmToggleComments *mStorageMockToggleComments) invocationsDone() bool {
+	if len(mmToggleComments.expectations) == 0 && mmToggleComments.defaultExpectation == nil && mmToggleComments.mock.funcToggleComments == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmToggleComments.mock.afterToggleCommentsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmToggleComments.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ToggleComments implements mm_storage.Storage
+func (mmToggleComments *StorageMock) ToggleComments(ctx context.Context, postID string, enabled bool, author string) (pp1 *model.Post, err error) {
+	mm_atomic.AddUint64(&mmToggleComments.beforeToggleCommentsCounter, 1)
+	defer mm_atomic.AddUint64(&mmToggleComments.afterToggleCommentsCounter, 1)
+
+	mmToggleComments.t.Helper()
+
+	if mmToggleComments.inspectFuncToggleComments != nil {
+		mmToggleComments.inspectFuncToggleComments(ctx, postID, enabled, author)
+	}
+
+	mm_params := StorageMockToggleCommentsParams{ctx, postID, enabled, author}
+
+	// Record call args
+	mmToggleComments.ToggleCommentsMock.mutex.Lock()
+	mmToggleComments.ToggleCommentsMock.callArgs = append(mmToggleComments.ToggleCommentsMock.callArgs, &mm_params)
+	mmToggleComments.ToggleCommentsMock.mutex.Unlock()
+
+	for _, e := range mmToggleComments.ToggleCommentsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.pp1, e.results.err
+		}
+	}
+
+	if mmToggleComments.ToggleCommentsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmToggleComments.ToggleCommentsMock.defaultExpectation.Counter, 1)
+		mm_want := mmToggleComments.ToggleCommentsMock.defaultExpectation.params
+		mm_want_ptrs := mmToggleComments.ToggleCommentsMock.defaultExpectation.paramPtrs
+
+		mm_got := StorageMockToggleCommentsParams{ctx, postID, enabled, author}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmToggleComments.t.Errorf("StorageMock.ToggleComments got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmToggleComments.ToggleCommentsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.postID != nil && !minimock.Equal(*mm_want_ptrs.postID, mm_got.postID) {
+				mmToggleComments.t.Errorf("StorageMock.ToggleComments got unexpected parameter postID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmToggleComments.ToggleCommentsMock.defaultExpectation.expectationOrigins.originPostID, *mm_want_ptrs.postID, mm_got.postID, minimock.Diff(*mm_want_ptrs.postID, mm_got.postID))
+			}
+
+			if mm_want_ptrs.enabled != nil && !minimock.Equal(*mm_want_ptrs.enabled, mm_got.enabled) {
+				mmToggleComments.t.Errorf("StorageMock.ToggleComments got unexpected parameter enabled, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmToggleComments.ToggleCommentsMock.defaultExpectation.expectationOrigins.originEnabled, *mm_want_ptrs.enabled, mm_got.enabled, minimock.Diff(*mm_want_ptrs.enabled, mm_got.enabled))
+			}
+
+			if mm_want_ptrs.author != nil && !minimock.Equal(*mm_want_ptrs.author, mm_got.author) {
+				mmToggleComments.t.Errorf("StorageMock.ToggleComments got unexpected parameter author, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmToggleComments.ToggleCommentsMock.defaultExpectation.expectationOrigins.originAuthor, *mm_want_ptrs.author, mm_got.author, minimock.Diff(*mm_want_ptrs.author, mm_got.author))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmToggleComments.t.Errorf("StorageMock.ToggleComments got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmToggleComments.ToggleCommentsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmToggleComments.ToggleCommentsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmToggleComments.t.Fatal("No results are set for the StorageMock.ToggleComments")
+		}
+		return (*mm_results).pp1, (*mm_results).err
+	}
+	if mmToggleComments.funcToggleComments != nil {
+		return mmToggleComments.funcToggleComments(ctx, postID, enabled, author)
+	}
+	mmToggleComments.t.Fatalf("Unexpected call to StorageMock.ToggleComments. %v %v %v %v", ctx, postID, enabled, author)
+	return
+}
+
+// ToggleCommentsAfterCounter returns a count of finished StorageMock.ToggleComments invocations
+func (mmToggleComments *StorageMock) ToggleCommentsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmToggleComments.afterToggleCommentsCounter)
+}
+
+// ToggleCommentsBeforeCounter returns a count of StorageMock.ToggleComments invocations
+func (mmToggleComments *StorageMock) ToggleCommentsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmToggleComments.beforeToggleCommentsCounter)
+}
+
+// Calls returns a list of arguments used in each call to StorageMock.ToggleComments.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmToggleComments *mStorageMockToggleComments) Calls() []*StorageMockToggleCommentsParams {
+	mmToggleComments.mutex.RLock()
 
-	argCopy := make([]*StorageMockGetPostsParams, len(mmGetPosts.callArgs))
-	copy(argCopy, mmGetPosts.callArgs)
+	argCopy := make([]*StorageMockToggleCommentsParams, len(mmToggleComments.callArgs))
+	copy(argCopy, mmToggleComments.callArgs)
 
-	mmGetPosts.mutex.RUnlock()
+	mmToggleComments.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockGetPostsDone returns true if the count of the GetPosts invocations corresponds
+// MinimockToggleCommentsDone returns true if the count of the ToggleComments invocations corresponds
 // the number of defined expectations
-func (m *StorageMock) MinimockGetPostsDone() bool {
-	if m.GetPostsMock.optional {
+func (m *StorageMock) MinimockToggleCommentsDone() bool {
+	if m.ToggleCommentsMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.GetPostsMock.expectations {
+	for _, e := range m.ToggleCommentsMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.GetPostsMock.invocationsDone()
+	return m.ToggleCommentsMock.invocationsDone()
 }
 
-// MinimockGetPostsInspect logs each unmet expectation
-func (m *StorageMock) MinimockGetPostsInspect() {
-	for _, e := range m.GetPostsMock.expectations {
+// MinimockToggleCommentsInspect logs each unmet expectation
+func (m *StorageMock) MinimockToggleCommentsInspect() {
+	for _, e := range m.ToggleCommentsMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to StorageMock.GetPosts at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to StorageMock.ToggleComments at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterGetPostsCounter := mm_atomic.LoadUint64(&m.afterGetPostsCounter)
+	afterToggleCommentsCounter := mm_atomic.LoadUint64(&m.afterToggleCommentsCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.GetPostsMock.defaultExpectation != nil && afterGetPostsCounter < 1 {
-		if m.GetPostsMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to StorageMock.GetPosts at\n%s", m.GetPostsMock.defaultExpectation.returnOrigin)
+	if m.ToggleCommentsMock.defaultExpectation != nil && afterToggleCommentsCounter < 1 {
+		if m.ToggleCommentsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to StorageMock.ToggleComments at\n%s", m.ToggleCommentsMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to StorageMock.GetPosts at\n%s with params: %#v", m.GetPostsMock.defaultExpectation.expectationOrigins.origin, *m.GetPostsMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to StorageMock.ToggleComments at\n%s with params: %#v", m.ToggleCommentsMock.defaultExpectation.expectationOrigins.origin, *m.ToggleCommentsMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcGetPosts != nil && afterGetPostsCounter < 1 {
-		m.t.Errorf("Expected call to StorageMock.GetPosts at\n%s", m.funcGetPostsOrigin)
+	if m.funcToggleComments != nil && afterToggleCommentsCounter < 1 {
+		m.t.Errorf("Expected call to StorageMock.ToggleComments at\n%s", m.funcToggleCommentsOrigin)
 	}
 
-	if !m.GetPostsMock.invocationsDone() && afterGetPostsCounter > 0 {
-		m.t.Errorf("Expected %d calls to StorageMock.GetPosts at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.GetPostsMock.expectedInvocations), m.GetPostsMock.expectedInvocationsOrigin, afterGetPostsCounter)
+	if !m.ToggleCommentsMock.invocationsDone() && afterToggleCommentsCounter > 0 {
+		m.t.Errorf("Expected %d calls to StorageMock.ToggleComments at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ToggleCommentsMock.expectedInvocations), m.ToggleCommentsMock.expectedInvocationsOrigin, afterToggleCommentsCounter)
 	}
 }
 
-type mStorageMockGetReplies struct {
+type mStorageMockUpdateComment struct {
 	optional           bool
 	mock               *StorageMock
-	defaultExpectation *StorageMockGetRepliesExpectation
-	expectations       []*StorageMockGetRepliesExpectation
+	defaultExpectation *StorageMockUpdateCommentExpectation
+	expectations       []*StorageMockUpdateCommentExpectation
 
-	callArgs []*StorageMockGetRepliesParams
+	callArgs []*StorageMockUpdateCommentParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// StorageMockGetRepliesExpectation specifies expectation struct of the Storage.GetReplies
-type StorageMockGetRepliesExpectation struct {
+// StorageMockUpdateCommentExpectation specifies expectation struct of the Storage.UpdateComment
+type StorageMockUpdateCommentExpectation struct {
 	mock               *StorageMock
-	params             *StorageMockGetRepliesParams
-	paramPtrs          *StorageMockGetRepliesParamPtrs
-	expectationOrigins StorageMockGetRepliesExpectationOrigins
-	results            *StorageMockGetRepliesResults
+	params             *StorageMockUpdateCommentParams
+	paramPtrs          *StorageMockUpdateCommentParamPtrs
+	expectationOrigins StorageMockUpdateCommentExpectationOrigins
+	results            *StorageMockUpdateCommentResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// StorageMockGetRepliesParams contains parameters of the Storage.GetReplies
-type StorageMockGetRepliesParams struct {
+// StorageMockUpdateCommentParams contains parameters of the Storage.UpdateComment
+type StorageMockUpdateCommentParams struct {
 	ctx      context.Context
-	parentID string
-	limit    int
-	offset   int
+	id       string
+	content  string
+	editedAt time.Time
 }
 
-// StorageMockGetRepliesParamPtrs contains pointers to parameters of the Storage.GetReplies
-type StorageMockGetRepliesParamPtrs struct {
+// StorageMockUpdateCommentParamPtrs contains pointers to parameters of the Storage.UpdateComment
+type StorageMockUpdateCommentParamPtrs struct {
 	ctx      *context.Context
-	parentID *string
-	limit    *int
-	offset   *int
+	id       *string
+	content  *string
+	editedAt *time.Time
 }
 
-// StorageMockGetRepliesResults contains results of the Storage.GetReplies
-type StorageMockGetRepliesResults struct {
-	cpa1 []*model.Comment
-	err  error
+// StorageMockUpdateCommentResults contains results of the Storage.UpdateComment
+type StorageMockUpdateCommentResults struct {
+	cp1 *model.Comment
+	v1  mm_storage.Visibility
+	err error
 }
 
-// StorageMockGetRepliesOrigins contains origins of expectations of the Storage.GetReplies
-type StorageMockGetRepliesExpectationOrigins struct {
+// StorageMockUpdateCommentOrigins contains origins of expectations of the Storage.UpdateComment
+type StorageMockUpdateCommentExpectationOrigins struct {
 	origin         string
 	originCtx      string
-	originParentID string
-	originLimit    string
-	originOffset   string
+	originId       string
+	originContent  string
+	originEditedAt string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -1907,404 +9135,401 @@ type StorageMockGetRepliesExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmGetReplies *mStorageMockGetReplies) Optional() *mStorageMockGetReplies {
-	mmGetReplies.optional = true
-	return mmGetReplies
+func (mmUpdateComment *mStorageMockUpdateComment) Optional() *mStorageMockUpdateComment {
+	mmUpdateComment.optional = true
+	return mmUpdateComment
 }
 
-// Expect sets up expected params for Storage.GetReplies
-func (mmGetReplies *mStorageMockGetReplies) Expect(ctx context.Context, parentID string, limit int, offset int) *mStorageMockGetReplies {
-	if mmGetReplies.mock.funcGetReplies != nil {
-		mmGetReplies.mock.t.Fatalf("StorageMock.GetReplies mock is already set by Set")
+// Expect sets up expected params for Storage.UpdateComment
+func (mmUpdateComment *mStorageMockUpdateComment) Expect(ctx context.Context, id string, content string, editedAt time.Time) *mStorageMockUpdateComment {
+	if mmUpdateComment.mock.funcUpdateComment != nil {
+		mmUpdateComment.mock.t.Fatalf("StorageMock.UpdateComment mock is already set by Set")
 	}
 
-	if mmGetReplies.defaultExpectation == nil {
-		mmGetReplies.defaultExpectation = &StorageMockGetRepliesExpectation{}
+	if mmUpdateComment.defaultExpectation == nil {
+		mmUpdateComment.defaultExpectation = &StorageMockUpdateCommentExpectation{}
 	}
 
-	if mmGetReplies.defaultExpectation.paramPtrs != nil {
-		mmGetReplies.mock.t.Fatalf("StorageMock.GetReplies mock is already set by ExpectParams functions")
+	if mmUpdateComment.defaultExpectation.paramPtrs != nil {
+		mmUpdateComment.mock.t.Fatalf("StorageMock.UpdateComment mock is already set by ExpectParams functions")
 	}
 
-	mmGetReplies.defaultExpectation.params = &StorageMockGetRepliesParams{ctx, parentID, limit, offset}
-	mmGetReplies.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmGetReplies.expectations {
-		if minimock.Equal(e.params, mmGetReplies.defaultExpectation.params) {
-			mmGetReplies.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetReplies.defaultExpectation.params)
+	mmUpdateComment.defaultExpectation.params = &StorageMockUpdateCommentParams{ctx, id, content, editedAt}
+	mmUpdateComment.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmUpdateComment.expectations {
+		if minimock.Equal(e.params, mmUpdateComment.defaultExpectation.params) {
+			mmUpdateComment.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpdateComment.defaultExpectation.params)
 		}
 	}
 
-	return mmGetReplies
+	return mmUpdateComment
 }
 
-// ExpectCtxParam1 sets up expected param ctx for Storage.GetReplies
-func (mmGetReplies *mStorageMockGetReplies) ExpectCtxParam1(ctx context.Context) *mStorageMockGetReplies {
-	if mmGetReplies.mock.funcGetReplies != nil {
-		mmGetReplies.mock.t.Fatalf("StorageMock.GetReplies mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for Storage.UpdateComment
+func (mmUpdateComment *mStorageMockUpdateComment) ExpectCtxParam1(ctx context.Context) *mStorageMockUpdateComment {
+	if mmUpdateComment.mock.funcUpdateComment != nil {
+		mmUpdateComment.mock.t.Fatalf("StorageMock.UpdateComment mock is already set by Set")
 	}
 
-	if mmGetReplies.defaultExpectation == nil {
-		mmGetReplies.defaultExpectation = &StorageMockGetRepliesExpectation{}
+	if mmUpdateComment.defaultExpectation == nil {
+		mmUpdateComment.defaultExpectation = &StorageMockUpdateCommentExpectation{}
 	}
 
-	if mmGetReplies.defaultExpectation.params != nil {
-		mmGetReplies.mock.t.Fatalf("StorageMock.GetReplies mock is already set by Expect")
+	if mmUpdateComment.defaultExpectation.params != nil {
+		mmUpdateComment.mock.t.Fatalf("StorageMock.UpdateComment mock is already set by Expect")
 	}
 
-	if mmGetReplies.defaultExpectation.paramPtrs == nil {
-		mmGetReplies.defaultExpectation.paramPtrs = &StorageMockGetRepliesParamPtrs{}
+	if mmUpdateComment.defaultExpectation.paramPtrs == nil {
+		mmUpdateComment.defaultExpectation.paramPtrs = &StorageMockUpdateCommentParamPtrs{}
 	}
-	mmGetReplies.defaultExpectation.paramPtrs.ctx = &ctx
-	mmGetReplies.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmUpdateComment.defaultExpectation.paramPtrs.ctx = &ctx
+	mmUpdateComment.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmGetReplies
+	return mmUpdateComment
 }
 
-// ExpectParentIDParam2 sets up expected param parentID for Storage.GetReplies
-func (mmGetReplies *mStorageMockGetReplies) ExpectParentIDParam2(parentID string) *mStorageMockGetReplies {
-	if mmGetReplies.mock.funcGetReplies != nil {
-		mmGetReplies.mock.t.Fatalf("StorageMock.GetReplies mock is already set by Set")
+// ExpectIdParam2 sets up expected param id for Storage.UpdateComment
+func (mmUpdateComment *mStorageMockUpdateComment) ExpectIdParam2(id string) *mStorageMockUpdateComment {
+	if mmUpdateComment.mock.funcUpdateComment != nil {
+		mmUpdateComment.mock.t.Fatalf("StorageMock.UpdateComment mock is already set by Set")
 	}
 
-	if mmGetReplies.defaultExpectation == nil {
-		mmGetReplies.defaultExpectation = &StorageMockGetRepliesExpectation{}
+	if mmUpdateComment.defaultExpectation == nil {
+		mmUpdateComment.defaultExpectation = &StorageMockUpdateCommentExpectation{}
 	}
 
-	if mmGetReplies.defaultExpectation.params != nil {
-		mmGetReplies.mock.t.Fatalf("StorageMock.GetReplies mock is already set by Expect")
+	if mmUpdateComment.defaultExpectation.params != nil {
+		mmUpdateComment.mock.t.Fatalf("StorageMock.UpdateComment mock is already set by Expect")
 	}
 
-	if mmGetReplies.defaultExpectation.paramPtrs == nil {
-		mmGetReplies.defaultExpectation.paramPtrs = &StorageMockGetRepliesParamPtrs{}
+	if mmUpdateComment.defaultExpectation.paramPtrs == nil {
+		mmUpdateComment.defaultExpectation.paramPtrs = &StorageMockUpdateCommentParamPtrs{}
 	}
-	mmGetReplies.defaultExpectation.paramPtrs.parentID = &parentID
-	mmGetReplies.defaultExpectation.expectationOrigins.originParentID = minimock.CallerInfo(1)
+	mmUpdateComment.defaultExpectation.paramPtrs.id = &id
+	mmUpdateComment.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
 
-	return mmGetReplies
+	return mmUpdateComment
 }
 
-// ExpectLimitParam3 sets up expected param limit for Storage.GetReplies
-func (mmGetReplies *mStorageMockGetReplies) ExpectLimitParam3(limit int) *mStorageMockGetReplies {
-	if mmGetReplies.mock.funcGetReplies != nil {
-		mmGetReplies.mock.t.Fatalf("StorageMock.GetReplies mock is already set by Set")
+// ExpectContentParam3 sets up expected param content for Storage.UpdateComment
+func (mmUpdateComment *mStorageMockUpdateComment) ExpectContentParam3(content string) *mStorageMockUpdateComment {
+	if mmUpdateComment.mock.funcUpdateComment != nil {
+		mmUpdateComment.mock.t.Fatalf("StorageMock.UpdateComment mock is already set by Set")
 	}
 
-	if mmGetReplies.defaultExpectation == nil {
-		mmGetReplies.defaultExpectation = &StorageMockGetRepliesExpectation{}
+	if mmUpdateComment.defaultExpectation == nil {
+		mmUpdateComment.defaultExpectation = &StorageMockUpdateCommentExpectation{}
 	}
 
-	if mmGetReplies.defaultExpectation.params != nil {
-		mmGetReplies.mock.t.Fatalf("StorageMock.GetReplies mock is already set by Expect")
+	if mmUpdateComment.defaultExpectation.params != nil {
+		mmUpdateComment.mock.t.Fatalf("StorageMock.UpdateComment mock is already set by Expect")
 	}
 
-	if mmGetReplies.defaultExpectation.paramPtrs == nil {
-		mmGetReplies.defaultExpectation.paramPtrs = &StorageMockGetRepliesParamPtrs{}
+	if mmUpdateComment.defaultExpectation.paramPtrs == nil {
+		mmUpdateComment.defaultExpectation.paramPtrs = &StorageMockUpdateCommentParamPtrs{}
 	}
-	mmGetReplies.defaultExpectation.paramPtrs.limit = &limit
-	mmGetReplies.defaultExpectation.expectationOrigins.originLimit = minimock.CallerInfo(1)
+	mmUpdateComment.defaultExpectation.paramPtrs.content = &content
+	mmUpdateComment.defaultExpectation.expectationOrigins.originContent = minimock.CallerInfo(1)
 
-	return mmGetReplies
+	return mmUpdateComment
 }
 
-// ExpectOffsetParam4 sets up expected param offset for Storage.GetReplies
-func (mmGetReplies *mStorageMockGetReplies) ExpectOffsetParam4(offset int) *mStorageMockGetReplies {
-	if mmGetReplies.mock.funcGetReplies != nil {
-		mmGetReplies.mock.t.Fatalf("StorageMock.GetReplies mock is already set by Set")
+// ExpectEditedAtParam4 sets up expected param editedAt for Storage.UpdateComment
+func (mmUpdateComment *mStorageMockUpdateComment) ExpectEditedAtParam4(editedAt time.Time) *mStorageMockUpdateComment {
+	if mmUpdateComment.mock.funcUpdateComment != nil {
+		mmUpdateComment.mock.t.Fatalf("StorageMock.UpdateComment mock is already set by Set")
 	}
 
-	if mmGetReplies.defaultExpectation == nil {
-		mmGetReplies.defaultExpectation = &StorageMockGetRepliesExpectation{}
+	if mmUpdateComment.defaultExpectation == nil {
+		mmUpdateComment.defaultExpectation = &StorageMockUpdateCommentExpectation{}
 	}
 
-	if mmGetReplies.defaultExpectation.params != nil {
-		mmGetReplies.mock.t.Fatalf("StorageMock.GetReplies mock is already set by Expect")
+	if mmUpdateComment.defaultExpectation.params != nil {
+		mmUpdateComment.mock.t.Fatalf("StorageMock.UpdateComment mock is already set by Expect")
 	}
 
-	if mmGetReplies.defaultExpectation.paramPtrs == nil {
-		mmGetReplies.defaultExpectation.paramPtrs = &StorageMockGetRepliesParamPtrs{}
+	if mmUpdateComment.defaultExpectation.paramPtrs == nil {
+		mmUpdateComment.defaultExpectation.paramPtrs = &StorageMockUpdateCommentParamPtrs{}
 	}
-	mmGetReplies.defaultExpectation.paramPtrs.offset = &offset
-	mmGetReplies.defaultExpectation.expectationOrigins.originOffset = minimock.CallerInfo(1)
+	mmUpdateComment.defaultExpectation.paramPtrs.editedAt = &editedAt
+	mmUpdateComment.defaultExpectation.expectationOrigins.originEditedAt = minimock.CallerInfo(1)
 
-	return mmGetReplies
+	return mmUpdateComment
 }
 
-// Inspect accepts an inspector function that has same arguments as the Storage.GetReplies
-func (mmGetReplies *mStorageMockGetReplies) Inspect(f func(ctx context.Context, parentID string, limit int, offset int)) *mStorageMockGetReplies {
-	if mmGetReplies.mock.inspectFuncGetReplies != nil {
-		mmGetReplies.mock.t.Fatalf("Inspect function is already set for StorageMock.GetReplies")
+// Inspect accepts an inspector function that has same arguments as the Storage.UpdateComment
+func (mmUpdateComment *mStorageMockUpdateComment) Inspect(f func(ctx context.Context, id string, content string, editedAt time.Time)) *mStorageMockUpdateComment {
+	if mmUpdateComment.mock.inspectFuncUpdateComment != nil {
+		mmUpdateComment.mock.t.Fatalf("Inspect function is already set for StorageMock.UpdateComment")
 	}
 
-	mmGetReplies.mock.inspectFuncGetReplies = f
+	mmUpdateComment.mock.inspectFuncUpdateComment = f
 
-	return mmGetReplies
+	return mmUpdateComment
 }
 
-// Return sets up results that will be returned by Storage.GetReplies
-func (mmGetReplies *mStorageMockGetReplies) Return(cpa1 []*model.Comment, err error) *StorageMock {
-	if mmGetReplies.mock.funcGetReplies != nil {
-		mmGetReplies.mock.t.Fatalf("StorageMock.GetReplies mock is already set by Set")
+// Return sets up results that will be returned by Storage.UpdateComment
+func (mmUpdateComment *mStorageMockUpdateComment) Return(cp1 *model.Comment, v1 mm_storage.Visibility, err error) *StorageMock {
+	if mmUpdateComment.mock.funcUpdateComment != nil {
+		mmUpdateComment.mock.t.Fatalf("StorageMock.UpdateComment mock is already set by Set")
 	}
 
-	if mmGetReplies.defaultExpectation == nil {
-		mmGetReplies.defaultExpectation = &StorageMockGetRepliesExpectation{mock: mmGetReplies.mock}
+	if mmUpdateComment.defaultExpectation == nil {
+		mmUpdateComment.defaultExpectation = &StorageMockUpdateCommentExpectation{mock: mmUpdateComment.mock}
 	}
-	mmGetReplies.defaultExpectation.results = &StorageMockGetRepliesResults{cpa1, err}
-	mmGetReplies.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmGetReplies.mock
+	mmUpdateComment.defaultExpectation.results = &StorageMockUpdateCommentResults{cp1, v1, err}
+	mmUpdateComment.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmUpdateComment.mock
 }
 
-// Set uses given function f to mock the Storage.GetReplies method
-func (mmGetReplies *mStorageMockGetReplies) Set(f func(ctx context.Context, parentID string, limit int, offset int) (cpa1 []*model.Comment, err error)) *StorageMock {
-	if mmGetReplies.defaultExpectation != nil {
-		mmGetReplies.mock.t.Fatalf("Default expectation is already set for the Storage.GetReplies method")
+// Set uses given function f to mock the Storage.UpdateComment method
+func (mmUpdateComment *mStorageMockUpdateComment) Set(f func(ctx context.Context, id string, content string, editedAt time.Time) (cp1 *model.Comment, v1 mm_storage.Visibility, err error)) *StorageMock {
+	if mmUpdateComment.defaultExpectation != nil {
+		mmUpdateComment.mock.t.Fatalf("Default expectation is already set for the Storage.UpdateComment method")
 	}
 
-	if len(mmGetReplies.expectations) > 0 {
-		mmGetReplies.mock.t.Fatalf("Some expectations are already set for the Storage.GetReplies method")
+	if len(mmUpdateComment.expectations) > 0 {
+		mmUpdateComment.mock.t.Fatalf("Some expectations are already set for the Storage.UpdateComment method")
 	}
 
-	mmGetReplies.mock.funcGetReplies = f
-	mmGetReplies.mock.funcGetRepliesOrigin = minimock.CallerInfo(1)
-	return mmGetReplies.mock
+	mmUpdateComment.mock.funcUpdateComment = f
+	mmUpdateComment.mock.funcUpdateCommentOrigin = minimock.CallerInfo(1)
+	return mmUpdateComment.mock
 }
 
-// When sets expectation for the Storage.GetReplies which will trigger the result defined by the following
+// When sets expectation for the Storage.UpdateComment which will trigger the result defined by the following
 // Then helper
-func (mmGetReplies *mStorageMockGetReplies) When(ctx context.Context, parentID string, limit int, offset int) *StorageMockGetRepliesExpectation {
-	if mmGetReplies.mock.funcGetReplies != nil {
-		mmGetReplies.mock.t.Fatalf("StorageMock.GetReplies mock is already set by Set")
+func (mmUpdateComment *mStorageMockUpdateComment) When(ctx context.Context, id string, content string, editedAt time.Time) *StorageMockUpdateCommentExpectation {
+	if mmUpdateComment.mock.funcUpdateComment != nil {
+		mmUpdateComment.mock.t.Fatalf("StorageMock.UpdateComment mock is already set by Set")
 	}
 
-	expectation := &StorageMockGetRepliesExpectation{
-		mock:               mmGetReplies.mock,
-		params:             &StorageMockGetRepliesParams{ctx, parentID, limit, offset},
-		expectationOrigins: StorageMockGetRepliesExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &StorageMockUpdateCommentExpectation{
+		mock:               mmUpdateComment.mock,
+		params:             &StorageMockUpdateCommentParams{ctx, id, content, editedAt},
+		expectationOrigins: StorageMockUpdateCommentExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmGetReplies.expectations = append(mmGetReplies.expectations, expectation)
+	mmUpdateComment.expectations = append(mmUpdateComment.expectations, expectation)
 	return expectation
 }
 
-// Then sets up Storage.GetReplies return parameters for the expectation previously defined by the When method
-func (e *StorageMockGetRepliesExpectation) Then(cpa1 []*model.Comment, err error) *StorageMock {
-	e.results = &StorageMockGetRepliesResults{cpa1, err}
+// Then sets up Storage.UpdateComment return parameters for the expectation previously defined by the When method
+func (e *StorageMockUpdateCommentExpectation) Then(cp1 *model.Comment, v1 mm_storage.Visibility, err error) *StorageMock {
+	e.results = &StorageMockUpdateCommentResults{cp1, v1, err}
 	return e.mock
 }
 
-// Times sets number of times Storage.GetReplies should be invoked
-func (mmGetReplies *mStorageMockGetReplies) Times(n uint64) *mStorageMockGetReplies {
+// Times sets number of times Storage.UpdateComment should be invoked
+func (mmUpdateComment *mStorageMockUpdateComment) Times(n uint64) *mStorageMockUpdateComment {
 	if n == 0 {
-		mmGetReplies.mock.t.Fatalf("Times of StorageMock.GetReplies mock can not be zero")
+		mmUpdateComment.mock.t.Fatalf("Times of StorageMock.UpdateComment mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmGetReplies.expectedInvocations, n)
-	mmGetReplies.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmGetReplies
+	mm_atomic.StoreUint64(&mmUpdateComment.expectedInvocations, n)
+	mmUpdateComment.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmUpdateComment
 }
 
-func (mmGetReplies *mStorageMockGetReplies) invocationsDone() bool {
-	if len(mmGetReplies.expectations) == 0 && mmGetReplies.defaultExpectation == nil && mmGetReplies.mock.funcGetReplies == nil {
+func (mmUpdateComment *mStorageMockUpdateComment) invocationsDone() bool {
+	if len(mmUpdateComment.expectations) == 0 && mmUpdateComment.defaultExpectation == nil && mmUpdateComment.mock.funcUpdateComment == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmGetReplies.mock.afterGetRepliesCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmGetReplies.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmUpdateComment.mock.afterUpdateCommentCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmUpdateComment.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// GetReplies implements mm_storage.Storage
-func (mmGetReplies *StorageMock) GetReplies(ctx context.Context, parentID string, limit int, offset int) (cpa1 []*model.Comment, err error) {
-	mm_atomic.AddUint64(&mmGetReplies.beforeGetRepliesCounter, 1)
-	defer mm_atomic.AddUint64(&mmGetReplies.afterGetRepliesCounter, 1)
+// UpdateComment implements mm_storage.Storage
+func (mmUpdateComment *StorageMock) UpdateComment(ctx context.Context, id string, content string, editedAt time.Time) (cp1 *model.Comment, v1 mm_storage.Visibility, err error) {
+	mm_atomic.AddUint64(&mmUpdateComment.beforeUpdateCommentCounter, 1)
+	defer mm_atomic.AddUint64(&mmUpdateComment.afterUpdateCommentCounter, 1)
 
-	mmGetReplies.t.Helper()
+	mmUpdateComment.t.Helper()
 
-	if mmGetReplies.inspectFuncGetReplies != nil {
-		mmGetReplies.inspectFuncGetReplies(ctx, parentID, limit, offset)
+	if mmUpdateComment.inspectFuncUpdateComment != nil {
+		mmUpdateComment.inspectFuncUpdateComment(ctx, id, content, editedAt)
 	}
 
-	mm_params := StorageMockGetRepliesParams{ctx, parentID, limit, offset}
+	mm_params := StorageMockUpdateCommentParams{ctx, id, content, editedAt}
 
 	// Record call args
-	mmGetReplies.GetRepliesMock.mutex.Lock()
-	mmGetReplies.GetRepliesMock.callArgs = append(mmGetReplies.GetRepliesMock.callArgs, &mm_params)
-	mmGetReplies.GetRepliesMock.mutex.Unlock()
+	mmUpdateComment.UpdateCommentMock.mutex.Lock()
+	mmUpdateComment.UpdateCommentMock.callArgs = append(mmUpdateComment.UpdateCommentMock.callArgs, &mm_params)
+	mmUpdateComment.UpdateCommentMock.mutex.Unlock()
 
-	for _, e := range mmGetReplies.GetRepliesMock.expectations {
+	for _, e := range mmUpdateComment.UpdateCommentMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.cpa1, e.results.err
+			return e.results.cp1, e.results.v1, e.results.err
 		}
 	}
 
-	if mmGetReplies.GetRepliesMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmGetReplies.GetRepliesMock.defaultExpectation.Counter, 1)
-		mm_want := mmGetReplies.GetRepliesMock.defaultExpectation.params
-		mm_want_ptrs := mmGetReplies.GetRepliesMock.defaultExpectation.paramPtrs
+	if mmUpdateComment.UpdateCommentMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUpdateComment.UpdateCommentMock.defaultExpectation.Counter, 1)
+		mm_want := mmUpdateComment.UpdateCommentMock.defaultExpectation.params
+		mm_want_ptrs := mmUpdateComment.UpdateCommentMock.defaultExpectation.paramPtrs
 
-		mm_got := StorageMockGetRepliesParams{ctx, parentID, limit, offset}
+		mm_got := StorageMockUpdateCommentParams{ctx, id, content, editedAt}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmGetReplies.t.Errorf("StorageMock.GetReplies got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmGetReplies.GetRepliesMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmUpdateComment.t.Errorf("StorageMock.UpdateComment got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateComment.UpdateCommentMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.parentID != nil && !minimock.Equal(*mm_want_ptrs.parentID, mm_got.parentID) {
-				mmGetReplies.t.Errorf("StorageMock.GetReplies got unexpected parameter parentID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmGetReplies.GetRepliesMock.defaultExpectation.expectationOrigins.originParentID, *mm_want_ptrs.parentID, mm_got.parentID, minimock.Diff(*mm_want_ptrs.parentID, mm_got.parentID))
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmUpdateComment.t.Errorf("StorageMock.UpdateComment got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateComment.UpdateCommentMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
 			}
 
-			if mm_want_ptrs.limit != nil && !minimock.Equal(*mm_want_ptrs.limit, mm_got.limit) {
-				mmGetReplies.t.Errorf("StorageMock.GetReplies got unexpected parameter limit, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmGetReplies.GetRepliesMock.defaultExpectation.expectationOrigins.originLimit, *mm_want_ptrs.limit, mm_got.limit, minimock.Diff(*mm_want_ptrs.limit, mm_got.limit))
+			if mm_want_ptrs.content != nil && !minimock.Equal(*mm_want_ptrs.content, mm_got.content) {
+				mmUpdateComment.t.Errorf("StorageMock.UpdateComment got unexpected parameter content, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateComment.UpdateCommentMock.defaultExpectation.expectationOrigins.originContent, *mm_want_ptrs.content, mm_got.content, minimock.Diff(*mm_want_ptrs.content, mm_got.content))
 			}
 
-			if mm_want_ptrs.offset != nil && !minimock.Equal(*mm_want_ptrs.offset, mm_got.offset) {
-				mmGetReplies.t.Errorf("StorageMock.GetReplies got unexpected parameter offset, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmGetReplies.GetRepliesMock.defaultExpectation.expectationOrigins.originOffset, *mm_want_ptrs.offset, mm_got.offset, minimock.Diff(*mm_want_ptrs.offset, mm_got.offset))
+			if mm_want_ptrs.editedAt != nil && !minimock.Equal(*mm_want_ptrs.editedAt, mm_got.editedAt) {
+				mmUpdateComment.t.Errorf("StorageMock.UpdateComment got unexpected parameter editedAt, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateComment.UpdateCommentMock.defaultExpectation.expectationOrigins.originEditedAt, *mm_want_ptrs.editedAt, mm_got.editedAt, minimock.Diff(*mm_want_ptrs.editedAt, mm_got.editedAt))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmGetReplies.t.Errorf("StorageMock.GetReplies got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmGetReplies.GetRepliesMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmUpdateComment.t.Errorf("StorageMock.UpdateComment got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmUpdateComment.UpdateCommentMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmGetReplies.GetRepliesMock.defaultExpectation.results
+		mm_results := mmUpdateComment.UpdateCommentMock.defaultExpectation.results
 		if mm_results == nil {
-			mmGetReplies.t.Fatal("No results are set for the StorageMock.GetReplies")
+			mmUpdateComment.t.Fatal("No results are set for the StorageMock.UpdateComment")
 		}
-		return (*mm_results).cpa1, (*mm_results).err
+		return (*mm_results).cp1, (*mm_results).v1, (*mm_results).err
 	}
-	if mmGetReplies.funcGetReplies != nil {
-		return mmGetReplies.funcGetReplies(ctx, parentID, limit, offset)
+	if mmUpdateComment.funcUpdateComment != nil {
+		return mmUpdateComment.funcUpdateComment(ctx, id, content, editedAt)
 	}
-	mmGetReplies.t.Fatalf("Unexpected call to StorageMock.GetReplies. %v %v %v %v", ctx, parentID, limit, offset)
+	mmUpdateComment.t.Fatalf("Unexpected call to StorageMock.UpdateComment. %v %v %v %v", ctx, id, content, editedAt)
 	return
 }
 
-// GetRepliesAfterCounter returns a count of finished StorageMock.GetReplies invocations
-func (mmGetReplies *StorageMock) GetRepliesAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmGetReplies.afterGetRepliesCounter)
+// UpdateCommentAfterCounter returns a count of finished StorageMock.UpdateComment invocations
+func (mmUpdateComment *StorageMock) UpdateCommentAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateComment.afterUpdateCommentCounter)
 }
 
-// GetRepliesBeforeCounter returns a count of StorageMock.GetReplies invocations
-func (mmGetReplies *StorageMock) GetRepliesBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmGetReplies.beforeGetRepliesCounter)
+// UpdateCommentBeforeCounter returns a count of StorageMock.UpdateComment invocations
+func (mmUpdateComment *StorageMock) UpdateCommentBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateComment.beforeUpdateCommentCounter)
 }
 
-// Calls returns a list of arguments used in each call to StorageMock.GetReplies.
+// Calls returns a list of arguments used in each call to StorageMock.UpdateComment.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmGetReplies *mStorageMockGetReplies) Calls() []*StorageMockGetRepliesParams {
-	mmGetReplies.mutex.RLock()
+func (mmUpdateComment *mStorageMockUpdateComment) Calls() []*StorageMockUpdateCommentParams {
+	mmUpdateComment.mutex.RLock()
 
-	argCopy := make([]*StorageMockGetRepliesParams, len(mmGetReplies.callArgs))
-	copy(argCopy, mmGetReplies.callArgs)
+	argCopy := make([]*StorageMockUpdateCommentParams, len(mmUpdateComment.callArgs))
+	copy(argCopy, mmUpdateComment.callArgs)
 
-	mmGetReplies.mutex.RUnlock()
+	mmUpdateComment.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockGetRepliesDone returns true if the count of the GetReplies invocations corresponds
+// MinimockUpdateCommentDone returns true if the count of the UpdateComment invocations corresponds
 // the number of defined expectations
-func (m *StorageMock) MinimockGetRepliesDone() bool {
-	if m.GetRepliesMock.optional {
+func (m *StorageMock) MinimockUpdateCommentDone() bool {
+	if m.UpdateCommentMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.GetRepliesMock.expectations {
+	for _, e := range m.UpdateCommentMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.GetRepliesMock.invocationsDone()
+	return m.UpdateCommentMock.invocationsDone()
 }
 
-// MinimockGetRepliesInspect logs each unmet expectation
-func (m *StorageMock) MinimockGetRepliesInspect() {
-	for _, e := range m.GetRepliesMock.expectations {
+// MinimockUpdateCommentInspect logs each unmet expectation
+func (m *StorageMock) MinimockUpdateCommentInspect() {
+	for _, e := range m.UpdateCommentMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to StorageMock.GetReplies at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to StorageMock.UpdateComment at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterGetRepliesCounter := mm_atomic.LoadUint64(&m.afterGetRepliesCounter)
+	afterUpdateCommentCounter := mm_atomic.LoadUint64(&m.afterUpdateCommentCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.GetRepliesMock.defaultExpectation != nil && afterGetRepliesCounter < 1 {
-		if m.GetRepliesMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to StorageMock.GetReplies at\n%s", m.GetRepliesMock.defaultExpectation.returnOrigin)
+	if m.UpdateCommentMock.defaultExpectation != nil && afterUpdateCommentCounter < 1 {
+		if m.UpdateCommentMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to StorageMock.UpdateComment at\n%s", m.UpdateCommentMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to StorageMock.GetReplies at\n%s with params: %#v", m.GetRepliesMock.defaultExpectation.expectationOrigins.origin, *m.GetRepliesMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to StorageMock.UpdateComment at\n%s with params: %#v", m.UpdateCommentMock.defaultExpectation.expectationOrigins.origin, *m.UpdateCommentMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcGetReplies != nil && afterGetRepliesCounter < 1 {
-		m.t.Errorf("Expected call to StorageMock.GetReplies at\n%s", m.funcGetRepliesOrigin)
+	if m.funcUpdateComment != nil && afterUpdateCommentCounter < 1 {
+		m.t.Errorf("Expected call to StorageMock.UpdateComment at\n%s", m.funcUpdateCommentOrigin)
 	}
 
-	if !m.GetRepliesMock.invocationsDone() && afterGetRepliesCounter > 0 {
-		m.t.Errorf("Expected %d calls to StorageMock.GetReplies at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.GetRepliesMock.expectedInvocations), m.GetRepliesMock.expectedInvocationsOrigin, afterGetRepliesCounter)
+	if !m.UpdateCommentMock.invocationsDone() && afterUpdateCommentCounter > 0 {
+		m.t.Errorf("Expected %d calls to StorageMock.UpdateComment at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.UpdateCommentMock.expectedInvocations), m.UpdateCommentMock.expectedInvocationsOrigin, afterUpdateCommentCounter)
 	}
 }
 
-type mStorageMockToggleComments struct {
+type mStorageMockUpdateReportStatus struct {
 	optional           bool
 	mock               *StorageMock
-	defaultExpectation *StorageMockToggleCommentsExpectation
-	expectations       []*StorageMockToggleCommentsExpectation
+	defaultExpectation *StorageMockUpdateReportStatusExpectation
+	expectations       []*StorageMockUpdateReportStatusExpectation
 
-	callArgs []*StorageMockToggleCommentsParams
+	callArgs []*StorageMockUpdateReportStatusParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// StorageMockToggleCommentsExpectation specifies expectation struct of the Storage.ToggleComments
-type StorageMockToggleCommentsExpectation struct {
+// StorageMockUpdateReportStatusExpectation specifies expectation struct of the Storage.UpdateReportStatus
+type StorageMockUpdateReportStatusExpectation struct {
 	mock               *StorageMock
-	params             *StorageMockToggleCommentsParams
-	paramPtrs          *StorageMockToggleCommentsParamPtrs
-	expectationOrigins StorageMockToggleCommentsExpectationOrigins
-	results            *StorageMockToggleCommentsResults
+	params             *StorageMockUpdateReportStatusParams
+	paramPtrs          *StorageMockUpdateReportStatusParamPtrs
+	expectationOrigins StorageMockUpdateReportStatusExpectationOrigins
+	results            *StorageMockUpdateReportStatusResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// StorageMockToggleCommentsParams contains parameters of the Storage.ToggleComments
-type StorageMockToggleCommentsParams struct {
-	ctx     context.Context
-	postID  string
-	enabled bool
-	author  string
+// StorageMockUpdateReportStatusParams contains parameters of the Storage.UpdateReportStatus
+type StorageMockUpdateReportStatusParams struct {
+	ctx    context.Context
+	id     string
+	change *model.ReportStatusChange
 }
 
-// StorageMockToggleCommentsParamPtrs contains pointers to parameters of the Storage.ToggleComments
-type StorageMockToggleCommentsParamPtrs struct {
-	ctx     *context.Context
-	postID  *string
-	enabled *bool
-	author  *string
+// StorageMockUpdateReportStatusParamPtrs contains pointers to parameters of the Storage.UpdateReportStatus
+type StorageMockUpdateReportStatusParamPtrs struct {
+	ctx    *context.Context
+	id     *string
+	change **model.ReportStatusChange
 }
 
-// StorageMockToggleCommentsResults contains results of the Storage.ToggleComments
-type StorageMockToggleCommentsResults struct {
-	pp1 *model.Post
+// StorageMockUpdateReportStatusResults contains results of the Storage.UpdateReportStatus
+type StorageMockUpdateReportStatusResults struct {
+	rp1 *model.Report
 	err error
 }
 
-// StorageMockToggleCommentsOrigins contains origins of expectations of the Storage.ToggleComments
-type StorageMockToggleCommentsExpectationOrigins struct {
-	origin        string
-	originCtx     string
-	originPostID  string
-	originEnabled string
-	originAuthor  string
+// StorageMockUpdateReportStatusOrigins contains origins of expectations of the Storage.UpdateReportStatus
+type StorageMockUpdateReportStatusExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originId     string
+	originChange string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -2312,348 +9537,320 @@ type StorageMockToggleCommentsExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmToggleComments *mStorageMockToggleComments) Optional() *mStorageMockToggleComments {
-	mmToggleComments.optional = true
-	return mmToggleComments
+func (mmUpdateReportStatus *mStorageMockUpdateReportStatus) Optional() *mStorageMockUpdateReportStatus {
+	mmUpdateReportStatus.optional = true
+	return mmUpdateReportStatus
 }
 
-// Expect sets up expected params for Storage.ToggleComments
-func (mmToggleComments *mStorageMockToggleComments) Expect(ctx context.Context, postID string, enabled bool, author string) *mStorageMockToggleComments {
-	if mmToggleComments.mock.funcToggleComments != nil {
-		mmToggleComments.mock.t.Fatalf("StorageMock.ToggleComments mock is already set by Set")
+// Expect sets up expected params for Storage.UpdateReportStatus
+func (mmUpdateReportStatus *mStorageMockUpdateReportStatus) Expect(ctx context.Context, id string, change *model.ReportStatusChange) *mStorageMockUpdateReportStatus {
+	if mmUpdateReportStatus.mock.funcUpdateReportStatus != nil {
+		mmUpdateReportStatus.mock.t.Fatalf("StorageMock.UpdateReportStatus mock is already set by Set")
 	}
 
-	if mmToggleComments.defaultExpectation == nil {
-		mmToggleComments.defaultExpectation = &StorageMockToggleCommentsExpectation{}
+	if mmUpdateReportStatus.defaultExpectation == nil {
+		mmUpdateReportStatus.defaultExpectation = &StorageMockUpdateReportStatusExpectation{}
 	}
 
-	if mmToggleComments.defaultExpectation.paramPtrs != nil {
-		mmToggleComments.mock.t.Fatalf("StorageMock.ToggleComments mock is already set by ExpectParams functions")
+	if mmUpdateReportStatus.defaultExpectation.paramPtrs != nil {
+		mmUpdateReportStatus.mock.t.Fatalf("StorageMock.UpdateReportStatus mock is already set by ExpectParams functions")
 	}
 
-	mmToggleComments.defaultExpectation.params = &StorageMockToggleCommentsParams{ctx, postID, enabled, author}
-	mmToggleComments.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmToggleComments.expectations {
-		if minimock.Equal(e.params, mmToggleComments.defaultExpectation.params) {
-			mmToggleComments.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmToggleComments.defaultExpectation.params)
+	mmUpdateReportStatus.defaultExpectation.params = &StorageMockUpdateReportStatusParams{ctx, id, change}
+	mmUpdateReportStatus.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmUpdateReportStatus.expectations {
+		if minimock.Equal(e.params, mmUpdateReportStatus.defaultExpectation.params) {
+			mmUpdateReportStatus.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpdateReportStatus.defaultExpectation.params)
 		}
 	}
 
-	return mmToggleComments
-}
-
-// ExpectCtxParam1 sets up expected param ctx for Storage.ToggleComments
-func (mmToggleComments *mStorageMockToggleComments) ExpectCtxParam1(ctx context.Context) *mStorageMockToggleComments {
-	if mmToggleComments.mock.funcToggleComments != nil {
-		mmToggleComments.mock.t.Fatalf("StorageMock.ToggleComments mock is already set by Set")
-	}
-
-	if mmToggleComments.defaultExpectation == nil {
-		mmToggleComments.defaultExpectation = &StorageMockToggleCommentsExpectation{}
-	}
-
-	if mmToggleComments.defaultExpectation.params != nil {
-		mmToggleComments.mock.t.Fatalf("StorageMock.ToggleComments mock is already set by Expect")
-	}
-
-	if mmToggleComments.defaultExpectation.paramPtrs == nil {
-		mmToggleComments.defaultExpectation.paramPtrs = &StorageMockToggleCommentsParamPtrs{}
-	}
-	mmToggleComments.defaultExpectation.paramPtrs.ctx = &ctx
-	mmToggleComments.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
-
-	return mmToggleComments
+	return mmUpdateReportStatus
 }
 
-// ExpectPostIDParam2 sets up expected param postID for Storage.ToggleComments
-func (mmToggleComments *mStorageMockToggleComments) ExpectPostIDParam2(postID string) *mStorageMockToggleComments {
-	if mmToggleComments.mock.funcToggleComments != nil {
-		mmToggleComments.mock.t.Fatalf("StorageMock.ToggleComments mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for Storage.UpdateReportStatus
+func (mmUpdateReportStatus *mStorageMockUpdateReportStatus) ExpectCtxParam1(ctx context.Context) *mStorageMockUpdateReportStatus {
+	if mmUpdateReportStatus.mock.funcUpdateReportStatus != nil {
+		mmUpdateReportStatus.mock.t.Fatalf("StorageMock.UpdateReportStatus mock is already set by Set")
 	}
 
-	if mmToggleComments.defaultExpectation == nil {
-		mmToggleComments.defaultExpectation = &StorageMockToggleCommentsExpectation{}
+	if mmUpdateReportStatus.defaultExpectation == nil {
+		mmUpdateReportStatus.defaultExpectation = &StorageMockUpdateReportStatusExpectation{}
 	}
 
-	if mmToggleComments.defaultExpectation.params != nil {
-		mmToggleComments.mock.t.Fatalf("StorageMock.ToggleComments mock is already set by Expect")
+	if mmUpdateReportStatus.defaultExpectation.params != nil {
+		mmUpdateReportStatus.mock.t.Fatalf("StorageMock.UpdateReportStatus mock is already set by Expect")
 	}
 
-	if mmToggleComments.defaultExpectation.paramPtrs == nil {
-		mmToggleComments.defaultExpectation.paramPtrs = &StorageMockToggleCommentsParamPtrs{}
+	if mmUpdateReportStatus.defaultExpectation.paramPtrs == nil {
+		mmUpdateReportStatus.defaultExpectation.paramPtrs = &StorageMockUpdateReportStatusParamPtrs{}
 	}
-	mmToggleComments.defaultExpectation.paramPtrs.postID = &postID
-	mmToggleComments.defaultExpectation.expectationOrigins.originPostID = minimock.CallerInfo(1)
+	mmUpdateReportStatus.defaultExpectation.paramPtrs.ctx = &ctx
+	mmUpdateReportStatus.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmToggleComments
+	return mmUpdateReportStatus
 }
 
-// ExpectEnabledParam3 sets up expected param enabled for Storage.ToggleComments
-func (mmToggleComments *mStorageMockToggleComments) ExpectEnabledParam3(enabled bool) *mStorageMockToggleComments {
-	if mmToggleComments.mock.funcToggleComments != nil {
-		mmToggleComments.mock.t.Fatalf("StorageMock.ToggleComments mock is already set by Set")
+// ExpectIdParam2 sets up expected param id for Storage.UpdateReportStatus
+func (mmUpdateReportStatus *mStorageMockUpdateReportStatus) ExpectIdParam2(id string) *mStorageMockUpdateReportStatus {
+	if mmUpdateReportStatus.mock.funcUpdateReportStatus != nil {
+		mmUpdateReportStatus.mock.t.Fatalf("StorageMock.UpdateReportStatus mock is already set by Set")
 	}
 
-	if mmToggleComments.defaultExpectation == nil {
-		mmToggleComments.defaultExpectation = &StorageMockToggleCommentsExpectation{}
+	if mmUpdateReportStatus.defaultExpectation == nil {
+		mmUpdateReportStatus.defaultExpectation = &StorageMockUpdateReportStatusExpectation{}
 	}
 
-	if mmToggleComments.defaultExpectation.params != nil {
-		mmToggleComments.mock.t.Fatalf("StorageMock.ToggleComments mock is already set by Expect")
+	if mmUpdateReportStatus.defaultExpectation.params != nil {
+		mmUpdateReportStatus.mock.t.Fatalf("StorageMock.UpdateReportStatus mock is already set by Expect")
 	}
 
-	if mmToggleComments.defaultExpectation.paramPtrs == nil {
-		mmToggleComments.defaultExpectation.paramPtrs = &StorageMockToggleCommentsParamPtrs{}
+	if mmUpdateReportStatus.defaultExpectation.paramPtrs == nil {
+		mmUpdateReportStatus.defaultExpectation.paramPtrs = &StorageMockUpdateReportStatusParamPtrs{}
 	}
-	mmToggleComments.defaultExpectation.paramPtrs.enabled = &enabled
-	mmToggleComments.defaultExpectation.expectationOrigins.originEnabled = minimock.CallerInfo(1)
+	mmUpdateReportStatus.defaultExpectation.paramPtrs.id = &id
+	mmUpdateReportStatus.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
 
-	return mmToggleComments
+	return mmUpdateReportStatus
 }
 
-// ExpectAuthorParam4 sets up expected param author for Storage.ToggleComments
-func (mmToggleComments *mStorageMockToggleComments) ExpectAuthorParam4(author string) *mStorageMockToggleComments {
-	if mmToggleComments.mock.funcToggleComments != nil {
-		mmToggleComments.mock.t.Fatalf("StorageMock.ToggleComments mock is already set by Set")
+// ExpectChangeParam3 sets up expected param change for Storage.UpdateReportStatus
+func (mmUpdateReportStatus *mStorageMockUpdateReportStatus) ExpectChangeParam3(change *model.ReportStatusChange) *mStorageMockUpdateReportStatus {
+	if mmUpdateReportStatus.mock.funcUpdateReportStatus != nil {
+		mmUpdateReportStatus.mock.t.Fatalf("StorageMock.UpdateReportStatus mock is already set by Set")
 	}
 
-	if mmToggleComments.defaultExpectation == nil {
-		mmToggleComments.defaultExpectation = &StorageMockToggleCommentsExpectation{}
+	if mmUpdateReportStatus.defaultExpectation == nil {
+		mmUpdateReportStatus.defaultExpectation = &StorageMockUpdateReportStatusExpectation{}
 	}
 
-	if mmToggleComments.defaultExpectation.params != nil {
-		mmToggleComments.mock.t.Fatalf("StorageMock.ToggleComments mock is already set by Expect")
+	if mmUpdateReportStatus.defaultExpectation.params != nil {
+		mmUpdateReportStatus.mock.t.Fatalf("StorageMock.UpdateReportStatus mock is already set by Expect")
 	}
 
-	if mmToggleComments.defaultExpectation.paramPtrs == nil {
-		mmToggleComments.defaultExpectation.paramPtrs = &StorageMockToggleCommentsParamPtrs{}
+	if mmUpdateReportStatus.defaultExpectation.paramPtrs == nil {
+		mmUpdateReportStatus.defaultExpectation.paramPtrs = &StorageMockUpdateReportStatusParamPtrs{}
 	}
-	mmToggleComments.defaultExpectation.paramPtrs.author = &author
-	mmToggleComments.defaultExpectation.expectationOrigins.originAuthor = minimock.CallerInfo(1)
+	mmUpdateReportStatus.defaultExpectation.paramPtrs.change = &change
+	mmUpdateReportStatus.defaultExpectation.expectationOrigins.originChange = minimock.CallerInfo(1)
 
-	return mmToggleComments
+	return mmUpdateReportStatus
 }
 
-// Inspect accepts an inspector function that has same arguments as the Storage.ToggleComments
-func (mmToggleComments *mStorageMockToggleComments) Inspect(f func(ctx context.Context, postID string, enabled bool, author string)) *mStorageMockToggleComments {
-	if mmToggleComments.mock.inspectFuncToggleComments != nil {
-		mmToggleComments.mock.t.Fatalf("Inspect function is already set for StorageMock.ToggleComments")
+// Inspect accepts an inspector function that has same arguments as the Storage.UpdateReportStatus
+func (mmUpdateReportStatus *mStorageMockUpdateReportStatus) Inspect(f func(ctx context.Context, id string, change *model.ReportStatusChange)) *mStorageMockUpdateReportStatus {
+	if mmUpdateReportStatus.mock.inspectFuncUpdateReportStatus != nil {
+		mmUpdateReportStatus.mock.t.Fatalf("Inspect function is already set for StorageMock.UpdateReportStatus")
 	}
 
-	mmToggleComments.mock.inspectFuncToggleComments = f
+	mmUpdateReportStatus.mock.inspectFuncUpdateReportStatus = f
 
-	return mmToggleComments
+	return mmUpdateReportStatus
 }
 
-// Return sets up results that will be returned by Storage.ToggleComments
-func (mmToggleComments *mStorageMockToggleComments) Return(pp1 *model.Post, err error) *StorageMock {
-	if mmToggleComments.mock.funcToggleComments != nil {
-		mmToggleComments.mock.t.Fatalf("StorageMock.ToggleComments mock is already set by Set")
+// Return sets up results that will be returned by Storage.UpdateReportStatus
+func (mmUpdateReportStatus *mStorageMockUpdateReportStatus) Return(rp1 *model.Report, err error) *StorageMock {
+	if mmUpdateReportStatus.mock.funcUpdateReportStatus != nil {
+		mmUpdateReportStatus.mock.t.Fatalf("StorageMock.UpdateReportStatus mock is already set by Set")
 	}
 
-	if mmToggleComments.defaultExpectation == nil {
-		mmToggleComments.defaultExpectation = &StorageMockToggleCommentsExpectation{mock: mmToggleComments.mock}
+	if mmUpdateReportStatus.defaultExpectation == nil {
+		mmUpdateReportStatus.defaultExpectation = &StorageMockUpdateReportStatusExpectation{mock: mmUpdateReportStatus.mock}
 	}
-	mmToggleComments.defaultExpectation.results = &StorageMockToggleCommentsResults{pp1, err}
-	mmToggleComments.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmToggleComments.mock
+	mmUpdateReportStatus.defaultExpectation.results = &StorageMockUpdateReportStatusResults{rp1, err}
+	mmUpdateReportStatus.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmUpdateReportStatus.mock
 }
 
-// Set uses given function f to mock the Storage.ToggleComments method
-func (mmToggleComments *mStorageMockToggleComments) Set(f func(ctx context.Context, postID string, enabled bool, author string) (pp1 *model.Post, err error)) *StorageMock {
-	if mmToggleComments.defaultExpectation != nil {
-		mmToggleComments.mock.t.Fatalf("Default expectation is already set for the Storage.ToggleComments method")
+// Set uses given function f to mock the Storage.UpdateReportStatus method
+func (mmUpdateReportStatus *mStorageMockUpdateReportStatus) Set(f func(ctx context.Context, id string, change *model.ReportStatusChange) (rp1 *model.Report, err error)) *StorageMock {
+	if mmUpdateReportStatus.defaultExpectation != nil {
+		mmUpdateReportStatus.mock.t.Fatalf("Default expectation is already set for the Storage.UpdateReportStatus method")
 	}
 
-	if len(mmToggleComments.expectations) > 0 {
-		mmToggleComments.mock.t.Fatalf("Some expectations are already set for the Storage.ToggleComments method")
+	if len(mmUpdateReportStatus.expectations) > 0 {
+		mmUpdateReportStatus.mock.t.Fatalf("Some expectations are already set for the Storage.UpdateReportStatus method")
 	}
 
-	mmToggleComments.mock.funcToggleComments = f
-	mmToggleComments.mock.funcToggleCommentsOrigin = minimock.CallerInfo(1)
-	return mmToggleComments.mock
+	mmUpdateReportStatus.mock.funcUpdateReportStatus = f
+	mmUpdateReportStatus.mock.funcUpdateReportStatusOrigin = minimock.CallerInfo(1)
+	return mmUpdateReportStatus.mock
 }
 
-// When sets expectation for the Storage.ToggleComments which will trigger the result defined by the following
+// When sets expectation for the Storage.UpdateReportStatus which will trigger the result defined by the following
 // Then helper
-func (mmToggleComments *mStorageMockToggleComments) When(ctx context.Context, postID string, enabled bool, author string) *StorageMockToggleCommentsExpectation {
-	if mmToggleComments.mock.funcToggleComments != nil {
-		mmToggleComments.mock.t.Fatalf("StorageMock.ToggleComments mock is already set by Set")
+func (mmUpdateReportStatus *mStorageMockUpdateReportStatus) When(ctx context.Context, id string, change *model.ReportStatusChange) *StorageMockUpdateReportStatusExpectation {
+	if mmUpdateReportStatus.mock.funcUpdateReportStatus != nil {
+		mmUpdateReportStatus.mock.t.Fatalf("StorageMock.UpdateReportStatus mock is already set by Set")
 	}
 
-	expectation := &StorageMockToggleCommentsExpectation{
-		mock:               mmToggleComments.mock,
-		params:             &StorageMockToggleCommentsParams{ctx, postID, enabled, author},
-		expectationOrigins: StorageMockToggleCommentsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &StorageMockUpdateReportStatusExpectation{
+		mock:               mmUpdateReportStatus.mock,
+		params:             &StorageMockUpdateReportStatusParams{ctx, id, change},
+		expectationOrigins: StorageMockUpdateReportStatusExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmToggleComments.expectations = append(mmToggleComments.expectations, expectation)
+	mmUpdateReportStatus.expectations = append(mmUpdateReportStatus.expectations, expectation)
 	return expectation
 }
 
-// Then sets up Storage.ToggleComments return parameters for the expectation previously defined by the When method
-func (e *StorageMockToggleCommentsExpectation) Then(pp1 *model.Post, err error) *StorageMock {
-	e.results = &StorageMockToggleCommentsResults{pp1, err}
+// Then sets up Storage.UpdateReportStatus return parameters for the expectation previously defined by the When method
+func (e *StorageMockUpdateReportStatusExpectation) Then(rp1 *model.Report, err error) *StorageMock {
+	e.results = &StorageMockUpdateReportStatusResults{rp1, err}
 	return e.mock
 }
 
-// Times sets number of times Storage.ToggleComments should be invoked
-func (mmToggleComments *mStorageMockToggleComments) Times(n uint64) *mStorageMockToggleComments {
+// Times sets number of times Storage.UpdateReportStatus should be invoked
+func (mmUpdateReportStatus *mStorageMockUpdateReportStatus) Times(n uint64) *mStorageMockUpdateReportStatus {
 	if n == 0 {
-		mmToggleComments.mock.t.Fatalf("Times of StorageMock.ToggleComments mock can not be zero")
+		mmUpdateReportStatus.mock.t.Fatalf("Times of StorageMock.UpdateReportStatus mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmToggleComments.expectedInvocations, n)
-	mmToggleComments.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmToggleComments
+	mm_atomic.StoreUint64(&mmUpdateReportStatus.expectedInvocations, n)
+	mmUpdateReportStatus.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmUpdateReportStatus
 }
 
-func (mmToggleComments *mStorageMockToggleComments) invocationsDone() bool {
-	if len(mmToggleComments.expectations) == 0 && mmToggleComments.defaultExpectation == nil && mmToggleComments.mock.funcToggleComments == nil {
+func (mmUpdateReportStatus *mStorageMockUpdateReportStatus) invocationsDone() bool {
+	if len(mmUpdateReportStatus.expectations) == 0 && mmUpdateReportStatus.defaultExpectation == nil && mmUpdateReportStatus.mock.funcUpdateReportStatus == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmToggleComments.mock.afterToggleCommentsCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmToggleComments.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmUpdateReportStatus.mock.afterUpdateReportStatusCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmUpdateReportStatus.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// ToggleComments implements mm_storage.Storage
-func (mmToggleComments *StorageMock) ToggleComments(ctx context.Context, postID string, enabled bool, author string) (pp1 *model.Post, err error) {
-	mm_atomic.AddUint64(&mmToggleComments.beforeToggleCommentsCounter, 1)
-	defer mm_atomic.AddUint64(&mmToggleComments.afterToggleCommentsCounter, 1)
+// UpdateReportStatus implements mm_storage.Storage
+func (mmUpdateReportStatus *StorageMock) UpdateReportStatus(ctx context.Context, id string, change *model.ReportStatusChange) (rp1 *model.Report, err error) {
+	mm_atomic.AddUint64(&mmUpdateReportStatus.beforeUpdateReportStatusCounter, 1)
+	defer mm_atomic.AddUint64(&mmUpdateReportStatus.afterUpdateReportStatusCounter, 1)
 
-	mmToggleComments.t.Helper()
+	mmUpdateReportStatus.t.Helper()
 
-	if mmToggleComments.inspectFuncToggleComments != nil {
-		mmToggleComments.inspectFuncToggleComments(ctx, postID, enabled, author)
+	if mmUpdateReportStatus.inspectFuncUpdateReportStatus != nil {
+		mmUpdateReportStatus.inspectFuncUpdateReportStatus(ctx, id, change)
 	}
 
-	mm_params := StorageMockToggleCommentsParams{ctx, postID, enabled, author}
+	mm_params := StorageMockUpdateReportStatusParams{ctx, id, change}
 
 	// Record call args
-	mmToggleComments.ToggleCommentsMock.mutex.Lock()
-	mmToggleComments.ToggleCommentsMock.callArgs = append(mmToggleComments.ToggleCommentsMock.callArgs, &mm_params)
-	mmToggleComments.ToggleCommentsMock.mutex.Unlock()
+	mmUpdateReportStatus.UpdateReportStatusMock.mutex.Lock()
+	mmUpdateReportStatus.UpdateReportStatusMock.callArgs = append(mmUpdateReportStatus.UpdateReportStatusMock.callArgs, &mm_params)
+	mmUpdateReportStatus.UpdateReportStatusMock.mutex.Unlock()
 
-	for _, e := range mmToggleComments.ToggleCommentsMock.expectations {
+	for _, e := range mmUpdateReportStatus.UpdateReportStatusMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.pp1, e.results.err
+			return e.results.rp1, e.results.err
 		}
 	}
 
-	if mmToggleComments.ToggleCommentsMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmToggleComments.ToggleCommentsMock.defaultExpectation.Counter, 1)
-		mm_want := mmToggleComments.ToggleCommentsMock.defaultExpectation.params
-		mm_want_ptrs := mmToggleComments.ToggleCommentsMock.defaultExpectation.paramPtrs
+	if mmUpdateReportStatus.UpdateReportStatusMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUpdateReportStatus.UpdateReportStatusMock.defaultExpectation.Counter, 1)
+		mm_want := mmUpdateReportStatus.UpdateReportStatusMock.defaultExpectation.params
+		mm_want_ptrs := mmUpdateReportStatus.UpdateReportStatusMock.defaultExpectation.paramPtrs
 
-		mm_got := StorageMockToggleCommentsParams{ctx, postID, enabled, author}
+		mm_got := StorageMockUpdateReportStatusParams{ctx, id, change}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmToggleComments.t.Errorf("StorageMock.ToggleComments got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmToggleComments.ToggleCommentsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
-			}
-
-			if mm_want_ptrs.postID != nil && !minimock.Equal(*mm_want_ptrs.postID, mm_got.postID) {
-				mmToggleComments.t.Errorf("StorageMock.ToggleComments got unexpected parameter postID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmToggleComments.ToggleCommentsMock.defaultExpectation.expectationOrigins.originPostID, *mm_want_ptrs.postID, mm_got.postID, minimock.Diff(*mm_want_ptrs.postID, mm_got.postID))
+				mmUpdateReportStatus.t.Errorf("StorageMock.UpdateReportStatus got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateReportStatus.UpdateReportStatusMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.enabled != nil && !minimock.Equal(*mm_want_ptrs.enabled, mm_got.enabled) {
-				mmToggleComments.t.Errorf("StorageMock.ToggleComments got unexpected parameter enabled, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmToggleComments.ToggleCommentsMock.defaultExpectation.expectationOrigins.originEnabled, *mm_want_ptrs.enabled, mm_got.enabled, minimock.Diff(*mm_want_ptrs.enabled, mm_got.enabled))
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmUpdateReportStatus.t.Errorf("StorageMock.UpdateReportStatus got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateReportStatus.UpdateReportStatusMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
 			}
 
-			if mm_want_ptrs.author != nil && !minimock.Equal(*mm_want_ptrs.author, mm_got.author) {
-				mmToggleComments.t.Errorf("StorageMock.ToggleComments got unexpected parameter author, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmToggleComments.ToggleCommentsMock.defaultExpectation.expectationOrigins.originAuthor, *mm_want_ptrs.author, mm_got.author, minimock.Diff(*mm_want_ptrs.author, mm_got.author))
+			if mm_want_ptrs.change != nil && !minimock.Equal(*mm_want_ptrs.change, mm_got.change) {
+				mmUpdateReportStatus.t.Errorf("StorageMock.UpdateReportStatus got unexpected parameter change, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateReportStatus.UpdateReportStatusMock.defaultExpectation.expectationOrigins.originChange, *mm_want_ptrs.change, mm_got.change, minimock.Diff(*mm_want_ptrs.change, mm_got.change))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmToggleComments.t.Errorf("StorageMock.ToggleComments got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmToggleComments.ToggleCommentsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmUpdateReportStatus.t.Errorf("StorageMock.UpdateReportStatus got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmUpdateReportStatus.UpdateReportStatusMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmToggleComments.ToggleCommentsMock.defaultExpectation.results
+		mm_results := mmUpdateReportStatus.UpdateReportStatusMock.defaultExpectation.results
 		if mm_results == nil {
-			mmToggleComments.t.Fatal("No results are set for the StorageMock.ToggleComments")
+			mmUpdateReportStatus.t.Fatal("No results are set for the StorageMock.UpdateReportStatus")
 		}
-		return (*mm_results).pp1, (*mm_results).err
+		return (*mm_results).rp1, (*mm_results).err
 	}
-	if mmToggleComments.funcToggleComments != nil {
-		return mmToggleComments.funcToggleComments(ctx, postID, enabled, author)
+	if mmUpdateReportStatus.funcUpdateReportStatus != nil {
+		return mmUpdateReportStatus.funcUpdateReportStatus(ctx, id, change)
 	}
-	mmToggleComments.t.Fatalf("Unexpected call to StorageMock.ToggleComments. %v %v %v %v", ctx, postID, enabled, author)
+	mmUpdateReportStatus.t.Fatalf("Unexpected call to StorageMock.UpdateReportStatus. %v %v %v", ctx, id, change)
 	return
 }
 
-// ToggleCommentsAfterCounter returns a count of finished StorageMock.ToggleComments invocations
-func (mmToggleComments *StorageMock) ToggleCommentsAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmToggleComments.afterToggleCommentsCounter)
+// UpdateReportStatusAfterCounter returns a count of finished StorageMock.UpdateReportStatus invocations
+func (mmUpdateReportStatus *StorageMock) UpdateReportStatusAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateReportStatus.afterUpdateReportStatusCounter)
 }
 
-// ToggleCommentsBeforeCounter returns a count of StorageMock.ToggleComments invocations
-func (mmToggleComments *StorageMock) ToggleCommentsBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmToggleComments.beforeToggleCommentsCounter)
+// UpdateReportStatusBeforeCounter returns a count of StorageMock.UpdateReportStatus invocations
+func (mmUpdateReportStatus *StorageMock) UpdateReportStatusBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateReportStatus.beforeUpdateReportStatusCounter)
 }
 
-// Calls returns a list of arguments used in each call to StorageMock.ToggleComments.
+// Calls returns a list of arguments used in each call to StorageMock.UpdateReportStatus.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmToggleComments *mStorageMockToggleComments) Calls() []*StorageMockToggleCommentsParams {
-	mmToggleComments.mutex.RLock()
+func (mmUpdateReportStatus *mStorageMockUpdateReportStatus) Calls() []*StorageMockUpdateReportStatusParams {
+	mmUpdateReportStatus.mutex.RLock()
 
-	argCopy := make([]*StorageMockToggleCommentsParams, len(mmToggleComments.callArgs))
-	copy(argCopy, mmToggleComments.callArgs)
+	argCopy := make([]*StorageMockUpdateReportStatusParams, len(mmUpdateReportStatus.callArgs))
+	copy(argCopy, mmUpdateReportStatus.callArgs)
 
-	mmToggleComments.mutex.RUnlock()
+	mmUpdateReportStatus.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockToggleCommentsDone returns true if the count of the ToggleComments invocations corresponds
+// MinimockUpdateReportStatusDone returns true if the count of the UpdateReportStatus invocations corresponds
 // the number of defined expectations
-func (m *StorageMock) MinimockToggleCommentsDone() bool {
-	if m.ToggleCommentsMock.optional {
+func (m *StorageMock) MinimockUpdateReportStatusDone() bool {
+	if m.UpdateReportStatusMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.ToggleCommentsMock.expectations {
+	for _, e := range m.UpdateReportStatusMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.ToggleCommentsMock.invocationsDone()
+	return m.UpdateReportStatusMock.invocationsDone()
 }
 
-// MinimockToggleCommentsInspect logs each unmet expectation
-func (m *StorageMock) MinimockToggleCommentsInspect() {
-	for _, e := range m.ToggleCommentsMock.expectations {
+// MinimockUpdateReportStatusInspect logs each unmet expectation
+func (m *StorageMock) MinimockUpdateReportStatusInspect() {
+	for _, e := range m.UpdateReportStatusMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to StorageMock.ToggleComments at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to StorageMock.UpdateReportStatus at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterToggleCommentsCounter := mm_atomic.LoadUint64(&m.afterToggleCommentsCounter)
+	afterUpdateReportStatusCounter := mm_atomic.LoadUint64(&m.afterUpdateReportStatusCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.ToggleCommentsMock.defaultExpectation != nil && afterToggleCommentsCounter < 1 {
-		if m.ToggleCommentsMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to StorageMock.ToggleComments at\n%s", m.ToggleCommentsMock.defaultExpectation.returnOrigin)
+	if m.UpdateReportStatusMock.defaultExpectation != nil && afterUpdateReportStatusCounter < 1 {
+		if m.UpdateReportStatusMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to StorageMock.UpdateReportStatus at\n%s", m.UpdateReportStatusMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to StorageMock.ToggleComments at\n%s with params: %#v", m.ToggleCommentsMock.defaultExpectation.expectationOrigins.origin, *m.ToggleCommentsMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to StorageMock.UpdateReportStatus at\n%s with params: %#v", m.UpdateReportStatusMock.defaultExpectation.expectationOrigins.origin, *m.UpdateReportStatusMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcToggleComments != nil && afterToggleCommentsCounter < 1 {
-		m.t.Errorf("Expected call to StorageMock.ToggleComments at\n%s", m.funcToggleCommentsOrigin)
+	if m.funcUpdateReportStatus != nil && afterUpdateReportStatusCounter < 1 {
+		m.t.Errorf("Expected call to StorageMock.UpdateReportStatus at\n%s", m.funcUpdateReportStatusOrigin)
 	}
 
-	if !m.ToggleCommentsMock.invocationsDone() && afterToggleCommentsCounter > 0 {
-		m.t.Errorf("Expected %d calls to StorageMock.ToggleComments at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.ToggleCommentsMock.expectedInvocations), m.ToggleCommentsMock.expectedInvocationsOrigin, afterToggleCommentsCounter)
+	if !m.UpdateReportStatusMock.invocationsDone() && afterUpdateReportStatusCounter > 0 {
+		m.t.Errorf("Expected %d calls to StorageMock.UpdateReportStatus at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.UpdateReportStatusMock.expectedInvocations), m.UpdateReportStatusMock.expectedInvocationsOrigin, afterUpdateReportStatusCounter)
 	}
 }
 
@@ -2661,19 +9858,57 @@ func (m *StorageMock) MinimockToggleCommentsInspect() {
 func (m *StorageMock) MinimockFinish() {
 	m.finishOnce.Do(func() {
 		if !m.minimockDone() {
+			m.MinimockClaimIdempotencyKeyInspect()
+
+			m.MinimockCompleteIdempotencyKeyInspect()
+
+			m.MinimockCreateAuditEntryInspect()
+
 			m.MinimockCreateCommentInspect()
 
 			m.MinimockCreatePostInspect()
 
+			m.MinimockCreateReportInspect()
+
+			m.MinimockGetAuditEntriesInspect()
+
+			m.MinimockGetCommentByIDInspect()
+
 			m.MinimockGetCommentsByPostIDInspect()
 
+			m.MinimockGetCommentsSinceInspect()
+
 			m.MinimockGetPostByIDInspect()
 
+			m.MinimockGetPostWithVisibilityInspect()
+
 			m.MinimockGetPostsInspect()
 
 			m.MinimockGetRepliesInspect()
 
+			m.MinimockGetReportByIDInspect()
+
+			m.MinimockGetReportsInspect()
+
+			m.MinimockPingInspect()
+
+			m.MinimockPublishCommentInspect()
+
+			m.MinimockPublishPostInspect()
+
+			m.MinimockReleaseIdempotencyKeyInspect()
+
+			m.MinimockRemoveCommentInspect()
+
+			m.MinimockRemovePostInspect()
+
+			m.MinimockReopenReportInspect()
+
 			m.MinimockToggleCommentsInspect()
+
+			m.MinimockUpdateCommentInspect()
+
+			m.MinimockUpdateReportStatusInspect()
 		}
 	})
 }
@@ -2697,11 +9932,30 @@ func (m *StorageMock) MinimockWait(timeout mm_time.Duration) {
 func (m *StorageMock) minimockDone() bool {
 	done := true
 	return done &&
+		m.MinimockClaimIdempotencyKeyDone() &&
+		m.MinimockCompleteIdempotencyKeyDone() &&
+		m.MinimockCreateAuditEntryDone() &&
 		m.MinimockCreateCommentDone() &&
 		m.MinimockCreatePostDone() &&
+		m.MinimockCreateReportDone() &&
+		m.MinimockGetAuditEntriesDone() &&
+		m.MinimockGetCommentByIDDone() &&
 		m.MinimockGetCommentsByPostIDDone() &&
+		m.MinimockGetCommentsSinceDone() &&
 		m.MinimockGetPostByIDDone() &&
+		m.MinimockGetPostWithVisibilityDone() &&
 		m.MinimockGetPostsDone() &&
 		m.MinimockGetRepliesDone() &&
-		m.MinimockToggleCommentsDone()
+		m.MinimockGetReportByIDDone() &&
+		m.MinimockGetReportsDone() &&
+		m.MinimockPingDone() &&
+		m.MinimockPublishCommentDone() &&
+		m.MinimockPublishPostDone() &&
+		m.MinimockReleaseIdempotencyKeyDone() &&
+		m.MinimockRemoveCommentDone() &&
+		m.MinimockRemovePostDone() &&
+		m.MinimockReopenReportDone() &&
+		m.MinimockToggleCommentsDone() &&
+		m.MinimockUpdateCommentDone() &&
+		m.MinimockUpdateReportStatusDone()
 }
@@ -0,0 +1,88 @@
+package tracing
+
+import (
+	"context"
+	"sync"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/vektah/gqlparser/v2/ast"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.30.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Extension — расширение gqlgen, создающее span на каждую операцию, а при
+// Fields — и на каждое поле с резолвером. Для подписки span охватывает только
+// её оформление: поток событий может длиться часами.
+type Extension struct {
+	Fields bool
+}
+
+var _ interface {
+	graphql.HandlerExtension
+	graphql.OperationInterceptor
+	graphql.FieldInterceptor
+} = Extension{}
+
+func (Extension) ExtensionName() string {
+	return "Tracing"
+}
+
+func (Extension) Validate(schema graphql.ExecutableSchema) error {
+	return nil
+}
+
+func (Extension) InterceptOperation(ctx context.Context, next graphql.OperationHandler) graphql.ResponseHandler {
+	opCtx := graphql.GetOperationContext(ctx)
+	op := opCtx.Operation
+	if op == nil {
+		return next(ctx)
+	}
+	name := string(op.Operation)
+	if op.Name != "" {
+		name += " " + op.Name
+	}
+	// Span начинается с разбора запроса, а не с выполнения.
+	ctx, span := tracer.Start(ctx, name,
+		trace.WithSpanKind(trace.SpanKindServer),
+		trace.WithTimestamp(opCtx.Stats.OperationStart),
+		trace.WithAttributes(
+			semconv.GraphqlOperationTypeKey.String(string(op.Operation)),
+			semconv.GraphqlOperationName(op.Name),
+			semconv.GraphqlDocument(opCtx.RawQuery),
+		))
+
+	responses := next(ctx)
+	if op.Operation == ast.Subscription {
+		span.End()
+		return responses
+	}
+	var once sync.Once
+	return func(ctx context.Context) *graphql.Response {
+		resp := responses(ctx)
+		once.Do(func() {
+			if resp != nil && len(resp.Errors) > 0 {
+				for _, err := range resp.Errors {
+					span.RecordError(err)
+				}
+				span.SetStatus(codes.Error, resp.Errors[0].Message)
+			}
+			span.End()
+		})
+		return resp
+	}
+}
+
+func (e Extension) InterceptField(ctx context.Context, next graphql.Resolver) (any, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if !e.Fields || fc == nil || !fc.IsResolver {
+		return next(ctx)
+	}
+	ctx, span := tracer.Start(ctx, fc.Object+"."+fc.Field.Name,
+		trace.WithAttributes(attribute.String("graphql.field.path", fc.Path().String())))
+	defer span.End()
+	res, err := next(ctx)
+	recordError(span, err)
+	return res, err
+}
@@ -0,0 +1,184 @@
+package tracing
+
+import (
+	"context"
+	"time"
+
+	"hivemind/graph/model"
+	"hivemind/internal/storage"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Storage оборачивает хранилище и создаёт span на каждый вызов; запросы к
+// базе становятся его дочерними span'ами.
+type Storage struct {
+	next storage.Storage
+}
+
+var _ storage.Storage = (*Storage)(nil)
+
+func InstrumentStorage(next storage.Storage) *Storage {
+	return &Storage{next: next}
+}
+
+func end(span trace.Span, err *error) {
+	recordError(span, *err)
+	span.End()
+}
+
+func (s *Storage) Ping(ctx context.Context) (err error) {
+	ctx, span := tracer.Start(ctx, "storage.Ping")
+	defer end(span, &err)
+	return s.next.Ping(ctx)
+}
+
+func (s *Storage) CreatePost(ctx context.Context, post *model.Post, visibility storage.Visibility) (err error) {
+	ctx, span := tracer.Start(ctx, "storage.CreatePost")
+	defer end(span, &err)
+	return s.next.CreatePost(ctx, post, visibility)
+}
+
+func (s *Storage) GetPosts(ctx context.Context) (_ []*model.Post, err error) {
+	ctx, span := tracer.Start(ctx, "storage.GetPosts")
+	defer end(span, &err)
+	return s.next.GetPosts(ctx)
+}
+
+func (s *Storage) GetPostByID(ctx context.Context, id string) (_ *model.Post, err error) {
+	ctx, span := tracer.Start(ctx, "storage.GetPostByID")
+	defer end(span, &err)
+	return s.next.GetPostByID(ctx, id)
+}
+
+func (s *Storage) GetPostWithVisibility(ctx context.Context, id string) (_ *model.Post, _ storage.Visibility, err error) {
+	ctx, span := tracer.Start(ctx, "storage.GetPostWithVisibility")
+	defer end(span, &err)
+	return s.next.GetPostWithVisibility(ctx, id)
+}
+
+func (s *Storage) ToggleComments(ctx context.Context, postID string, enabled bool, author string) (_ *model.Post, err error) {
+	ctx, span := tracer.Start(ctx, "storage.ToggleComments")
+	defer end(span, &err)
+	return s.next.ToggleComments(ctx, postID, enabled, author)
+}
+
+func (s *Storage) RemovePost(ctx context.Context, id string) (err error) {
+	ctx, span := tracer.Start(ctx, "storage.RemovePost")
+	defer end(span, &err)
+	return s.next.RemovePost(ctx, id)
+}
+
+func (s *Storage) PublishPost(ctx context.Context, id string) (err error) {
+	ctx, span := tracer.Start(ctx, "storage.PublishPost")
+	defer end(span, &err)
+	return s.next.PublishPost(ctx, id)
+}
+
+func (s *Storage) CreateComment(ctx context.Context, comment *model.Comment, visibility storage.Visibility) (err error) {
+	ctx, span := tracer.Start(ctx, "storage.CreateComment")
+	defer end(span, &err)
+	return s.next.CreateComment(ctx, comment, visibility)
+}
+
+func (s *Storage) GetCommentByID(ctx context.Context, id string) (_ *model.Comment, err error) {
+	ctx, span := tracer.Start(ctx, "storage.GetCommentByID")
+	defer end(span, &err)
+	return s.next.GetCommentByID(ctx, id)
+}
+
+func (s *Storage) GetCommentsByPostID(ctx context.Context, postID string, limit, offset int) (_ []*model.Comment, err error) {
+	ctx, span := tracer.Start(ctx, "storage.GetCommentsByPostID")
+	defer end(span, &err)
+	return s.next.GetCommentsByPostID(ctx, postID, limit, offset)
+}
+
+func (s *Storage) GetReplies(ctx context.Context, parentID string, limit, offset int) (_ []*model.Comment, err error) {
+	ctx, span := tracer.Start(ctx, "storage.GetReplies")
+	defer end(span, &err)
+	return s.next.GetReplies(ctx, parentID, limit, offset)
+}
+
+func (s *Storage) GetCommentsSince(ctx context.Context, postID string, since time.Time, afterID string, limit int) (_ []*model.Comment, err error) {
+	ctx, span := tracer.Start(ctx, "storage.GetCommentsSince")
+	defer end(span, &err)
+	return s.next.GetCommentsSince(ctx, postID, since, afterID, limit)
+}
+
+func (s *Storage) UpdateComment(ctx context.Context, id, content string, editedAt time.Time) (_ *model.Comment, _ storage.Visibility, err error) {
+	ctx, span := tracer.Start(ctx, "storage.UpdateComment")
+	defer end(span, &err)
+	return s.next.UpdateComment(ctx, id, content, editedAt)
+}
+
+func (s *Storage) RemoveComment(ctx context.Context, id string) (err error) {
+	ctx, span := tracer.Start(ctx, "storage.RemoveComment")
+	defer end(span, &err)
+	return s.next.RemoveComment(ctx, id)
+}
+
+func (s *Storage) PublishComment(ctx context.Context, id string) (err error) {
+	ctx, span := tracer.Start(ctx, "storage.PublishComment")
+	defer end(span, &err)
+	return s.next.PublishComment(ctx, id)
+}
+
+func (s *Storage) CreateReport(ctx context.Context, report *model.Report) (err error) {
+	ctx, span := tracer.Start(ctx, "storage.CreateReport")
+	defer end(span, &err)
+	return s.next.CreateReport(ctx, report)
+}
+
+func (s *Storage) GetReportByID(ctx context.Context, id string) (_ *model.Report, err error) {
+	ctx, span := tracer.Start(ctx, "storage.GetReportByID")
+	defer end(span, &err)
+	return s.next.GetReportByID(ctx, id)
+}
+
+func (s *Storage) GetReports(ctx context.Context, status model.ReportStatus, limit int, afterID string) (_ []*model.Report, err error) {
+	ctx, span := tracer.Start(ctx, "storage.GetReports")
+	defer end(span, &err)
+	return s.next.GetReports(ctx, status, limit, afterID)
+}
+
+func (s *Storage) UpdateReportStatus(ctx context.Context, id string, change *model.ReportStatusChange) (_ *model.Report, err error) {
+	ctx, span := tracer.Start(ctx, "storage.UpdateReportStatus")
+	defer end(span, &err)
+	return s.next.UpdateReportStatus(ctx, id, change)
+}
+
+func (s *Storage) ReopenReport(ctx context.Context, id string, from model.ReportStatus, change *model.ReportStatusChange) (err error) {
+	ctx, span := tracer.Start(ctx, "storage.ReopenReport")
+	defer end(span, &err)
+	return s.next.ReopenReport(ctx, id, from, change)
+}
+
+func (s *Storage) CreateAuditEntry(ctx context.Context, entry *model.AuditEntry) (err error) {
+	ctx, span := tracer.Start(ctx, "storage.CreateAuditEntry")
+	defer end(span, &err)
+	return s.next.CreateAuditEntry(ctx, entry)
+}
+
+func (s *Storage) GetAuditEntries(ctx context.Context, filter model.AuditLogFilter, limit int, afterID string) (_ []*model.AuditEntry, err error) {
+	ctx, span := tracer.Start(ctx, "storage.GetAuditEntries")
+	defer end(span, &err)
+	return s.next.GetAuditEntries(ctx, filter, limit, afterID)
+}
+
+func (s *Storage) ClaimIdempotencyKey(ctx context.Context, key *storage.IdempotencyKey) (_ *storage.IdempotencyKey, err error) {
+	ctx, span := tracer.Start(ctx, "storage.ClaimIdempotencyKey")
+	defer end(span, &err)
+	return s.next.ClaimIdempotencyKey(ctx, key)
+}
+
+func (s *Storage) CompleteIdempotencyKey(ctx context.Context, key, resultID string, expiresAt time.Time) (err error) {
+	ctx, span := tracer.Start(ctx, "storage.CompleteIdempotencyKey")
+	defer end(span, &err)
+	return s.next.CompleteIdempotencyKey(ctx, key, resultID, expiresAt)
+}
+
+func (s *Storage) ReleaseIdempotencyKey(ctx context.Context, key, resultID string) (err error) {
+	ctx, span := tracer.Start(ctx, "storage.ReleaseIdempotencyKey")
+	defer end(span, &err)
+	return s.next.ReleaseIdempotencyKey(ctx, key, resultID)
+}
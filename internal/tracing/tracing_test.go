@@ -0,0 +1,112 @@
+package tracing_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"hivemind/graph/generated"
+	"hivemind/graph/model"
+	"hivemind/graph/resolver"
+	"hivemind/internal/storage/mocks"
+	"hivemind/internal/tracing"
+
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+var (
+	exporter  = tracetest.NewInMemoryExporter()
+	setupOnce sync.Once
+)
+
+// recorded устанавливает глобальный провайдер один раз: tracer'ы пакетов
+// привязываются к первому установленному провайдеру.
+func recorded(t *testing.T) *tracetest.InMemoryExporter {
+	t.Helper()
+	setupOnce.Do(func() {
+		otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter)))
+		otel.SetTextMapPropagator(propagation.TraceContext{})
+	})
+	exporter.Reset()
+	return exporter
+}
+
+func byName(spans tracetest.SpanStubs) map[string]tracetest.SpanStub {
+	m := make(map[string]tracetest.SpanStub, len(spans))
+	for _, span := range spans {
+		m[span.Name] = span
+	}
+	return m
+}
+
+func query(t *testing.T, h http.Handler, q string, header http.Header) {
+	t.Helper()
+	req := httptest.NewRequest(http.MethodPost, "/query", strings.NewReader(`{"query":"`+strings.ReplaceAll(q, `"`, `\"`)+`"}`))
+	req.Header.Set("Content-Type", "application/json")
+	for k, v := range header {
+		req.Header[k] = v
+	}
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusOK, rec.Code)
+}
+
+func newServer(t *testing.T, mockStorage *mocks.StorageMock, fields bool) http.Handler {
+	t.Helper()
+	srv := handler.New(generated.NewExecutableSchema(generated.Config{Resolvers: resolver.NewResolver(tracing.InstrumentStorage(mockStorage))}))
+	srv.AddTransport(transport.POST{})
+	srv.Use(tracing.Extension{Fields: fields})
+	return tracing.Middleware(srv)
+}
+
+func TestOperationSpans(t *testing.T) {
+	t.Run("operation continues incoming trace", func(t *testing.T) {
+		exp := recorded(t)
+		mockStorage := mocks.NewStorageMock(t)
+		mockStorage.GetPostsMock.Return([]*model.Post{}, nil)
+
+		header := http.Header{}
+		header.Set("traceparent", "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+		query(t, newServer(t, mockStorage, false), `query Feed { posts { id } }`, header)
+
+		spans := byName(exp.GetSpans())
+		require.Contains(t, spans, "query Feed")
+		op := spans["query Feed"]
+		assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", op.SpanContext.TraceID().String())
+		assert.Equal(t, "00f067aa0ba902b7", op.Parent.SpanID().String())
+		assert.Equal(t, codes.Unset, op.Status.Code)
+
+		require.Contains(t, spans, "storage.GetPosts")
+		assert.Equal(t, op.SpanContext.SpanID(), spans["storage.GetPosts"].Parent.SpanID())
+		assert.NotContains(t, spans, "Query.posts")
+	})
+
+	t.Run("field spans and errors", func(t *testing.T) {
+		exp := recorded(t)
+		mockStorage := mocks.NewStorageMock(t)
+		mockStorage.GetPostWithVisibilityMock.Return(nil, "", errors.New("post not found"))
+
+		query(t, newServer(t, mockStorage, true), `{ post(id: "missing") { id } }`, nil)
+
+		spans := byName(exp.GetSpans())
+		require.Contains(t, spans, "query")
+		require.Contains(t, spans, "Query.post")
+		require.Contains(t, spans, "storage.GetPostWithVisibility")
+		assert.Equal(t, codes.Error, spans["query"].Status.Code)
+		assert.Equal(t, codes.Error, spans["Query.post"].Status.Code)
+		assert.Equal(t, codes.Error, spans["storage.GetPostWithVisibility"].Status.Code)
+		assert.Equal(t, spans["query"].SpanContext.SpanID(), spans["Query.post"].Parent.SpanID())
+		assert.Equal(t, spans["Query.post"].SpanContext.SpanID(), spans["storage.GetPostWithVisibility"].Parent.SpanID())
+	})
+}